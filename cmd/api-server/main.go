@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -18,6 +19,7 @@ import (
 	cloudartifacts "github.com/kubeshop/testkube/pkg/cloud/data/artifact"
 
 	domainstorage "github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/local"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -26,6 +28,7 @@ import (
 	cloudconfig "github.com/kubeshop/testkube/pkg/cloud/data/config"
 
 	cloudresult "github.com/kubeshop/testkube/pkg/cloud/data/result"
+	cloudtestcase "github.com/kubeshop/testkube/pkg/cloud/data/testcase"
 	cloudtestresult "github.com/kubeshop/testkube/pkg/cloud/data/testresult"
 
 	"github.com/kubeshop/testkube/internal/common"
@@ -37,6 +40,7 @@ import (
 	configrepository "github.com/kubeshop/testkube/pkg/repository/config"
 	"github.com/kubeshop/testkube/pkg/repository/result"
 	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 
 	"golang.org/x/sync/errgroup"
@@ -74,6 +78,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/log"
 	"github.com/kubeshop/testkube/pkg/migrator"
 	"github.com/kubeshop/testkube/pkg/reconciler"
+	"github.com/kubeshop/testkube/pkg/retention"
 	"github.com/kubeshop/testkube/pkg/secret"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
@@ -190,9 +195,15 @@ func main() {
 		ui.ExitOnError("Creating TestKube Clientset", err)
 	}
 
+	dynamicClient, err := k8sclient.ConnectToK8sDynamic()
+	if err != nil {
+		ui.ExitOnError("Creating k8s dynamic client", err)
+	}
+
 	// DI
 	var resultsRepository result.Repository
 	var testResultsRepository testresult.Repository
+	var testCasesRepository testcase.Repository
 	var configRepository configrepository.Repository
 	var triggerLeaseBackend triggers.LeaseBackend
 	var artifactStorage domainstorage.ArtifactsStorage
@@ -200,41 +211,88 @@ func main() {
 	if mode == common.ModeAgent {
 		resultsRepository = cloudresult.NewCloudResultRepository(grpcClient, grpcConn, cfg.TestkubeCloudAPIKey)
 		testResultsRepository = cloudtestresult.NewCloudRepository(grpcClient, grpcConn, cfg.TestkubeCloudAPIKey)
+		testCasesRepository = cloudtestcase.NewCloudRepository(grpcClient, grpcConn, cfg.TestkubeCloudAPIKey)
 		configRepository = cloudconfig.NewCloudResultRepository(grpcClient, grpcConn, cfg.TestkubeCloudAPIKey)
 		triggerLeaseBackend = triggers.NewAcquireAlwaysLeaseBackend()
 		artifactStorage = cloudartifacts.NewCloudArtifactsStorage(grpcClient, grpcConn, cfg.TestkubeCloudAPIKey)
 	} else {
-		mongoSSLConfig := getMongoSSLConfig(cfg, secretClient)
-		db, err := storage.GetMongoDatabase(cfg.APIMongoDSN, cfg.APIMongoDB, cfg.APIMongoDBType, cfg.APIMongoAllowTLS, mongoSSLConfig)
-		ui.ExitOnError("Getting mongo database", err)
-		mongoResultsRepository := result.NewMongoRepository(db, cfg.APIMongoAllowDiskUse)
-		resultsRepository = mongoResultsRepository
-		testResultsRepository = testresult.NewMongoRepository(db, cfg.APIMongoAllowDiskUse)
-		configRepository = configrepository.NewMongoRepository(db)
-		triggerLeaseBackend = triggers.NewMongoLeaseBackend(db)
-		minioClient := minio.NewClient(
-			cfg.StorageEndpoint,
-			cfg.StorageAccessKeyID,
-			cfg.StorageSecretAccessKey,
-			cfg.StorageRegion,
-			cfg.StorageToken,
-			cfg.StorageBucket,
-			cfg.StorageSSL,
-		)
-		if err = minioClient.Connect(); err != nil {
-			ui.ExitOnError("Connecting to minio", err)
+		if cfg.TestkubeLiteMode {
+			log.DefaultLogger.Infow("running in lite mode", "dataDir", cfg.TestkubeLiteDataDir)
+			cfg.APIDBType = storage.TypeSQLite
+			cfg.APISQLitePath = filepath.Join(cfg.TestkubeLiteDataDir, "testkube.db")
+		}
+
+		var mongoResultsRepository *result.MongoRepository
+		switch cfg.APIDBType {
+		case storage.TypePostgres:
+			db, err := storage.GetPostgresDatabase(cfg.APIPostgresDSN)
+			ui.ExitOnError("Getting postgres database", err)
+			resultsRepository = result.NewSQLRepository(db)
+			testResultsRepository = testresult.NewSQLRepository(db)
+			testCasesRepository = testcase.NewSQLRepository(db)
+			configRepository = configrepository.NewSQLRepository(db)
+			triggerLeaseBackend = triggers.NewSQLLeaseBackend(db)
+		case storage.TypeSQLite:
+			db, err := storage.GetSQLiteDatabase(cfg.APISQLitePath)
+			ui.ExitOnError("Getting sqlite database", err)
+			resultsRepository = result.NewSQLRepository(db)
+			testResultsRepository = testresult.NewSQLRepository(db)
+			testCasesRepository = testcase.NewSQLRepository(db)
+			configRepository = configrepository.NewSQLRepository(db)
+			triggerLeaseBackend = triggers.NewSQLLeaseBackend(db)
+		default:
+			mongoSSLConfig := getMongoSSLConfig(cfg, secretClient)
+			db, err := storage.GetMongoDatabase(cfg.APIMongoDSN, cfg.APIMongoDB, cfg.APIMongoDBType, cfg.APIMongoAllowTLS, mongoSSLConfig)
+			ui.ExitOnError("Getting mongo database", err)
+			mongoResultsRepository = result.NewMongoRepository(db, cfg.APIMongoAllowDiskUse)
+			if err = mongoResultsRepository.EnsureIndexes(ctx); err != nil {
+				log.DefaultLogger.Errorw("creating results indexes", "error", err)
+			}
+			resultsRepository = mongoResultsRepository
+			mongoTestResultsRepository := testresult.NewMongoRepository(db, cfg.APIMongoAllowDiskUse)
+			if err = mongoTestResultsRepository.EnsureIndexes(ctx); err != nil {
+				log.DefaultLogger.Errorw("creating test suite results indexes", "error", err)
+			}
+			testResultsRepository = mongoTestResultsRepository
+			mongoTestCasesRepository := testcase.NewMongoRepository(db)
+			if err = mongoTestCasesRepository.EnsureIndexes(ctx); err != nil {
+				log.DefaultLogger.Errorw("creating test cases indexes", "error", err)
+			}
+			testCasesRepository = mongoTestCasesRepository
+			configRepository = configrepository.NewMongoRepository(db)
+			triggerLeaseBackend = triggers.NewMongoLeaseBackend(db)
 		}
-		if expErr := minioClient.SetExpirationPolicy(cfg.StorageExpiration); expErr != nil {
-			log.DefaultLogger.Errorw("Error setting expiration policy", "error", expErr)
+		if cfg.TestkubeLiteMode {
+			localClient := local.NewClient(filepath.Join(cfg.TestkubeLiteDataDir, "artifacts"), cfg.StorageBucket)
+			storageClient = localClient
+			artifactStorage = local.NewLocalArtifactClient(localClient)
+		} else {
+			minioClient := minio.NewClient(
+				cfg.StorageEndpoint,
+				cfg.StorageAccessKeyID,
+				cfg.StorageSecretAccessKey,
+				cfg.StorageRegion,
+				cfg.StorageToken,
+				cfg.StorageBucket,
+				cfg.StorageSSL,
+			)
+			if err = minioClient.Connect(); err != nil {
+				ui.ExitOnError("Connecting to minio", err)
+			}
+			if expErr := minioClient.SetExpirationPolicy(cfg.StorageExpiration); expErr != nil {
+				log.DefaultLogger.Errorw("Error setting expiration policy", "error", expErr)
+			}
+			storageClient = minioClient
+			artifactStorage = minio.NewMinIOArtifactClient(storageClient)
 		}
-		storageClient = minioClient
-		artifactStorage = minio.NewMinIOArtifactClient(storageClient)
 		// init storage
-		isMinioStorage := cfg.LogsStorage == "minio"
+		isMinioStorage := cfg.LogsStorage == "minio" && !cfg.TestkubeLiteMode
 		if isMinioStorage {
 			bucket := cfg.LogsBucket
 			if bucket == "" {
 				log.DefaultLogger.Error("LOGS_BUCKET env var is not set")
+			} else if mongoResultsRepository == nil {
+				log.DefaultLogger.Warnw("minio logs storage is supported only with mongo database, using default logs storage", "dbType", cfg.APIDBType)
 			} else if ok, err := storageClient.IsConnectionPossible(ctx); ok && (err == nil) {
 				log.DefaultLogger.Info("setting minio as logs storage")
 				mongoResultsRepository.OutputRepository = result.NewMinioOutputRepository(storageClient, mongoResultsRepository.ResultsColl, bucket)
@@ -306,12 +364,17 @@ func main() {
 		envs[pair[0]] += pair[1]
 	}
 
-	// configure NATS event bus
-	nc, err := bus.NewNATSConnection(cfg.NatsURI)
-	if err != nil {
-		log.DefaultLogger.Errorw("error creating NATS connection", "error", err)
+	var eventBus bus.Bus
+	if cfg.TestkubeLiteMode {
+		eventBus = bus.NewInProcessBus()
+	} else {
+		// configure NATS event bus
+		nc, err := bus.NewNATSConnection(cfg.NatsURI)
+		if err != nil {
+			log.DefaultLogger.Errorw("error creating NATS connection", "error", err)
+		}
+		eventBus = bus.NewNATSBus(nc)
 	}
-	eventBus := bus.NewNATSBus(nc)
 	eventsEmitter := event.NewEmitter(eventBus, cfg.TestkubeClusterName, envs)
 
 	metrics := metrics.NewMetrics()
@@ -398,7 +461,11 @@ func main() {
 		testsuiteExecutionsClient,
 		eventBus,
 		cfg.TestkubeDashboardURI,
-	)
+	).WithLease(triggerLeaseBackend)
+	if mode == common.ModeAgent {
+		// cloud results replace whole executions on update, so heartbeats of running executions can't be kept
+		sched.WithoutResume()
+	}
 
 	slackLoader, err := newSlackLoader(cfg, envs)
 	if err != nil {
@@ -409,6 +476,7 @@ func main() {
 		cfg.TestkubeNamespace,
 		resultsRepository,
 		testResultsRepository,
+		testCasesRepository,
 		testsClientV3,
 		executorsClient,
 		testsuitesClientV3,
@@ -436,6 +504,7 @@ func main() {
 		mode,
 		eventBus,
 		cfg.EnableSecretsEndpoint,
+		cfg.EnableTestTriggerAnnotatedActions,
 	)
 
 	if mode == common.ModeAgent {
@@ -468,6 +537,10 @@ func main() {
 
 	api.InitEvents()
 
+	go sched.RunExecutionQueue(ctx)
+
+	go sched.RunResumer(ctx)
+
 	if !cfg.DisableTestTriggers {
 		triggerService := triggers.NewService(
 			sched,
@@ -487,6 +560,7 @@ func main() {
 			triggers.WithHostnameIdentifier(),
 			triggers.WithTestkubeNamespace(cfg.TestkubeNamespace),
 			triggers.WithWatcherNamespaces(cfg.TestkubeWatcherNamespaces),
+			triggers.WithDynamicClient(dynamicClient),
 		)
 		log.DefaultLogger.Info("starting trigger service")
 		triggerService.Run(ctx)
@@ -508,6 +582,15 @@ func main() {
 		log.DefaultLogger.Info("reconclier is disabled")
 	}
 
+	if mode != common.ModeAgent && cfg.RetentionInterval > 0 {
+		janitor := retention.NewJanitor(configMapConfig, resultsRepository, testResultsRepository, testCasesRepository, storageClient, log.DefaultLogger).
+			WithLease(triggerLeaseBackend)
+		log.DefaultLogger.Infow("starting retention janitor", "interval", cfg.RetentionInterval, "dryRun", cfg.RetentionDryRun)
+		go janitor.Run(ctx, cfg.RetentionInterval, cfg.RetentionDryRun)
+	} else {
+		log.DefaultLogger.Info("retention janitor is disabled")
+	}
+
 	// telemetry based functions
 	telemetryCh := make(chan struct{})
 	defer close(telemetryCh)
@@ -6,6 +6,7 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/executors"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/retention"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/templates"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsources"
@@ -42,6 +43,8 @@ func NewDeleteCmd() *cobra.Command {
 	cmd.AddCommand(executors.NewDeleteExecutorCmd())
 	cmd.AddCommand(testsources.NewDeleteTestSourceCmd())
 	cmd.AddCommand(templates.NewDeleteTemplateCmd())
+	cmd.AddCommand(retention.NewDeleteRetentionCmd())
+	cmd.AddCommand(tests.NewDeleteAnnotationCmd())
 
 	return cmd
 }
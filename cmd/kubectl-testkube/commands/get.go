@@ -8,6 +8,7 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/context"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/executors"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/retention"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/templates"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsources"
@@ -43,11 +44,14 @@ func NewGetCmd() *cobra.Command {
 	cmd.AddCommand(webhooks.NewGetWebhookCmd())
 	cmd.AddCommand(executors.NewGetExecutorCmd())
 	cmd.AddCommand(tests.NewGetExecutionCmd())
+	cmd.AddCommand(tests.NewGetTestCasesCmd())
+	cmd.AddCommand(tests.NewGetFlakyCmd())
 	cmd.AddCommand(artifacts.NewListArtifactsCmd())
 	cmd.AddCommand(testsuites.NewTestSuiteExecutionCmd())
 	cmd.AddCommand(testsources.NewGetTestSourceCmd())
 	cmd.AddCommand(context.NewGetContextCmd())
 	cmd.AddCommand(templates.NewGetTemplateCmd())
+	cmd.AddCommand(retention.NewGetRetentionCmd())
 
 	cmd.PersistentFlags().StringP("output", "o", "pretty", "output type can be one of json|yaml|pretty|go-template")
 	cmd.PersistentFlags().StringP("go-template", "", "{{.}}", "go template to render")
@@ -0,0 +1,46 @@
+package quarantine
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewSetQuarantineCmd() *cobra.Command {
+	var policy testkube.QuarantinePolicy
+
+	cmd := &cobra.Command{
+		Use:     "quarantine",
+		Aliases: []string{"quarantine-policy"},
+		Short:   "Set quarantine of flaky tests",
+		Long:    `Set quarantine of flaky tests, failures of quarantined tests don't fail their test suite executions and are reported separately`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := policy.Validate()
+			ui.ExitOnError("validating quarantine policy", err)
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			config, err := client.GetConfig()
+			ui.ExitOnError("getting API config", err)
+
+			config.Quarantine = &policy
+			_, err = client.UpdateConfig(config)
+			ui.ExitOnError("updating API config", err)
+
+			if policy.Enabled {
+				ui.Success("Quarantine of flaky tests enabled")
+			} else {
+				ui.Success("Quarantine of flaky tests disabled")
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&policy.Enabled, "enabled", true, "quarantine failures of flaky tests")
+	cmd.Flags().Float64VarP(&policy.Threshold, "threshold", "", testkube.DefaultFlakinessThreshold, "flakiness score from which the test is considered flaky, between 0 and 1")
+	cmd.Flags().Int32VarP(&policy.Window, "window", "", testkube.DefaultFlakinessWindow, "number of latest test executions used for computing flakiness score")
+
+	return cmd
+}
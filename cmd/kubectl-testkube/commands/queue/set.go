@@ -0,0 +1,90 @@
+package queue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewSetQueueCmd() *cobra.Command {
+	var (
+		policy               testkube.QueuePolicy
+		namespaceConcurrency []string
+		executorConcurrency  []string
+		labelConcurrency     []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "queue",
+		Aliases: []string{"queue-policy"},
+		Short:   "Set concurrency limits of the execution queue",
+		Long:    `Set concurrency limits of running test executions, executions over the limits are queued and started by their priority class`,
+		Run: func(cmd *cobra.Command, args []string) {
+			var err error
+			policy.Namespaces, err = parseConcurrency(namespaceConcurrency)
+			ui.ExitOnError("parsing namespace concurrency", err)
+
+			policy.Executors, err = parseConcurrency(executorConcurrency)
+			ui.ExitOnError("parsing executor concurrency", err)
+
+			policy.Labels, err = parseConcurrency(labelConcurrency)
+			ui.ExitOnError("parsing label concurrency", err)
+
+			err = policy.Validate()
+			ui.ExitOnError("validating queue policy", err)
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			config, err := client.GetConfig()
+			ui.ExitOnError("getting API config", err)
+
+			config.Queue = &policy
+			_, err = client.UpdateConfig(config)
+			ui.ExitOnError("updating API config", err)
+
+			if policy.IsLimited() {
+				ui.Success("Execution queue concurrency limits set")
+			} else {
+				ui.Success("Execution queue concurrency limits removed")
+			}
+		},
+	}
+
+	cmd.Flags().Int32Var(&policy.Concurrency, "concurrency", 0, "maximum number of running test executions in the cluster, unlimited when 0")
+	cmd.Flags().StringArrayVar(&namespaceConcurrency, "namespace-concurrency", []string{}, "maximum number of running test executions in test namespace: --namespace-concurrency testkube=5")
+	cmd.Flags().StringArrayVar(&executorConcurrency, "executor-concurrency", []string{}, "maximum number of running test executions of executor: --executor-concurrency k6-executor=2")
+	cmd.Flags().StringArrayVar(&labelConcurrency, "label-concurrency", []string{}, "maximum number of running test executions with label: --label-concurrency team=qa=3")
+
+	return cmd
+}
+
+// parseConcurrency parses limits in form of name=limit, name can contain = itself
+func parseConcurrency(values []string) (map[string]int32, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	limits := make(map[string]int32, len(values))
+	for _, value := range values {
+		i := strings.LastIndex(value, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("concurrency %s has to be in form of name=limit", value)
+		}
+
+		limit, err := strconv.ParseInt(value[i+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid concurrency limit of %s: %w", value[:i], err)
+		}
+
+		limits[value[:i]] = int32(limit)
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,31 @@
+package renderer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func RenderAnnotations(annotations []testkube.ExecutionAnnotation) {
+	if len(annotations) > 0 {
+		ui.NL()
+		ui.Warn("  Annotations: ", fmt.Sprintf("%d", len(annotations)))
+		for _, a := range annotations {
+			header := a.CreatedAt.Format(time.RFC3339)
+			if a.Author != "" {
+				header += " " + a.Author
+			}
+			if a.Category != "" {
+				header += " [" + a.Category + "]"
+			}
+
+			ui.Info("  -", fmt.Sprintf("%s (id: %s)", header, a.Id))
+			ui.Info("   ", a.Text)
+			if a.IssueUrl != "" {
+				ui.Info("    Issue:", a.IssueUrl)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package retention
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewDeleteRetentionCmd() *cobra.Command {
+	var test, testSuite string
+
+	cmd := &cobra.Command{
+		Use:     "retention",
+		Aliases: []string{"retention-policy"},
+		Short:   "Delete retention policy",
+		Long:    `Delete retention policy of test or test suite, executions are not deleted`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if (test == "") == (testSuite == "") {
+				ui.Failf("Please provide either --test or --test-suite flag")
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			config, err := client.GetConfig()
+			ui.ExitOnError("getting API config", err)
+
+			policies := testkube.RetentionPolicies(config.RetentionPolicies)
+			i := policies.Index(test, testSuite)
+			if i == -1 {
+				ui.Failf("Retention policy not found")
+			}
+
+			// keep the list not nil, so removing the last policy is not treated as no change
+			config.RetentionPolicies = append(policies[:i:i], policies[i+1:]...)
+			_, err = client.UpdateConfig(config)
+			ui.ExitOnError("updating API config", err)
+
+			ui.Success("Retention policy deleted")
+		},
+	}
+
+	cmd.Flags().StringVarP(&test, "test", "t", "", "test name")
+	cmd.Flags().StringVarP(&testSuite, "test-suite", "", "", "test suite name")
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package retention
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewGetRetentionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "retention",
+		Aliases: []string{"retentions", "retention-policies"},
+		Short:   "Get retention policies",
+		Long:    `Get retention policies for test and test suite executions`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			config, err := client.GetConfig()
+			ui.ExitOnError("getting API config", err)
+
+			err = render.List(cmd, testkube.RetentionPolicies(config.RetentionPolicies), os.Stdout)
+			ui.ExitOnError("rendering list", err)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+package retention
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewRunRetentionCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "Enforce retention policies",
+		Long:  `Delete executions together with their outputs and artifacts not kept by retention policies`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			report, err := client.EnforceRetention(dryRun)
+			ui.ExitOnError("enforcing retention policies", err)
+
+			count := len(report.Executions) + len(report.TestSuiteExecutions)
+			if count == 0 {
+				ui.Info("No executions to delete")
+				return
+			}
+
+			ui.Table(report, os.Stdout)
+			ui.NL()
+			if dryRun {
+				ui.Info("Dry run, no executions were deleted")
+			} else {
+				ui.Success("Executions deleted")
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only report executions which would be deleted")
+
+	return cmd
+}
@@ -0,0 +1,51 @@
+package retention
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewSetRetentionCmd() *cobra.Command {
+	var policy testkube.RetentionPolicy
+
+	cmd := &cobra.Command{
+		Use:     "retention",
+		Aliases: []string{"retention-policy"},
+		Short:   "Set retention policy",
+		Long:    `Set retention policy for test or test suite executions, execution is deleted only when none of the limits keeps it`,
+		Run: func(cmd *cobra.Command, args []string) {
+			err := policy.Validate()
+			ui.ExitOnError("validating retention policy", err)
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			config, err := client.GetConfig()
+			ui.ExitOnError("getting API config", err)
+
+			policies := testkube.RetentionPolicies(config.RetentionPolicies)
+			if i := policies.Index(policy.Test, policy.TestSuite); i != -1 {
+				policies[i] = policy
+			} else {
+				policies = append(policies, policy)
+			}
+
+			config.RetentionPolicies = policies
+			_, err = client.UpdateConfig(config)
+			ui.ExitOnError("updating API config", err)
+
+			ui.Success("Retention policy set")
+		},
+	}
+
+	cmd.Flags().StringVarP(&policy.Test, "test", "t", "", "test name")
+	cmd.Flags().StringVarP(&policy.TestSuite, "test-suite", "", "", "test suite name")
+	cmd.Flags().Int32VarP(&policy.KeepLast, "keep-last", "", 0, "number of latest executions to keep")
+	cmd.Flags().Int32VarP(&policy.KeepDays, "keep-days", "", 0, "number of days to keep executions for")
+	cmd.Flags().Int32VarP(&policy.KeepFailedDays, "keep-failed-days", "", 0, "number of days to keep failed executions for")
+
+	return cmd
+}
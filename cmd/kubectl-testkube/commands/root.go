@@ -29,8 +29,13 @@ func init() {
 	RootCmd.AddCommand(NewGetCmd())
 	RootCmd.AddCommand(NewSetCmd())
 	RootCmd.AddCommand(NewRunCmd())
+	RootCmd.AddCommand(NewRerunCmd())
 	RootCmd.AddCommand(NewDeleteCmd())
 	RootCmd.AddCommand(NewAbortCmd())
+	RootCmd.AddCommand(NewDiffCmd())
+	RootCmd.AddCommand(NewAnnotateCmd())
+	RootCmd.AddCommand(NewExportCmd())
+	RootCmd.AddCommand(NewImportCmd())
 
 	RootCmd.AddCommand(NewEnableCmd())
 	RootCmd.AddCommand(NewDisableCmd())
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/retention"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/testsuites"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/config"
@@ -31,6 +32,7 @@ func NewRunCmd() *cobra.Command {
 
 	cmd.AddCommand(tests.NewRunTestCmd())
 	cmd.AddCommand(testsuites.NewRunTestSuiteCmd())
+	cmd.AddCommand(retention.NewRunRetentionCmd())
 
 	return cmd
 }
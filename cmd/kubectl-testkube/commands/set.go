@@ -4,6 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/context"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/quarantine"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/queue"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/retention"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -21,6 +24,9 @@ func NewSetCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(context.NewSetContextCmd())
+	cmd.AddCommand(quarantine.NewSetQuarantineCmd())
+	cmd.AddCommand(queue.NewSetQueueCmd())
+	cmd.AddCommand(retention.NewSetRetentionCmd())
 
 	return cmd
 }
@@ -0,0 +1,81 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewAnnotateExecutionCmd() *cobra.Command {
+	var annotation testkube.ExecutionAnnotation
+
+	cmd := &cobra.Command{
+		Use:     "execution <executionName>",
+		Aliases: []string{"executions", "e"},
+		Short:   "Annotates test execution",
+		Long:    `Records triage conclusion of the test execution with its category and optional link to the external issue`,
+		Args:    validator.ExecutionName,
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID := args[0]
+
+			if annotation.Author == "" {
+				annotation.Author = os.Getenv("USER")
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			annotation, err = client.AddExecutionAnnotation(executionID, annotation)
+			ui.ExitOnError(fmt.Sprintf("annotating execution %s", executionID), err)
+			ui.SuccessAndExit("Succesfully annotated execution", executionID, "annotation id:", annotation.Id)
+		},
+	}
+
+	cmd.Flags().StringVarP(&annotation.Text, "text", "t", "", "annotation text")
+	cmd.Flags().StringVar(&annotation.Author, "author", "", "author of the annotation, current user by default")
+	cmd.Flags().StringVar(&annotation.Category, "category", "", "annotation category, e.g. infra, product-bug or flaky")
+	cmd.Flags().StringVar(&annotation.IssueUrl, "issue-url", "", "link to the external issue")
+	_ = cmd.MarkFlagRequired("text")
+
+	return cmd
+}
+
+func NewDeleteAnnotationCmd() *cobra.Command {
+	var executionID, testSuiteExecutionID string
+
+	cmd := &cobra.Command{
+		Use:     "annotation <annotationID>",
+		Aliases: []string{"annotations"},
+		Short:   "Delete annotation of test or test suite execution",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			annotationID := args[0]
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			switch {
+			case executionID != "" && testSuiteExecutionID == "":
+				err = client.DeleteExecutionAnnotation(executionID, annotationID)
+			case testSuiteExecutionID != "" && executionID == "":
+				err = client.DeleteTestSuiteExecutionAnnotation(testSuiteExecutionID, annotationID)
+			default:
+				ui.Failf("pass either --execution or --test-suite-execution flag")
+			}
+
+			ui.ExitOnError("deleting annotation "+annotationID, err)
+			ui.SuccessAndExit("Succesfully deleted annotation", annotationID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&executionID, "execution", "e", "", "test execution id or name")
+	cmd.Flags().StringVar(&testSuiteExecutionID, "test-suite-execution", "", "test suite execution id or name")
+
+	return cmd
+}
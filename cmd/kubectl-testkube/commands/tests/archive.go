@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	apiv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewExportExecutionsCmd() *cobra.Command {
+	var (
+		file      string
+		selectors []string
+		options   apiv1.ExportExecutionsOptions
+	)
+
+	cmd := &cobra.Command{
+		Use:     "executions",
+		Aliases: []string{"execution", "e"},
+		Short:   "Export executions history",
+		Long:    `Export test and test suite executions together with their logs and optionally artifacts to a portable archive, which can be imported to other cluster`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			options.Selector = strings.Join(selectors, ",")
+			archive, err := client.ExportExecutions(options, file)
+			ui.ExitOnError("exporting executions", err)
+
+			ui.Success("Executions exported to", archive)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "executions.tar.gz", "archive file to write")
+	cmd.Flags().StringVar(&options.TestName, "test", "", "export only executions of the test, test suite executions are skipped")
+	cmd.Flags().StringVar(&options.Statuses, "status", "", "comma separated execution statuses, e.g. failed,timeout")
+	cmd.Flags().StringVar(&options.StartDate, "start-date", "", "export executions started since the date, in YYYY-MM-DD format")
+	cmd.Flags().StringVar(&options.EndDate, "end-date", "", "export executions started until the date, in YYYY-MM-DD format")
+	cmd.Flags().IntVar(&options.LastNDays, "last-days", 0, "export executions from the last days")
+	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
+	cmd.Flags().BoolVar(&options.Artifacts, "artifacts", false, "include artifacts stored in the folder per execution")
+
+	return cmd
+}
+
+func NewImportExecutionsCmd() *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:     "executions",
+		Aliases: []string{"execution", "e"},
+		Short:   "Import executions history",
+		Long:    `Import executions exported from other cluster keeping their ids and numbers, already existing executions are skipped`,
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			report, err := client.ImportExecutions(file)
+			ui.ExitOnError("importing executions from "+file, err)
+
+			ui.Warn("Executions:", fmt.Sprint(report.Executions))
+			ui.Warn("Test suite executions:", fmt.Sprint(report.TestSuiteExecutions))
+			ui.Warn("Artifacts:", fmt.Sprint(report.Artifacts))
+			if len(report.SkippedExecutions) != 0 {
+				ui.Warn("Skipped existing executions:", strings.Join(report.SkippedExecutions, ", "))
+			}
+
+			if len(report.SkippedTestSuiteExecutions) != 0 {
+				ui.Warn("Skipped existing test suite executions:", strings.Join(report.SkippedTestSuiteExecutions, ", "))
+			}
+
+			ui.NL()
+			ui.Success("Executions imported")
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "archive file created by export executions command")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
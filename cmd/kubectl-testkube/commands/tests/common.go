@@ -398,6 +398,11 @@ func newExecutionRequestFromFlags(cmd *cobra.Command) (request *testkube.Executi
 		return nil, err
 	}
 
+	shards, err := cmd.Flags().GetInt32("shards")
+	if err != nil {
+		return nil, err
+	}
+
 	request = &testkube.ExecutionRequest{
 		Name:                               executionName,
 		Variables:                          variables,
@@ -417,6 +422,7 @@ func newExecutionRequestFromFlags(cmd *cobra.Command) (request *testkube.Executi
 		PvcTemplateReference:               pvcTemplateReference,
 		NegativeTest:                       negativeTest,
 		ExecutePostRunScriptBeforeScraping: executePostRunScriptBeforeScraping,
+		Shards:                             shards,
 	}
 
 	var fields = []struct {
@@ -904,6 +910,16 @@ func newExecutionUpdateRequestFromFlags(cmd *cobra.Command) (request *testkube.E
 		nonEmpty = true
 	}
 
+	if cmd.Flag("shards").Changed {
+		shards, err := cmd.Flags().GetInt32("shards")
+		if err != nil {
+			return nil, err
+		}
+
+		request.Shards = &shards
+		nonEmpty = true
+	}
+
 	if cmd.Flag("negative-test").Changed {
 		negativeTest, err := cmd.Flags().GetBool("negative-test")
 		if err != nil {
@@ -1138,3 +1154,18 @@ func PrepareVariablesFile(client client.Client, parentName string, parentType cl
 	}
 	return fileName, true, nil
 }
+
+// parseMatrix parses matrix flags in the form of name=value1,value2
+func parseMatrix(values []string) (map[string][]string, error) {
+	matrix := make(map[string][]string, len(values))
+	for _, value := range values {
+		name, list, found := strings.Cut(value, "=")
+		if !found || name == "" || list == "" {
+			return nil, fmt.Errorf("invalid matrix %s, use name=value1,value2", value)
+		}
+
+		matrix[name] = append(matrix[name], strings.Split(list, ",")...)
+	}
+
+	return matrix, nil
+}
@@ -38,6 +38,18 @@ func Test_readCopyFiles(t *testing.T) {
 	})
 }
 
+func Test_parseMatrix(t *testing.T) {
+	t.Run("Matrix values are split by comma", func(t *testing.T) {
+		matrix, err := parseMatrix([]string{"region=eu,us", "env=a", "env=b"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string][]string{"region": {"eu", "us"}, "env": {"a", "b"}}, matrix)
+	})
+	t.Run("Matrix without values, an error is thrown", func(t *testing.T) {
+		_, err := parseMatrix([]string{"region"})
+		assert.Error(t, err)
+	})
+}
+
 func Test_mergeCopyFiles(t *testing.T) {
 	t.Run("Two empty lists should return empty list", func(t *testing.T) {
 		testFiles := []string{}
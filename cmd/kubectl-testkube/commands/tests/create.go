@@ -59,6 +59,7 @@ type CreateCommonFlags struct {
 	ArtifactStorageBucket              string
 	ArtifactOmitFolderPerExecution     bool
 	Description                        string
+	Shards                             int32
 }
 
 // NewCreateTestsCmd is a command tp create new Test Custom Resource
@@ -260,6 +261,7 @@ func AddCreateFlags(cmd *cobra.Command, flags *CreateCommonFlags) {
 	cmd.Flags().StringVar(&flags.ArtifactStorageBucket, "artifact-storage-bucket", "", "artifact storage class name for container executor")
 	cmd.Flags().BoolVarP(&flags.ArtifactOmitFolderPerExecution, "artifact-omit-folder-per-execution", "", false, "don't store artifacts in execution folder")
 	cmd.Flags().StringVarP(&flags.Description, "description", "", "", "test description")
+	cmd.Flags().Int32Var(&flags.Shards, "shards", 0, "number of pods running the test in parallel, each pod gets SHARD_INDEX and SHARD_TOTAL env variables")
 }
 
 func validateExecutorTypeAndContent(executorType, contentType string, executors testkube.ExecutorsDetails) error {
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests/renderer"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewDiffExecutionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "execution <executionA> <executionB>",
+		Aliases: []string{"executions", "e"},
+		Short:   "Compares two test executions",
+		Long:    `Compares variables, args, image, git revision, duration, step and assertion results and output logs of two test executions, e.g. the last passed and the first failed one`,
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			diff, err := client.DiffExecutions(args[0], args[1])
+			ui.ExitOnError("comparing executions "+args[0]+" and "+args[1], err)
+
+			err = render.Obj(cmd, diff, os.Stdout, renderer.ExecutionDiffRenderer)
+			ui.ExitOnError("rendering obj", err)
+		},
+	}
+}
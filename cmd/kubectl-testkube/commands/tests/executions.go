@@ -9,6 +9,7 @@ import (
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests/renderer"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -18,6 +19,7 @@ func NewGetExecutionCmd() *cobra.Command {
 		testID    string
 		limit     int
 		logsOnly  bool
+		parentID  string
 	)
 
 	cmd := &cobra.Command{
@@ -40,6 +42,18 @@ func NewGetExecutionCmd() *cobra.Command {
 					err = render.Obj(cmd, execution, os.Stdout, renderer.ExecutionRenderer)
 					ui.ExitOnError("rendering execution", err)
 				}
+			} else if parentID != "" {
+				summaries, err := client.ListMatrixExecutions(parentID)
+				ui.ExitOnError("getting matrix executions: "+parentID, err)
+
+				var executions testkube.MatrixExecutions
+				for _, summary := range summaries.Results {
+					execution, err := client.GetExecution(summary.Id)
+					ui.ExitOnError("getting test execution: "+summary.Id, err)
+					executions = append(executions, execution)
+				}
+
+				ui.Table(executions, os.Stdout)
 			} else {
 				executions, err := client.ListExecutions(testID, limit, strings.Join(selectors, ","))
 				ui.ExitOnError("Getting executions for test: "+testID, err)
@@ -53,6 +67,7 @@ func NewGetExecutionCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&testID, "test", "", "", "test id")
 	cmd.Flags().IntVarP(&limit, "limit", "", 10, "records limit")
 	cmd.Flags().BoolVar(&logsOnly, "logs-only", false, "show only execution logs")
+	cmd.Flags().StringVar(&parentID, "matrix", "", "parent execution id, shows executions of the matrix as a grid")
 
 	return cmd
 }
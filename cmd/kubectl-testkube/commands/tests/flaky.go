@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/tests/renderer"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewGetFlakyCmd() *cobra.Command {
+	var (
+		selectors []string
+		all       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "flaky [testName]",
+		Aliases: []string{"flakiness"},
+		Short:   "Lists flaky tests",
+		Long:    `Getting flakiness of given test with its flaky test cases, or list of flaky tests if there is no test name passed`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			if len(args) == 1 {
+				flakiness, err := client.GetTestFlakiness(args[0])
+				ui.ExitOnError("getting test flakiness: "+args[0], err)
+
+				err = render.Obj(cmd, flakiness, os.Stdout, renderer.TestFlakinessRenderer)
+				ui.ExitOnError("rendering obj", err)
+				return
+			}
+
+			tests, err := client.ListTests(strings.Join(selectors, ","))
+			ui.ExitOnError("getting all tests", err)
+
+			var flakinesses testkube.TestFlakinesses
+			for _, test := range tests {
+				flakiness, err := client.GetTestFlakiness(test.Name)
+				ui.ExitOnError("getting test flakiness: "+test.Name, err)
+
+				if all || flakiness.Flaky {
+					flakinesses = append(flakinesses, flakiness)
+				}
+			}
+
+			err = render.List(cmd, flakinesses, os.Stdout)
+			ui.ExitOnError("rendering list", err)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&selectors, "label", "l", nil, "label key value pair: --label key1=value1")
+	cmd.Flags().BoolVar(&all, "all", false, "list flakiness of all tests, not only the flaky ones")
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func ExecutionDiffRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
+	diff, ok := obj.(testkube.ExecutionDiff)
+	if !ok {
+		return fmt.Errorf("can't use '%T' as testkube.ExecutionDiff in RenderObj for execution diff", obj)
+	}
+
+	ui.Warn("A:", fmt.Sprintf("%s (%s)", diff.ExecutionNameA, diff.ExecutionIdA))
+	ui.Warn("B:", fmt.Sprintf("%s (%s)", diff.ExecutionNameB, diff.ExecutionIdB))
+
+	if diff.IsEmpty() {
+		ui.NL()
+		ui.Info("Executions don't differ")
+		return nil
+	}
+
+	sections := []struct {
+		title string
+		items []testkube.ExecutionDiffItem
+	}{
+		{title: "Execution:", items: diff.Fields},
+		{title: "Variables:", items: diff.Variables},
+		{title: "Steps:", items: diff.Steps},
+	}
+
+	for _, section := range sections {
+		if len(section.items) == 0 {
+			continue
+		}
+
+		ui.NL()
+		ui.Warn(section.title)
+		ui.Table(testkube.ExecutionDiffItems(section.items), ui.Writer)
+	}
+
+	if diff.Output != "" {
+		ui.NL()
+		ui.Warn("Output:")
+		fmt.Fprint(ui.Writer, diff.Output)
+	}
+
+	return nil
+}
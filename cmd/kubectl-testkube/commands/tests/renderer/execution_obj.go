@@ -21,9 +21,26 @@ func ExecutionRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
 	if execution.Number != 0 {
 		ui.Warn("Number:           ", fmt.Sprintf("%d", execution.Number))
 	}
+	if execution.RetryOf != "" {
+		ui.Warn("Attempt:          ", fmt.Sprintf("%d", execution.Attempt))
+		ui.Warn("Retry of:         ", execution.RetryOf)
+	}
+	if execution.ParentExecutionId != "" {
+		ui.Warn("Parent execution: ", execution.ParentExecutionId)
+		ui.Warn("Matrix:           ", testkube.MapToString(execution.Matrix))
+	}
+	if execution.Shards > 1 {
+		ui.Warn("Shards:           ", fmt.Sprintf("%d", execution.Shards))
+	}
 	ui.Warn("Test name:        ", execution.TestName)
 	ui.Warn("Type:             ", execution.TestType)
 	ui.Warn("Status:           ", string(*execution.ExecutionResult.Status))
+	if execution.PriorityClass != "" {
+		ui.Warn("Priority class:   ", string(execution.PriorityClass))
+	}
+	if execution.QueuePosition != 0 {
+		ui.Warn("Queue position:   ", fmt.Sprintf("%d", execution.QueuePosition))
+	}
 	ui.Warn("Start time:       ", execution.StartTime.String())
 	ui.Warn("End time:         ", execution.EndTime.String())
 	ui.Warn("Duration:         ", execution.Duration)
@@ -57,6 +74,12 @@ func ExecutionRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
 		ui.Warn("  Auth type:      ", execution.Content.Repository.AuthType)
 	}
 
+	if execution.ExecutionResult != nil && len(execution.ExecutionResult.Outputs) > 0 {
+		ui.Warn("Outputs:          ", testkube.MapToString(execution.ExecutionResult.Outputs))
+	}
+
+	renderer.RenderAnnotations(execution.Annotations)
+
 	render.RenderExecutionResult(client, &execution, false)
 
 	ui.NL()
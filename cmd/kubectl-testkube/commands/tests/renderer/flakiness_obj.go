@@ -0,0 +1,31 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func TestFlakinessRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
+	flakiness, ok := obj.(testkube.TestFlakiness)
+	if !ok {
+		return fmt.Errorf("can't use '%T' as testkube.TestFlakiness in RenderObj for test flakiness", obj)
+	}
+
+	ui.Warn("Test:       ", flakiness.TestName)
+	ui.Warn("Score:      ", fmt.Sprintf("%.2f (threshold %.2f)", flakiness.Score, flakiness.Threshold))
+	ui.Warn("Flaky:      ", fmt.Sprint(flakiness.Flaky))
+	ui.Warn("Quarantined:", fmt.Sprint(flakiness.Quarantined))
+	ui.Warn("Executions: ", fmt.Sprint(flakiness.Executions))
+	ui.Warn("Flips:      ", fmt.Sprint(flakiness.Flips))
+
+	if len(flakiness.TestCases) > 0 {
+		ui.NL()
+		ui.Warn("Flaky test cases:")
+		ui.Table(testkube.TestCaseFlakinesses(flakiness.TestCases), ui.Writer)
+	}
+
+	return nil
+}
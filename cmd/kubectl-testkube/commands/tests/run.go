@@ -67,6 +67,14 @@ func NewRunTestCmd() *cobra.Command {
 		argsMode                           string
 		artifactStorageBucket              string
 		artifactOmitFolderPerExecution     bool
+		retryMaxAttempts                   int32
+		retryBackoff                       string
+		retryBackoffFactor                 float64
+		retryStatuses                      []string
+		matrix                             []string
+		shards                             int32
+		priorityClass                      string
+		dryRun                             bool
 	)
 
 	cmd := &cobra.Command{
@@ -171,6 +179,44 @@ func NewRunTestCmd() *cobra.Command {
 				}
 			}
 
+			if len(matrix) != 0 {
+				options.Matrix, err = parseMatrix(matrix)
+				ui.ExitOnError("parsing matrix", err)
+				ui.ExitOnError("validating matrix", testkube.ValidateMatrix(options.Matrix))
+			}
+
+			if shards != 0 {
+				ui.ExitOnError("validating shards", testkube.ValidateShards(shards))
+				options.Shards = shards
+			}
+
+			if priorityClass != "" {
+				options.PriorityClass = testkube.PriorityClass(priorityClass)
+				ui.ExitOnError("validating priority class", testkube.ValidatePriorityClass(options.PriorityClass))
+			}
+
+			if dryRun {
+				if len(matrix) != 0 {
+					ui.Failf("dry run can't be used with matrix")
+				}
+
+				options.DryRun = true
+			}
+
+			if retryMaxAttempts != 0 {
+				options.RetryPolicy = &testkube.RetryPolicy{
+					MaxAttempts:   retryMaxAttempts,
+					Backoff:       retryBackoff,
+					BackoffFactor: retryBackoffFactor,
+				}
+
+				for _, status := range retryStatuses {
+					options.RetryPolicy.Statuses = append(options.RetryPolicy.Statuses, testkube.RetryStatus(status))
+				}
+
+				ui.ExitOnError("validating retry policy", options.RetryPolicy.Validate())
+			}
+
 			if cmd.Flag("negative-test").Changed {
 				options.NegativeTest = negativeTest
 				options.IsNegativeTestChangedOnRun = true
@@ -231,7 +277,19 @@ func NewRunTestCmd() *cobra.Command {
 				for i := 0; i < iterations; i++ {
 					execution, err := client.ExecuteTest(testName, name, options)
 					ui.ExitOnError("starting test execution "+namespacedName, err)
-					executions = append(executions, execution)
+					if execution.ParentExecutionId == "" {
+						executions = append(executions, execution)
+						continue
+					}
+
+					// matrix executions are started together, one of them is returned
+					matrixExecutions, err := client.ListMatrixExecutions(execution.ParentExecutionId)
+					ui.ExitOnError("getting matrix executions "+execution.ParentExecutionId, err)
+					for _, summary := range matrixExecutions.Results {
+						matrixExecution, err := client.GetExecution(summary.Id)
+						ui.ExitOnError("getting matrix execution "+summary.Id, err)
+						executions = append(executions, matrixExecution)
+					}
 				}
 			case len(selectors) != 0:
 				selector := strings.Join(selectors, ",")
@@ -241,8 +299,13 @@ func NewRunTestCmd() *cobra.Command {
 				ui.Failf("Pass Test name or labels to run by labels ")
 			}
 
+			if dryRun {
+				printDryRunManifests(executions)
+				return
+			}
+
 			var hasErrors bool
-			for _, execution := range executions {
+			for i, execution := range executions {
 				printExecutionDetails(execution)
 
 				if execution.ExecutionResult != nil && execution.ExecutionResult.ErrorMessage != "" {
@@ -258,6 +321,7 @@ func NewRunTestCmd() *cobra.Command {
 					ui.ExitOnError("getting recent execution data id:"+execution.Id, err)
 				}
 
+				executions[i] = execution
 				render.RenderExecutionResult(client, &execution, false)
 
 				if execution.Id != "" {
@@ -271,6 +335,12 @@ func NewRunTestCmd() *cobra.Command {
 				uiShellGetExecution(execution.Name)
 			}
 
+			if len(options.Matrix) != 0 {
+				ui.NL()
+				ui.Warn("Matrix executions:")
+				ui.Table(testkube.MatrixExecutions(executions), ui.Writer)
+			}
+
 			if hasErrors {
 				ui.ExitOnError("executions contain failed on errors")
 			}
@@ -327,6 +397,14 @@ func NewRunTestCmd() *cobra.Command {
 	cmd.Flags().StringVar(&runningContext, "context", "", "running context description for test execution")
 	cmd.Flags().StringVar(&artifactStorageBucket, "artifact-storage-bucket", "", "artifact storage class name for container executor")
 	cmd.Flags().BoolVarP(&artifactOmitFolderPerExecution, "artifact-omit-folder-per-execution", "", false, "don't store artifacts in execution folder")
+	cmd.Flags().StringArrayVar(&matrix, "matrix", []string{}, "matrix variable with comma separated values, one execution is started for each combination: --matrix region=eu,us")
+	cmd.Flags().Int32Var(&shards, "shards", 0, "number of pods running the test in parallel, each pod gets SHARD_INDEX and SHARD_TOTAL env variables")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print Kubernetes manifests of the execution without running it")
+	cmd.Flags().StringVar(&priorityClass, "priority-class", "", "priority class of the execution in the execution queue, one of ci|scheduled|trigger")
+	cmd.Flags().Int32Var(&retryMaxAttempts, "retry-max-attempts", 0, "maximum number of test execution attempts including the first one")
+	cmd.Flags().StringVar(&retryBackoff, "retry-backoff", "", "delay before the first retry, example: 30s")
+	cmd.Flags().Float64Var(&retryBackoffFactor, "retry-backoff-factor", 0, "multiplier applied to the delay before each next retry")
+	cmd.Flags().StringArrayVar(&retryStatuses, "retry-status", []string{}, "status of the attempt to retry, one of failed|timeout|error, all of them are retried when not set")
 
 	return cmd
 }
@@ -348,3 +426,18 @@ func uiShellWatchExecution(id string) {
 
 	ui.NL()
 }
+
+// printDryRunManifests prints rendered Kubernetes manifests of dry run executions as multi document YAML
+func printDryRunManifests(executions []testkube.Execution) {
+	for i, execution := range executions {
+		if execution.ExecutionResult != nil && execution.ExecutionResult.ErrorMessage != "" {
+			ui.Failf("rendering manifests of test %s: %s", execution.TestName, execution.ExecutionResult.ErrorMessage)
+		}
+
+		if i != 0 {
+			fmt.Println("---")
+		}
+
+		fmt.Print(execution.Manifests)
+	}
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	apiv1 "github.com/kubeshop/testkube/pkg/api/v1/client"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewGetTestCasesCmd() *cobra.Command {
+	var (
+		options  apiv1.ListTestCasesOptions
+		statuses []string
+	)
+
+	cmd := &cobra.Command{
+		Use:     "testcase [executionID]",
+		Aliases: []string{"testcases", "tc"},
+		Short:   "Lists test cases",
+		Long:    `Getting list of test cases parsed from JUnit reports, for given execution or across executions`,
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			if len(args) == 1 {
+				options.ExecutionID = args[0]
+			}
+
+			options.Statuses = strings.Join(statuses, ",")
+			testCases, err := client.ListTestCases(options)
+			ui.ExitOnError("getting test cases", err)
+
+			err = render.List(cmd, testCases, os.Stdout)
+			ui.ExitOnError("rendering list", err)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.TestName, "test", "", "", "test name")
+	cmd.Flags().StringVarP(&options.TestSuiteName, "test-suite", "", "", "test suite name")
+	cmd.Flags().StringVarP(&options.Name, "name", "", "", "test case name")
+	cmd.Flags().StringVarP(&options.Classname, "classname", "", "", "test case class name")
+	cmd.Flags().StringSliceVarP(&statuses, "status", "", nil, "test case status: passed, failed, error or skipped")
+	cmd.Flags().IntVarP(&options.Limit, "limit", "", 100, "records limit")
+
+	return cmd
+}
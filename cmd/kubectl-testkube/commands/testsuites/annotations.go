@@ -0,0 +1,47 @@
+package testsuites
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewAnnotateTestSuiteExecutionCmd() *cobra.Command {
+	var annotation testkube.ExecutionAnnotation
+
+	cmd := &cobra.Command{
+		Use:     "testsuiteexecution <executionName>",
+		Aliases: []string{"testsuiteexecutions", "tse", "ts-execution", "tsexecution"},
+		Short:   "Annotates test suite execution",
+		Long:    `Records triage conclusion of the test suite execution with its category and optional link to the external issue`,
+		Args:    validator.ExecutionName,
+		Run: func(cmd *cobra.Command, args []string) {
+			executionID := args[0]
+
+			if annotation.Author == "" {
+				annotation.Author = os.Getenv("USER")
+			}
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			annotation, err = client.AddTestSuiteExecutionAnnotation(executionID, annotation)
+			ui.ExitOnError(fmt.Sprintf("annotating test suite execution %s", executionID), err)
+			ui.SuccessAndExit("Succesfully annotated test suite execution", executionID, "annotation id:", annotation.Id)
+		},
+	}
+
+	cmd.Flags().StringVarP(&annotation.Text, "text", "t", "", "annotation text")
+	cmd.Flags().StringVar(&annotation.Author, "author", "", "author of the annotation, current user by default")
+	cmd.Flags().StringVar(&annotation.Category, "category", "", "annotation category, e.g. infra, product-bug or flaky")
+	cmd.Flags().StringVar(&annotation.IssueUrl, "issue-url", "", "link to the external issue")
+	_ = cmd.MarkFlagRequired("text")
+
+	return cmd
+}
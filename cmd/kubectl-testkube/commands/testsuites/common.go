@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -29,6 +30,10 @@ func printExecution(execution testkube.TestSuiteExecution, startTime time.Time)
 		ui.Warn("Status        :", string(*execution.Status))
 	}
 
+	if len(execution.QuarantinedTests) != 0 {
+		ui.Warn("Quarantined   :", strings.Join(execution.QuarantinedTests, ", "))
+	}
+
 	if execution.Id != "" {
 		ui.Warn("Duration:", execution.CalculateDuration().String()+"\n")
 		ui.Table(execution, os.Stdout)
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/render"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/renderer"
 	"github.com/kubeshop/testkube/pkg/api/v1/client"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/ui"
@@ -29,6 +30,8 @@ func TestSuiteExecutionRenderer(client client.Client, ui *ui.UI, obj interface{}
 		ui.Warn("Context:", execution.RunningContext.Context)
 	}
 
+	renderer.RenderAnnotations(execution.Annotations)
+
 	info, err := client.GetServerInfo()
 	ui.ExitOnError("getting server info", err)
 
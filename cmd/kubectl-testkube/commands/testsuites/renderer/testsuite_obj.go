@@ -85,18 +85,40 @@ func TestSuiteRenderer(client client.Client, ui *ui.UI, obj interface{}) error {
 	batches := append(ts.Before, ts.Steps...)
 	batches = append(batches, ts.After...)
 
+	if ts.IsStepGraph() {
+		ui.NL()
+		ui.Warn("Test steps graph:")
+		d := [][]string{{"Step", "Depends on", "Condition"}}
+		for _, batch := range batches {
+			for _, step := range batch.Execute {
+				d = append(d, []string{step.StepName(), strings.Join(step.DependsOn, ", "), step.Condition})
+			}
+		}
+
+		ui.Table(ui.NewArrayTable(d), ui.Writer)
+		ui.NL()
+
+		return nil
+	}
+
 	ui.NL()
 	ui.Warn("Test batches:", fmt.Sprintf("%d", len(batches)))
-	d := [][]string{{"Names", "Stop on failure"}}
+	d := [][]string{{"Names", "Stop on failure", "Condition"}}
 	for _, batch := range batches {
 		var names []string
 		for _, step := range batch.Execute {
-			names = append(names, step.FullName())
+			name := step.FullName()
+			if step.Condition != "" {
+				name = fmt.Sprintf("%s (%s)", name, step.Condition)
+			}
+
+			names = append(names, name)
 		}
 
 		d = append(d, []string{
 			fmt.Sprintf("[%s]", strings.Join(names, ", ")),
 			fmt.Sprintf("%v", batch.StopOnFailure),
+			batch.Condition,
 		})
 	}
 
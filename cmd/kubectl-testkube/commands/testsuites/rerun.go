@@ -0,0 +1,61 @@
+package testsuites
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common"
+	"github.com/kubeshop/testkube/cmd/kubectl-testkube/commands/common/validator"
+	"github.com/kubeshop/testkube/pkg/ui"
+)
+
+func NewRerunTestSuiteExecutionCmd() *cobra.Command {
+	var (
+		onlyFailed   bool
+		watchEnabled bool
+	)
+
+	cmd := &cobra.Command{
+		Use:     "testsuiteexecution <executionName>",
+		Aliases: []string{"tse", "testsuites-execution", "testsuite-execution"},
+		Short:   "Rerun test suite execution",
+		Long:    "Rerun test suite execution steps with the same variables, with --failed passed step results are reused",
+		Args:    validator.ExecutionName,
+		Run: func(cmd *cobra.Command, args []string) {
+			startTime := time.Now()
+			executionID := args[0]
+
+			client, _, err := common.GetClient(cmd)
+			ui.ExitOnError("getting client", err)
+
+			execution, err := client.RerunTestSuiteExecution(executionID, onlyFailed)
+			ui.ExitOnError(fmt.Sprintf("rerunning test suite execution %s", executionID), err)
+
+			if watchEnabled {
+				executionCh, err := client.WatchTestSuiteExecution(execution.Id)
+				for execution := range executionCh {
+					ui.ExitOnError("watching test execution", err)
+					printExecution(execution, startTime)
+				}
+			}
+
+			execution, err = client.GetTestSuiteExecution(execution.Id)
+			printExecution(execution, startTime)
+			ui.ExitOnError("getting recent execution data id:"+execution.Id, err)
+
+			uiPrintExecutionStatus(client, execution)
+
+			uiShellTestSuiteGetCommandBlock(execution.Id)
+			if !watchEnabled {
+				uiShellTestSuiteWatchCommandBlock(execution.Id)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&onlyFailed, "failed", false, "run again only failed, timed out and aborted steps, passed step results are reused")
+	cmd.Flags().BoolVarP(&watchEnabled, "watch", "f", false, "watch for changes after start")
+
+	return cmd
+}
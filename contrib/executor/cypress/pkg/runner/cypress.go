@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -150,6 +151,12 @@ func (r *CypressRunner) Run(ctx context.Context, execution testkube.Execution) (
 	result = MapJunitToExecutionResults(out, suites)
 	output.PrintLogf("%s Mapped Junit to Execution Results...", ui.IconCheckMark)
 
+	report, rerr := junitreport.ReadDir(junitReportDir)
+	if rerr != nil {
+		output.PrintLogf("%s Could not read Junit reports: %s", ui.IconWarning, rerr.Error())
+	}
+	junitreport.Attach(&result, report)
+
 	if steps := result.FailedSteps(); len(steps) > 0 {
 		output.PrintLogf("Test Failed steps")
 		for _, s := range steps {
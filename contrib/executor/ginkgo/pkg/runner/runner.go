@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -152,6 +153,12 @@ func (r *GinkgoRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	if serr == nil {
 		result = MapJunitToExecutionResults(out, suites)
 		output.PrintLogf("%s Mapped Junit to Execution Results...", ui.IconCheckMark)
+
+		report, rerr := junitreport.ReadFiles(filepath.Join(reportsPath, reportFile))
+		if rerr != nil {
+			output.PrintLogf("%s Could not read Junit report: %s", ui.IconWarning, rerr.Error())
+		}
+		junitreport.Attach(&result, report)
 	}
 
 	if execution.PostRunScript != "" && execution.ExecutePostRunScriptBeforeScraping {
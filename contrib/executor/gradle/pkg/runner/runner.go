@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -214,6 +215,12 @@ func (r *GradleRunner) Run(ctx context.Context, execution testkube.Execution) (r
 		return *result.Err(err), nil
 	}
 
+	report, err := junitreport.ReadDir(junitReportPath)
+	if err != nil {
+		output.PrintLogf("%s Could not read Junit reports: %s", ui.IconWarning, err.Error())
+	}
+	junitreport.Attach(&result, report)
+
 	return result, nil
 }
 
@@ -52,3 +52,61 @@ type Testcase struct {
 	Failure    *TestResult `xml:"failure,omitempty"`
 	Error      *TestResult `xml:"error,omitempty"`
 }
+
+// MapResultsToTestsuites maps CSV JTL results to junit report with a testcase for each sample
+func MapResultsToTestsuites(name string, results Results) Testsuites {
+	suite := Testsuite{Name: name}
+	for _, r := range results.Results {
+		testcase := Testcase{Name: r.Label, ClassName: name, Time: float32(r.Duration.Seconds())}
+		if !r.Success {
+			testcase.Failure = &TestResult{Message: r.Error, Type: r.ResponseCode}
+			suite.Failures++
+		}
+
+		suite.Testcases = append(suite.Testcases, testcase)
+		suite.Time += testcase.Time
+	}
+
+	return newTestsuites(name, suite)
+}
+
+// MapTestResultsToTestsuites maps XML JTL results to junit report with a testcase for each sample
+func MapTestResultsToTestsuites(name string, results TestResults) Testsuites {
+	suite := Testsuite{Name: name}
+	for _, r := range append(results.HTTPSamples, results.Samples...) {
+		testcase := Testcase{Name: r.Label, ClassName: name, Time: float32(r.Time) / 1000}
+		if !r.Success {
+			testcase.Failure = &TestResult{Type: r.ResponseCode}
+			if r.AssertionResult != nil {
+				testcase.Failure.Message = r.AssertionResult.FailureMessage
+			}
+			suite.Failures++
+		}
+
+		suite.Testcases = append(suite.Testcases, testcase)
+		suite.Time += testcase.Time
+	}
+
+	return newTestsuites(name, suite)
+}
+
+// MarshalTestsuites returns junit report as XML document
+func MarshalTestsuites(testsuites Testsuites) (string, error) {
+	data, err := xml.MarshalIndent(testsuites, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(data), nil
+}
+
+func newTestsuites(name string, suite Testsuite) Testsuites {
+	suite.Tests = len(suite.Testcases)
+	return Testsuites{
+		Testsuites: []Testsuite{suite},
+		Name:       name,
+		Tests:      suite.Tests,
+		Failures:   suite.Failures,
+		Time:       suite.Time,
+	}
+}
@@ -0,0 +1,56 @@
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/joshdk/go-junit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapResultsToTestsuites(t *testing.T) {
+	t.Parallel()
+
+	results := Results{
+		HasError: true,
+		Results: []Result{
+			{Success: true, Label: "Home page", ResponseCode: "200", Duration: 250 * time.Millisecond},
+			{Success: false, Label: "Login", ResponseCode: "401", Error: "Test failed: code expected to equal 200", Duration: time.Second},
+		},
+	}
+
+	report, err := MarshalTestsuites(MapResultsToTestsuites("jmeter-test", results))
+	assert.NoError(t, err)
+
+	suites, err := junit.Ingest([]byte(report))
+	assert.NoError(t, err)
+	assert.Len(t, suites, 1)
+	assert.Equal(t, "jmeter-test", suites[0].Name)
+	assert.Len(t, suites[0].Tests, 2)
+	assert.Equal(t, junit.StatusPassed, suites[0].Tests[0].Status)
+	assert.Equal(t, "Login", suites[0].Tests[1].Name)
+	assert.Equal(t, "jmeter-test", suites[0].Tests[1].Classname)
+	assert.Equal(t, junit.StatusFailed, suites[0].Tests[1].Status)
+	assert.Equal(t, "Test failed: code expected to equal 200", suites[0].Tests[1].Message)
+	assert.Equal(t, time.Second, suites[0].Tests[1].Duration)
+}
+
+func TestMapTestResultsToTestsuites(t *testing.T) {
+	t.Parallel()
+
+	results := TestResults{
+		HTTPSamples: []Example{
+			{Time: 1500, Success: false, Label: "Login", ResponseCode: "401", AssertionResult: &AssertionResult{FailureMessage: "expected 200"}},
+		},
+		Samples: []Example{
+			{Time: 10, Success: true, Label: "Think time"},
+		},
+	}
+
+	testsuites := MapTestResultsToTestsuites("jmeter-test", results)
+
+	assert.Equal(t, 2, testsuites.Tests)
+	assert.Equal(t, 1, testsuites.Failures)
+	assert.Equal(t, "expected 200", testsuites.Testsuites[0].Testcases[0].Failure.Message)
+	assert.Nil(t, testsuites.Testsuites[0].Testcases[1].Failure)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -183,6 +184,7 @@ func (r *JMeterRunner) Run(ctx context.Context, execution testkube.Execution) (r
 	f.Close()
 
 	var executionResult testkube.ExecutionResult
+	var testsuites parser.Testsuites
 	if err != nil {
 		data, err := os.ReadFile(jtlPath)
 		if err != nil {
@@ -195,12 +197,20 @@ func (r *JMeterRunner) Run(ctx context.Context, execution testkube.Execution) (r
 		}
 
 		executionResult = MapTestResultsToExecutionResults(out, testResults)
+		testsuites = parser.MapTestResultsToTestsuites(execution.TestName, testResults)
 	} else {
 		executionResult = MapResultsToExecutionResults(out, results)
+		testsuites = parser.MapResultsToTestsuites(execution.TestName, results)
 	}
 
 	output.PrintLogf("%s Mapped JMeter results to Execution Results...", ui.IconCheckMark)
 
+	report, err := parser.MarshalTestsuites(testsuites)
+	if err != nil {
+		output.PrintLogf("%s Could not create Junit report: %s", ui.IconWarning, err.Error())
+	}
+	junitreport.Attach(&executionResult, report)
+
 	if execution.PostRunScript != "" && execution.ExecutePostRunScriptBeforeScraping {
 		output.PrintLog(fmt.Sprintf("%s Running post run script...", ui.IconCheckMark))
 
@@ -23,6 +23,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -223,6 +224,7 @@ func (r *JMeterDRunner) Run(ctx context.Context, execution testkube.Execution) (
 	f.Close()
 
 	var executionResult testkube.ExecutionResult
+	var testsuites parser.Testsuites
 	if err != nil {
 		data, err := os.ReadFile(jtlPath)
 		if err != nil {
@@ -235,12 +237,20 @@ func (r *JMeterDRunner) Run(ctx context.Context, execution testkube.Execution) (
 		}
 
 		executionResult = mapTestResultsToExecutionResults(out, testResults)
+		testsuites = parser.MapTestResultsToTestsuites(execution.TestName, testResults)
 	} else {
 		executionResult = mapResultsToExecutionResults(out, results)
+		testsuites = parser.MapResultsToTestsuites(execution.TestName, results)
 	}
 
 	output.PrintLogf("%s Mapped JMeter results to Execution Results...", ui.IconCheckMark)
 
+	report, err := parser.MarshalTestsuites(testsuites)
+	if err != nil {
+		output.PrintLogf("%s Could not create Junit report: %s", ui.IconWarning, err.Error())
+	}
+	junitreport.Attach(&executionResult, report)
+
 	if execution.PostRunScript != "" && execution.ExecutePostRunScriptBeforeScraping {
 		output.PrintLog(fmt.Sprintf("%s Running post run script...", ui.IconCheckMark))
 
@@ -21,6 +21,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/runner"
 	"github.com/kubeshop/testkube/pkg/executor/scraper"
 	"github.com/kubeshop/testkube/pkg/executor/scraper/factory"
+	"github.com/kubeshop/testkube/pkg/junitreport"
 	"github.com/kubeshop/testkube/pkg/ui"
 )
 
@@ -234,6 +235,12 @@ func (r *MavenRunner) Run(ctx context.Context, execution testkube.Execution) (re
 		return *result.Err(err), nil
 	}
 
+	report, err := junitreport.ReadDir(junitReportPath)
+	if err != nil {
+		outputPkg.PrintLogf("%s Could not read Junit reports: %s", ui.IconWarning, err.Error())
+	}
+	junitreport.Attach(&result, report)
+
 	return result, nil
 }
 
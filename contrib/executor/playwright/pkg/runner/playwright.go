@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -101,6 +102,8 @@ func (r *PlaywrightRunner) Run(ctx context.Context, execution testkube.Execution
 		args[i] = os.ExpandEnv(args[i])
 	}
 
+	args = addShardArg(args, r.Params.ShardIndex, r.Params.ShardTotal)
+
 	envManager := env.NewManagerWithVars(execution.Variables)
 	envManager.GetReferenceVars(envManager.Variables)
 
@@ -184,3 +187,18 @@ func scrapeArtifacts(ctx context.Context, r *PlaywrightRunner, execution testkub
 
 	return nil
 }
+
+// addShardArg adds Playwright shard argument for sharded executions, unless it's already provided in args
+func addShardArg(args []string, shardIndex, shardTotal int) []string {
+	if shardTotal <= 1 {
+		return args
+	}
+
+	for _, arg := range args {
+		if arg == "--shard" || strings.HasPrefix(arg, "--shard=") {
+			return args
+		}
+	}
+
+	return append(args, fmt.Sprintf("--shard=%d/%d", shardIndex+1, shardTotal))
+}
@@ -59,3 +59,25 @@ func TestRun_Integration(t *testing.T) {
 	assert.Equal(t, result.Status, testkube.ExecutionStatusPassed)
 	assert.NoError(t, err)
 }
+
+func TestAddShardArg(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds shard for sharded execution", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"playwright", "test", "--shard=2/3"}, addShardArg([]string{"playwright", "test"}, 1, 3))
+	})
+
+	t.Run("keeps shard provided in args", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"playwright", "test", "--shard=1/2"}, addShardArg([]string{"playwright", "test", "--shard=1/2"}, 1, 3))
+	})
+
+	t.Run("skips not sharded execution", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []string{"playwright", "test"}, addShardArg([]string{"playwright", "test"}, 0, 0))
+	})
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// AddExecutionAnnotationHandler adds triage annotation to the test execution
+func (s *TestkubeAPI) AddExecutionAnnotationHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to annotate execution %s", executionID)
+
+		annotation, err := s.getAnnotationFromRequest(c)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		execution, err := s.ExecutionResults.Get(ctx, executionID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: execution not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to get execution: %w", errPrefix, err))
+		}
+
+		if err = s.ExecutionResults.AddAnnotation(ctx, execution.Id, annotation); err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to store annotation: %w", errPrefix, err))
+		}
+
+		s.notifyAnnotatedExecution(ctx, execution.Id)
+
+		c.Status(http.StatusCreated)
+		return c.JSON(annotation)
+	}
+}
+
+// DeleteExecutionAnnotationHandler deletes triage annotation from the test execution
+func (s *TestkubeAPI) DeleteExecutionAnnotationHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		annotationID := c.Params("annotationID")
+		errPrefix := fmt.Sprintf("failed to delete annotation %s of execution %s", annotationID, executionID)
+
+		execution, err := s.ExecutionResults.Get(ctx, executionID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: execution not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to get execution: %w", errPrefix, err))
+		}
+
+		err = s.ExecutionResults.DeleteAnnotation(ctx, execution.Id, annotationID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: annotation not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		s.notifyAnnotatedExecution(ctx, execution.Id)
+
+		c.Status(http.StatusNoContent)
+		return nil
+	}
+}
+
+// AddTestSuiteExecutionAnnotationHandler adds triage annotation to the test suite execution
+func (s *TestkubeAPI) AddTestSuiteExecutionAnnotationHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to annotate test suite execution %s", executionID)
+
+		annotation, err := s.getAnnotationFromRequest(c)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		execution, err := s.TestExecutionResults.Get(ctx, executionID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: test suite execution not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to get test suite execution: %w", errPrefix, err))
+		}
+
+		if err = s.TestExecutionResults.AddAnnotation(ctx, execution.Id, annotation); err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to store annotation: %w", errPrefix, err))
+		}
+
+		s.notifyAnnotatedTestSuiteExecution(ctx, execution.Id)
+
+		c.Status(http.StatusCreated)
+		return c.JSON(annotation)
+	}
+}
+
+// DeleteTestSuiteExecutionAnnotationHandler deletes triage annotation from the test suite execution
+func (s *TestkubeAPI) DeleteTestSuiteExecutionAnnotationHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		annotationID := c.Params("annotationID")
+		errPrefix := fmt.Sprintf("failed to delete annotation %s of test suite execution %s", annotationID, executionID)
+
+		execution, err := s.TestExecutionResults.Get(ctx, executionID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: test suite execution not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to get test suite execution: %w", errPrefix, err))
+		}
+
+		err = s.TestExecutionResults.DeleteAnnotation(ctx, execution.Id, annotationID)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: annotation not found", errPrefix))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		s.notifyAnnotatedTestSuiteExecution(ctx, execution.Id)
+
+		c.Status(http.StatusNoContent)
+		return nil
+	}
+}
+
+// notifyAnnotatedExecution emits updated event with the current annotations of the test execution
+func (s *TestkubeAPI) notifyAnnotatedExecution(ctx context.Context, id string) {
+	execution, err := s.ExecutionResults.Get(ctx, id)
+	if err != nil {
+		s.Log.Errorw("can't get annotated execution", "id", id, "error", err)
+		return
+	}
+
+	s.Events.Notify(testkube.NewEventUpdatedTestExecution(&execution))
+}
+
+// notifyAnnotatedTestSuiteExecution emits updated event with the current annotations of the test suite execution
+func (s *TestkubeAPI) notifyAnnotatedTestSuiteExecution(ctx context.Context, id string) {
+	execution, err := s.TestExecutionResults.Get(ctx, id)
+	if err != nil {
+		s.Log.Errorw("can't get annotated test suite execution", "id", id, "error", err)
+		return
+	}
+
+	s.Events.Notify(testkube.NewEventUpdatedTestSuiteExecution(&execution))
+}
+
+// getAnnotationFromRequest parses and validates annotation from the request body
+func (s *TestkubeAPI) getAnnotationFromRequest(c *fiber.Ctx) (annotation testkube.ExecutionAnnotation, err error) {
+	if err = c.BodyParser(&annotation); err != nil {
+		return annotation, fmt.Errorf("could not parse json request: %w", err)
+	}
+
+	if err = annotation.Validate(); err != nil {
+		return annotation, err
+	}
+
+	return testkube.NewExecutionAnnotation(annotation), nil
+}
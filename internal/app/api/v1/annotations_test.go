@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_ExecutionAnnotationHandlers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	app := fiber.New()
+	resultRepo := result.NewMockRepository(mockCtrl)
+	eventBus := bus.NewEventBusMock()
+	events := make(chan testkube.Event, 1)
+	assert.NoError(t, eventBus.SubscribeTopic("events.>", "annotations", func(e testkube.Event) error {
+		events <- e
+		return nil
+	}))
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+		ExecutionResults: resultRepo,
+		Events:           event.NewEmitter(eventBus, "", nil),
+	}
+	app.Post("/executions/:executionID/annotations", s.AddExecutionAnnotationHandler())
+	app.Delete("/executions/:executionID/annotations/:annotationID", s.DeleteExecutionAnnotationHandler())
+
+	t.Run("adds annotation to execution found by name", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "test-1").Return(testkube.Execution{Id: "1", Name: "test-1"}, nil)
+		resultRepo.EXPECT().AddAnnotation(gomock.Any(), "1", gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ string, annotation testkube.ExecutionAnnotation) error {
+				assert.NotEmpty(t, annotation.Id)
+				assert.False(t, annotation.CreatedAt.IsZero())
+				assert.Equal(t, "infra", annotation.Category)
+				return nil
+			})
+		resultRepo.EXPECT().Get(gomock.Any(), "1").
+			Return(testkube.Execution{Id: "1", Name: "test-1", Annotations: []testkube.ExecutionAnnotation{{Id: "a1"}}}, nil)
+
+		req := httptest.NewRequest("POST", "/executions/test-1/annotations",
+			strings.NewReader(`{"text":"node was evicted","author":"on-call","category":"infra"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var annotation testkube.ExecutionAnnotation
+		assert.Equal(t, 201, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&annotation))
+		assert.Equal(t, "node was evicted", annotation.Text)
+		assert.Equal(t, "on-call", annotation.Author)
+
+		e := <-events
+		assert.Equal(t, testkube.UPDATED_EventType, e.Type())
+		assert.Equal(t, "1", e.ResourceId)
+		assert.Len(t, e.TestExecution.Annotations, 1)
+	})
+
+	t.Run("rejects annotation without text", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/executions/1/annotations", strings.NewReader(`{"category":"infra"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("rejects invalid issue url", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/executions/1/annotations", strings.NewReader(`{"text":"bug","issueUrl":"issue-1"}`))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("deletes annotation", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "1").Return(testkube.Execution{Id: "1"}, nil).Times(2)
+		resultRepo.EXPECT().DeleteAnnotation(gomock.Any(), "1", "a1").Return(nil)
+
+		req := httptest.NewRequest("DELETE", "/executions/1/annotations/a1", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 204, resp.StatusCode)
+
+		e := <-events
+		assert.Equal(t, testkube.UPDATED_EventType, e.Type())
+		assert.Empty(t, e.TestExecution.Annotations)
+	})
+
+	t.Run("deleting unknown annotation returns not found", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "1").Return(testkube.Execution{Id: "1"}, nil)
+		resultRepo.EXPECT().DeleteAnnotation(gomock.Any(), "1", "a2").Return(mongo.ErrNoDocuments)
+
+		req := httptest.NewRequest("DELETE", "/executions/1/annotations/a2", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+}
@@ -3,11 +3,13 @@ package v1
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/retention"
 )
 
 // GetConfigsHandler returns configuration
@@ -46,6 +48,24 @@ func (s TestkubeAPI) UpdateConfigsHandler() fiber.Handler {
 		}
 		s.Log.Warnw("#######", "request", config)
 		config.EnableTelemetry = request.EnableTelemetry
+		if request.RetentionPolicies != nil {
+			if err = testkube.RetentionPolicies(request.RetentionPolicies).Validate(); err != nil {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid retention policies: %w", errPrefix, err))
+			}
+			config.RetentionPolicies = request.RetentionPolicies
+		}
+		if request.Quarantine != nil {
+			if err = request.Quarantine.Validate(); err != nil {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid quarantine: %w", errPrefix, err))
+			}
+			config.Quarantine = request.Quarantine
+		}
+		if request.Queue != nil {
+			if err = request.Queue.Validate(); err != nil {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid queue: %w", errPrefix, err))
+			}
+			config.Queue = request.Queue
+		}
 		s.Log.Warnw("#######", "request", config)
 		_, err = s.ConfigMap.Upsert(ctx, config)
 		if err != nil {
@@ -54,3 +74,22 @@ func (s TestkubeAPI) UpdateConfigsHandler() fiber.Handler {
 		return c.JSON(config)
 	}
 }
+
+// EnforceRetentionHandler deletes executions not kept by retention policies
+func (s TestkubeAPI) EnforceRetentionHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to enforce retention policies"
+		dryRun, err := strconv.ParseBool(c.Query("dryRun", "false"))
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid dryRun value: %w", errPrefix, err))
+		}
+
+		janitor := retention.NewJanitor(s.ConfigMap, s.ExecutionResults, s.TestExecutionResults, s.TestCaseResults, s.Storage, s.Log)
+		report, err := janitor.Enforce(c.Context(), dryRun)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		return c.JSON(report)
+	}
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/executionarchive"
+)
+
+// ExportExecutionsHandler streams archive with executions matching the filter, their outputs and optionally artifacts
+func (s *TestkubeAPI) ExportExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		filter := getFilterFromRequest(c)
+		artifacts, err := strconv.ParseBool(c.Query("artifacts", "false"))
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("failed to export executions: invalid artifacts value: %w", err))
+		}
+
+		c.Attachment(fmt.Sprintf("executions-%s.tar.gz", time.Now().UTC().Format("20060102-150405")))
+		c.Set(fiber.HeaderContentType, "application/gzip")
+		ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+			exporter := executionarchive.NewExporter(s.ExecutionResults, s.TestExecutionResults, s.artifactsStorage)
+			report, err := exporter.Export(ctx, w, filter, artifacts)
+			if err != nil {
+				// the response is already being sent, so the broken archive is the only signal for the client
+				s.Log.Errorw("exporting executions error", "error", err)
+			} else {
+				s.Log.Infow("executions exported", "executions", report.Executions,
+					"testSuiteExecutions", report.TestSuiteExecutions, "artifacts", report.Artifacts)
+			}
+
+			_ = w.Flush()
+		})
+
+		return nil
+	}
+}
+
+// ImportExecutionsHandler imports executions from the archive streamed in the request body
+func (s *TestkubeAPI) ImportExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// the body is streamed when the server has request body streaming enabled
+		body := c.Context().RequestBodyStream()
+		if body == nil {
+			body = bytes.NewReader(c.Body())
+		}
+
+		importer := executionarchive.NewImporter(s.ExecutionResults, s.TestExecutionResults, s.artifactsStorage)
+		report, err := importer.Import(c.Context(), body)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("failed to import executions: %w", err))
+		}
+
+		s.Log.Infow("executions imported", "executions", report.Executions, "testSuiteExecutions", report.TestSuiteExecutions,
+			"artifacts", report.Artifacts, "skippedExecutions", len(report.SkippedExecutions),
+			"skippedTestSuiteExecutions", len(report.SkippedTestSuiteExecutions))
+		return c.JSON(report)
+	}
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_ExportImportExecutionsHandlers(t *testing.T) {
+	newAPI := func(t *testing.T) (*fiber.App, *result.SQLRepository) {
+		db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		// archives larger than the body limit are streamed
+		app := fiber.New(fiber.Config{StreamRequestBody: true, BodyLimit: 16})
+		resultRepo := result.NewSQLRepository(db)
+		s := &TestkubeAPI{
+			HTTPServer: server.HTTPServer{
+				Mux: app,
+				Log: log.DefaultLogger,
+			},
+			ExecutionResults:     resultRepo,
+			TestExecutionResults: testresult.NewSQLRepository(db),
+		}
+		app.Get("/executions/export", s.ExportExecutionsHandler())
+		app.Post("/executions/import", s.ImportExecutionsHandler())
+		return app, resultRepo
+	}
+
+	source, sourceRepo := newAPI(t)
+	require.NoError(t, sourceRepo.Insert(context.Background(), testkube.Execution{Id: "1", Name: "test-1", TestName: "test", Number: 1,
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed, Output: "ok"}}))
+	require.NoError(t, sourceRepo.Insert(context.Background(), testkube.Execution{Id: "2", Name: "other-1", TestName: "other", Number: 1,
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed}}))
+
+	resp, err := source.Test(httptest.NewRequest("GET", "/executions/export?testName=test", nil), -1)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "application/gzip", resp.Header.Get("Content-Type"))
+	archive, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	target, targetRepo := newAPI(t)
+	resp, err = target.Test(httptest.NewRequest("POST", "/executions/import", bytes.NewReader(archive)), -1)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var report testkube.ExecutionsArchiveReport
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	assert.Equal(t, testkube.ExecutionsArchiveReport{Executions: 1}, report)
+
+	execution, err := targetRepo.Get(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", execution.ExecutionResult.Output)
+
+	resp, err = target.Test(httptest.NewRequest("POST", "/executions/import", bytes.NewReader([]byte("invalid"))), -1)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/executiondiff"
+	"github.com/kubeshop/testkube/pkg/types"
+)
+
+// DiffExecutionsHandler compares two test executions, e.g. the last passed and the first failed one
+func (s *TestkubeAPI) DiffExecutionsHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		executionID := c.Params("executionID")
+		otherExecutionID := c.Params("otherExecutionID")
+		errPrefix := fmt.Sprintf("failed to compare executions %s and %s", executionID, otherExecutionID)
+
+		var executions [2]testkube.Execution
+		for i, id := range []string{executionID, otherExecutionID} {
+			execution, err := s.ExecutionResults.Get(ctx, id)
+			if err == mongo.ErrNoDocuments {
+				return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: execution %s not found", errPrefix, id))
+			}
+			if err != nil {
+				return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: db client was unable to get execution %s: %w", errPrefix, id, err))
+			}
+
+			execution.Duration = types.FormatDuration(execution.Duration)
+			executions[i] = execution
+		}
+
+		diff, err := executiondiff.Compare(executions[0], executions[1])
+		if errors.Is(err, executiondiff.ErrOutputTooLarge) {
+			return s.Error(c, http.StatusRequestEntityTooLarge, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		return c.JSON(diff)
+	}
+}
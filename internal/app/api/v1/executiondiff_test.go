@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/executiondiff"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_DiffExecutionsHandler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	app := fiber.New()
+	resultRepo := result.NewMockRepository(mockCtrl)
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+		ExecutionResults: resultRepo,
+	}
+	app.Get("/executions/:executionID/diff/:otherExecutionID", s.DiffExecutionsHandler())
+
+	t.Run("compares executions", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "1").Return(testkube.Execution{Id: "1", Name: "test-1",
+			ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed, Output: "ok\n"}}, nil)
+		resultRepo.EXPECT().Get(gomock.Any(), "2").Return(testkube.Execution{Id: "2", Name: "test-2",
+			ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusFailed, Output: "failed\n"}}, nil)
+
+		req := httptest.NewRequest("GET", "/executions/1/diff/2", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var diff testkube.ExecutionDiff
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&diff))
+		assert.Equal(t, []testkube.ExecutionDiffItem{{Name: "status", A: "passed", B: "failed"}}, diff.Fields)
+		assert.Equal(t, "--- test-1\n+++ test-2\n@@ -1 +1 @@\n-ok\n+failed\n", diff.Output)
+	})
+
+	t.Run("output too large", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "1").Return(testkube.Execution{Id: "1",
+			ExecutionResult: &testkube.ExecutionResult{Output: strings.Repeat("x", executiondiff.MaxOutputSize+1)}}, nil)
+		resultRepo.EXPECT().Get(gomock.Any(), "2").Return(testkube.Execution{Id: "2"}, nil)
+
+		req := httptest.NewRequest("GET", "/executions/1/diff/2", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 413, resp.StatusCode)
+	})
+
+	t.Run("execution not found", func(t *testing.T) {
+		resultRepo.EXPECT().Get(gomock.Any(), "1").Return(testkube.Execution{Id: "1"}, nil)
+		resultRepo.EXPECT().Get(gomock.Any(), "3").Return(testkube.Execution{}, mongo.ErrNoDocuments)
+
+		req := httptest.NewRequest("GET", "/executions/1/diff/3", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 404, resp.StatusCode)
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor/output"
 	"github.com/kubeshop/testkube/pkg/scheduler"
 	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/local"
 	"github.com/kubeshop/testkube/pkg/storage/minio"
 	"github.com/kubeshop/testkube/pkg/types"
 	"github.com/kubeshop/testkube/pkg/workerpool"
@@ -57,6 +58,28 @@ func (s *TestkubeAPI) ExecuteTestsHandler() fiber.Handler {
 			}
 		}
 
+		if err = testkube.ValidateMatrix(request.Matrix); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid matrix: %w", errPrefix, err))
+		}
+
+		if request.DryRun && len(request.Matrix) != 0 {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: dry run can't be used with matrix", errPrefix))
+		}
+
+		if err = testkube.ValidateShards(request.Shards); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid shards: %w", errPrefix, err))
+		}
+
+		if err = testkube.ValidatePriorityClass(request.PriorityClass); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid priority class: %w", errPrefix, err))
+		}
+
+		if request.RetryPolicy != nil {
+			if err = request.RetryPolicy.Validate(); err != nil {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid retry policy: %w", errPrefix, err))
+			}
+		}
+
 		id := c.Params("id")
 
 		var tests []testsv3.Test
@@ -102,12 +125,14 @@ func (s *TestkubeAPI) ExecuteTestsHandler() fiber.Handler {
 		}
 
 		if id != "" && len(results) != 0 {
-			if results[0].ExecutionResult.IsFailed() {
+			// matrix executions are available by the parent execution id of the returned one
+			execution, ok := getStartedExecution(results)
+			if !ok {
 				return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: execution failed: %s", errPrefix, results[0].ExecutionResult.ErrorMessage))
 			}
 
 			c.Status(http.StatusCreated)
-			return c.JSON(results[0])
+			return c.JSON(execution)
 		}
 
 		c.Status(http.StatusCreated)
@@ -115,6 +140,18 @@ func (s *TestkubeAPI) ExecuteTestsHandler() fiber.Handler {
 	}
 }
 
+// getStartedExecution returns the first execution which didn't fail to start, executions of the matrix
+// fail only when all of them failed
+func getStartedExecution(executions []testkube.Execution) (testkube.Execution, bool) {
+	for _, execution := range executions {
+		if execution.ExecutionResult == nil || !execution.ExecutionResult.IsFailed() {
+			return execution, true
+		}
+	}
+
+	return testkube.Execution{}, false
+}
+
 // ListExecutionsHandler returns array of available test executions
 func (s *TestkubeAPI) ListExecutionsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -125,12 +162,18 @@ func (s *TestkubeAPI) ListExecutionsHandler() fiber.Handler {
 		// or should id be a query string as it's some kind of filter?
 
 		filter := getFilterFromRequest(c)
+		if acceptsNDJSON(c) {
+			return s.streamExecutions(c, filter)
+		}
 
 		executions, err := s.ExecutionResults.GetExecutions(c.Context(), filter)
 		if err != nil {
 			if err == mongo.ErrNoDocuments {
 				return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: db found no execution results: %w", errPrefix, err))
 			}
+			if isInvalidCursor(err) {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+			}
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: db client failed to get execution results: %w", errPrefix, err))
 		}
 
@@ -153,6 +196,7 @@ func (s *TestkubeAPI) ListExecutionsHandler() fiber.Handler {
 			Totals:   &executionTotals,
 			Filtered: &filteredTotals,
 			Results:  mapExecutionsToExecutionSummary(executions),
+			Next:     nextExecutionsCursor(executions, filter.PageSize()),
 		}
 
 		return c.JSON(results)
@@ -270,6 +314,9 @@ func (s *TestkubeAPI) GetExecutionHandler() fiber.Handler {
 		}
 
 		execution.Duration = types.FormatDuration(execution.Duration)
+		if execution.IsQueued() && s.scheduler != nil {
+			execution.QueuePosition = s.scheduler.GetQueuePosition(ctx, execution.Id)
+		}
 
 		testSecretMap := make(map[string]string)
 		if execution.TestSecretUUID != "" {
@@ -627,6 +674,10 @@ func (s *TestkubeAPI) getArtifactStorage(bucket string) (storage.ArtifactsStorag
 		return s.artifactsStorage, nil
 	}
 
+	if localClient, ok := s.Storage.(*local.Client); ok {
+		return local.NewLocalArtifactClient(local.NewClient(localClient.Dir(), bucket)), nil
+	}
+
 	minioClient := minio.NewClient(
 		s.storageParams.Endpoint,
 		s.storageParams.AccessKeyId,
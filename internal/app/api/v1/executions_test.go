@@ -25,6 +25,22 @@ import (
 	"github.com/kubeshop/testkube/pkg/server"
 )
 
+func TestGetStartedExecution(t *testing.T) {
+	failed := testkube.Execution{Id: "1", ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusFailed}}
+	running := testkube.Execution{Id: "2", ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusRunning}}
+
+	t.Run("returns execution which started", func(t *testing.T) {
+		execution, ok := getStartedExecution([]testkube.Execution{failed, running})
+		assert.True(t, ok)
+		assert.Equal(t, "2", execution.Id)
+	})
+
+	t.Run("fails only when all executions failed", func(t *testing.T) {
+		_, ok := getStartedExecution([]testkube.Execution{failed, failed})
+		assert.False(t, ok)
+	})
+}
+
 func TestTestkubeAPI_ExecutionLogsHandler(t *testing.T) {
 	app := fiber.New()
 	resultRepo := MockExecutionResultsRepository{}
@@ -203,6 +219,30 @@ func (r MockExecutionResultsRepository) DeleteForAllTestSuites(ctx context.Conte
 	panic("not implemented")
 }
 
+func (r MockExecutionResultsRepository) DeleteByIds(ctx context.Context, ids []string) error {
+	panic("not implemented")
+}
+
+func (r MockExecutionResultsRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	panic("not implemented")
+}
+
+func (r MockExecutionResultsRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	panic("not implemented")
+}
+
+func (r MockExecutionResultsRepository) AdmitExecution(ctx context.Context, id string) (bool, error) {
+	panic("not implemented")
+}
+
+func (r MockExecutionResultsRepository) AbortQueuedExecution(ctx context.Context, id string) (bool, error) {
+	panic("not implemented")
+}
+
+func (r MockExecutionResultsRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	panic("not implemented")
+}
+
 func (r MockExecutionResultsRepository) GetTestMetrics(ctx context.Context, name string, limit, last int) (testkube.ExecutionsMetrics, error) {
 	panic("not implemented")
 }
@@ -219,6 +259,14 @@ func (e MockExecutor) Abort(ctx context.Context, execution *testkube.Execution)
 	panic("not implemented")
 }
 
+func (e MockExecutor) Reattach(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) (*testkube.ExecutionResult, error) {
+	panic("not implemented")
+}
+
+func (e MockExecutor) DryRun(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) ([]runtime.Object, error) {
+	panic("not implemented")
+}
+
 func (e MockExecutor) Logs(ctx context.Context, id string) (chan output.Output, error) {
 	if e.LogsFn == nil {
 		panic("not implemented")
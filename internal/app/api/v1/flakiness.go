@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/flakiness"
+)
+
+// TestFlakinessHandler returns flakiness score of the test and its flaky test cases, computed with the quarantine policy
+func (s TestkubeAPI) TestFlakinessHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		testName := c.Params("id")
+		errPrefix := fmt.Sprintf("failed to get flakiness of test %s", testName)
+
+		config, err := s.ConfigMap.Get(ctx)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: unable to get config: %w", errPrefix, err))
+		}
+
+		testFlakiness, err := flakiness.NewAnalyzer(s.ExecutionResults, s.TestCaseResults).GetTestFlakiness(ctx, testName, config.Quarantine)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		return c.JSON(testFlakiness)
+	}
+}
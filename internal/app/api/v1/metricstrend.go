@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+// getMetricsGranularity returns granularity of the metrics trend requested in the query, empty when the trend is not requested
+func getMetricsGranularity(c *fiber.Ctx) (testkube.MetricsGranularity, error) {
+	granularity := testkube.MetricsGranularity(c.Query("granularity"))
+	switch granularity {
+	case "", testkube.HOUR_MetricsGranularity, testkube.DAY_MetricsGranularity:
+		return granularity, nil
+	}
+
+	return "", fmt.Errorf("unsupported granularity %s, use %s or %s", granularity,
+		testkube.HOUR_MetricsGranularity, testkube.DAY_MetricsGranularity)
+}
+
+// withMetricsTrend adds trend of all the executions to the metrics and limits the returned executions afterwards,
+// metrics are expected to be read without the limit
+func withMetricsTrend(metrics testkube.ExecutionsMetrics, granularity testkube.MetricsGranularity, groupBy string,
+	limit int) testkube.ExecutionsMetrics {
+	metrics.Trend = common.CalculateTrend(metrics.Executions, granularity, groupBy)
+	if limit > 0 && limit < len(metrics.Executions) {
+		metrics.Executions = metrics.Executions[:limit]
+	}
+
+	return metrics
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_TestMetricsHandler_Trend(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	app := fiber.New()
+	resultRepo := result.NewMockRepository(mockCtrl)
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+		ExecutionResults: resultRepo,
+	}
+	app.Get("/tests/:id/metrics", s.TestMetricsHandler())
+
+	day := time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("returns trend of all executions and limits executions", func(t *testing.T) {
+		resultRepo.EXPECT().GetTestMetrics(gomock.Any(), "test", 0, 7).Return(testkube.ExecutionsMetrics{
+			Executions: []testkube.ExecutionsMetricsExecutions{
+				{Name: "test-3", Status: "passed", StartTime: day.Add(25 * time.Hour)},
+				{Name: "test-2", Status: "failed", StartTime: day.Add(2 * time.Hour)},
+				{Name: "test-1", Status: "passed", StartTime: day.Add(time.Hour)},
+			},
+		}, nil)
+
+		req := httptest.NewRequest("GET", "/tests/test/metrics?granularity=day&limit=1", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var metrics testkube.ExecutionsMetrics
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&metrics))
+		assert.Len(t, metrics.Executions, 1)
+		assert.Len(t, metrics.Trend, 2)
+		assert.True(t, metrics.Trend[0].StartTime.Equal(day))
+		assert.Equal(t, int32(2), metrics.Trend[0].TotalExecutions)
+		assert.Equal(t, int32(1), metrics.Trend[0].FailedExecutions)
+	})
+
+	t.Run("rejects unsupported granularity", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/tests/test/metrics?granularity=week", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+}
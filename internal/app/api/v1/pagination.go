@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+// NDJSONContentType is content type of executions streamed as newline delimited JSON
+const NDJSONContentType = "application/x-ndjson"
+
+// acceptsNDJSON checks if the client asked for streaming the list as newline delimited JSON
+func acceptsNDJSON(c *fiber.Ctx) bool {
+	return strings.Contains(c.Get(fiber.HeaderAccept), NDJSONContentType)
+}
+
+// isInvalidCursor checks if listing failed because of cursor not created by the API
+func isInvalidCursor(err error) bool {
+	return errors.Is(err, common.ErrInvalidCursor)
+}
+
+// nextExecutionsCursor returns cursor of the page following the executions, it's empty when the page is not full
+func nextExecutionsCursor(executions []testkube.Execution, pageSize int) string {
+	if len(executions) == 0 || len(executions) < pageSize {
+		return ""
+	}
+
+	last := executions[len(executions)-1]
+	return common.EncodeCursor(last.StartTime, last.Id)
+}
+
+// nextTestSuiteExecutionsCursor returns cursor of the page following the executions, it's empty when the page is not full
+func nextTestSuiteExecutionsCursor(executions []testkube.TestSuiteExecution, pageSize int) string {
+	if len(executions) == 0 || len(executions) < pageSize {
+		return ""
+	}
+
+	last := executions[len(executions)-1]
+	return common.EncodeCursor(last.StartTime, last.Id)
+}
+
+// streamExecutions writes summaries of all executions matching the filter as newline delimited JSON,
+// executions are read page by page following the cursor, so the page number of the filter is ignored
+func (s *TestkubeAPI) streamExecutions(c *fiber.Ctx, filter *result.FilterImpl) error {
+	if filter.CursorDefined() {
+		if _, err := common.DecodeCursor(filter.Cursor()); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("failed to stream executions: %w", err))
+		}
+	}
+
+	ctx := c.Context()
+	filter = filter.WithPage(0)
+	c.Set(fiber.HeaderContentType, NDJSONContentType)
+	ctx.SetBodyStreamWriter(func(w *bufio.Writer) {
+		encoder := json.NewEncoder(w)
+		for {
+			executions, err := s.ExecutionResults.GetExecutions(ctx, filter)
+			if err != nil {
+				// the response is already being sent, so the missing lines are the only signal for the client
+				s.Log.Errorw("streaming executions error", "error", err)
+				return
+			}
+
+			for _, summary := range mapExecutionsToExecutionSummary(executions) {
+				if err = encoder.Encode(summary); err != nil {
+					return
+				}
+			}
+
+			// flushing fails when the client is gone
+			if err = w.Flush(); err != nil {
+				return
+			}
+
+			next := nextExecutionsCursor(executions, filter.PageSize())
+			if next == "" {
+				return
+			}
+			filter = filter.WithCursor(next)
+		}
+	})
+
+	return nil
+}
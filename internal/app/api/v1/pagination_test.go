@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_ListExecutionsHandler_Pagination(t *testing.T) {
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	app := fiber.New()
+	resultRepo := result.NewSQLRepository(db)
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+		ExecutionResults: resultRepo,
+	}
+	app.Get("/executions", s.ListExecutionsHandler())
+
+	startTime := time.Now().Add(-time.Hour)
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, resultRepo.Insert(context.Background(), testkube.Execution{
+			Id:              fmt.Sprintf("%d", i),
+			Name:            fmt.Sprintf("test-%d", i),
+			TestName:        "test",
+			StartTime:       startTime.Add(time.Duration(i) * time.Minute),
+			ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed},
+		}))
+	}
+
+	t.Run("follows next cursor", func(t *testing.T) {
+		var ids []string
+		uri := "/executions?pageSize=2"
+		for uri != "" {
+			resp, err := app.Test(httptest.NewRequest("GET", uri, nil), -1)
+			require.NoError(t, err)
+
+			var page testkube.ExecutionsResult
+			assert.Equal(t, 200, resp.StatusCode)
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+			resp.Body.Close()
+			for _, execution := range page.Results {
+				ids = append(ids, execution.Id)
+			}
+
+			assert.Equal(t, int32(5), page.Totals.Results)
+			uri = ""
+			if page.Next != "" {
+				uri = "/executions?pageSize=2&cursor=" + page.Next
+			}
+		}
+
+		assert.Equal(t, []string{"5", "4", "3", "2", "1"}, ids)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest("GET", "/executions?cursor=invalid", nil), -1)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+
+	t.Run("streams NDJSON", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/executions?pageSize=2", nil)
+		req.Header.Set("Accept", NDJSONContentType)
+		resp, err := app.Test(req, -1)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.Equal(t, NDJSONContentType, resp.Header.Get("Content-Type"))
+
+		var ids []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var summary testkube.ExecutionSummary
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &summary))
+			ids = append(ids, summary.Id)
+		}
+
+		assert.Equal(t, []string{"5", "4", "3", "2", "1"}, ids)
+	})
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/kubeshop/testkube/pkg/datefilter"
 	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 
 	"k8s.io/client-go/kubernetes"
@@ -40,6 +41,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/event/bus"
 	"github.com/kubeshop/testkube/pkg/event/kind/cdevent"
 	"github.com/kubeshop/testkube/pkg/event/kind/slack"
+	testcaselistener "github.com/kubeshop/testkube/pkg/event/kind/testcase"
 	"github.com/kubeshop/testkube/pkg/event/kind/webhook"
 	ws "github.com/kubeshop/testkube/pkg/event/kind/websocket"
 	"github.com/kubeshop/testkube/pkg/executor/client"
@@ -61,6 +63,7 @@ func NewTestkubeAPI(
 	namespace string,
 	testExecutionResults result.Repository,
 	testsuiteExecutionsResults testresult.Repository,
+	testCaseResults testcase.Repository,
 	testsClient *testsclientv3.TestsClient,
 	executorsClient *executorsclientv1.ExecutorsClient,
 	testsuitesClient *testsuitesclientv3.TestSuitesClient,
@@ -88,6 +91,7 @@ func NewTestkubeAPI(
 	mode string,
 	eventsBus bus.Bus,
 	enableSecretsEndpoint bool,
+	enableTestTriggerAnnotatedActions bool,
 ) TestkubeAPI {
 
 	var httpConfig server.Config
@@ -102,37 +106,41 @@ func NewTestkubeAPI(
 	if httpConfig.HttpBodyLimit == 0 {
 		httpConfig.Http.BodyLimit = DefaultHttpBodyLimit
 	}
+	// large bodies like execution archives are streamed instead of being read into memory
+	httpConfig.Http.StreamRequestBody = true
 
 	s := TestkubeAPI{
-		HTTPServer:            server.NewServer(httpConfig),
-		TestExecutionResults:  testsuiteExecutionsResults,
-		ExecutionResults:      testExecutionResults,
-		TestsClient:           testsClient,
-		ExecutorsClient:       executorsClient,
-		SecretClient:          secretClient,
-		Clientset:             clientset,
-		TestsSuitesClient:     testsuitesClient,
-		TestKubeClientset:     testkubeClientset,
-		Metrics:               metrics,
-		Events:                eventsEmitter,
-		WebhooksClient:        webhookClient,
-		TestSourcesClient:     testsourcesClient,
-		Namespace:             namespace,
-		ConfigMap:             configMap,
-		Executor:              executor,
-		ContainerExecutor:     containerExecutor,
-		jobTemplate:           jobTemplate,
-		scheduler:             scheduler,
-		slackLoader:           slackLoader,
-		Storage:               storage,
-		graphqlPort:           graphqlPort,
-		artifactsStorage:      artifactsStorage,
-		TemplatesClient:       templatesClient,
-		dashboardURI:          dashboardURI,
-		helmchartVersion:      helmchartVersion,
-		mode:                  mode,
-		eventsBus:             eventsBus,
-		enableSecretsEndpoint: enableSecretsEndpoint,
+		HTTPServer:                        server.NewServer(httpConfig),
+		TestExecutionResults:              testsuiteExecutionsResults,
+		ExecutionResults:                  testExecutionResults,
+		TestCaseResults:                   testCaseResults,
+		TestsClient:                       testsClient,
+		ExecutorsClient:                   executorsClient,
+		SecretClient:                      secretClient,
+		Clientset:                         clientset,
+		TestsSuitesClient:                 testsuitesClient,
+		TestKubeClientset:                 testkubeClientset,
+		Metrics:                           metrics,
+		Events:                            eventsEmitter,
+		WebhooksClient:                    webhookClient,
+		TestSourcesClient:                 testsourcesClient,
+		Namespace:                         namespace,
+		ConfigMap:                         configMap,
+		Executor:                          executor,
+		ContainerExecutor:                 containerExecutor,
+		jobTemplate:                       jobTemplate,
+		scheduler:                         scheduler,
+		slackLoader:                       slackLoader,
+		Storage:                           storage,
+		graphqlPort:                       graphqlPort,
+		artifactsStorage:                  artifactsStorage,
+		TemplatesClient:                   templatesClient,
+		dashboardURI:                      dashboardURI,
+		helmchartVersion:                  helmchartVersion,
+		mode:                              mode,
+		eventsBus:                         eventsBus,
+		enableSecretsEndpoint:             enableSecretsEndpoint,
+		enableTestTriggerAnnotatedActions: enableTestTriggerAnnotatedActions,
 	}
 
 	// will be reused in websockets handler
@@ -141,6 +149,7 @@ func NewTestkubeAPI(
 	s.Events.Loader.Register(webhook.NewWebhookLoader(s.Log, webhookClient, templatesClient))
 	s.Events.Loader.Register(s.WebsocketLoader)
 	s.Events.Loader.Register(s.slackLoader)
+	s.Events.Loader.Register(testcaselistener.NewTestCaseLoader(testCaseResults))
 
 	if cdeventsTarget != "" {
 		cdeventLoader, err := cdevent.NewCDEventLoader(cdeventsTarget, clusterId, namespace, dashboardURI, testkube.AllEventTypes)
@@ -161,6 +170,7 @@ type TestkubeAPI struct {
 	server.HTTPServer
 	ExecutionResults      result.Repository
 	TestExecutionResults  testresult.Repository
+	TestCaseResults       testcase.Repository
 	Executor              client.Executor
 	ContainerExecutor     client.Executor
 	TestsSuitesClient     *testsuitesclientv3.TestSuitesClient
@@ -190,6 +200,9 @@ type TestkubeAPI struct {
 	mode                  string
 	eventsBus             bus.Bus
 	enableSecretsEndpoint bool
+	// enableTestTriggerAnnotatedActions allows test trigger actions kept in annotation, they are run as tests
+	// by consumers which don't read the annotation
+	enableTestTriggerAnnotatedActions bool
 }
 
 type storageParams struct {
@@ -276,12 +289,21 @@ func (s *TestkubeAPI) InitRoutes() {
 
 	executions.Get("/", s.ListExecutionsHandler())
 	executions.Post("/", s.ExecuteTestsHandler())
+	executions.Get("/export", s.ExportExecutionsHandler())
+	executions.Post("/import", s.ImportExecutionsHandler())
 	executions.Get("/:executionID", s.GetExecutionHandler())
 	executions.Get("/:executionID/artifacts", s.ListArtifactsHandler())
 	executions.Get("/:executionID/logs", s.ExecutionLogsHandler())
 	executions.Get("/:executionID/logs/stream", s.ExecutionLogsStreamHandler())
 	executions.Get("/:executionID/artifacts/:filename", s.GetArtifactHandler())
 	executions.Get("/:executionID/artifact-archive", s.GetArtifactArchiveHandler())
+	executions.Get("/:executionID/test-cases", s.ListTestCasesHandler())
+	executions.Get("/:executionID/diff/:otherExecutionID", s.DiffExecutionsHandler())
+	executions.Post("/:executionID/annotations", s.AddExecutionAnnotationHandler())
+	executions.Delete("/:executionID/annotations/:annotationID", s.DeleteExecutionAnnotationHandler())
+
+	testCases := s.Routes.Group("/test-cases")
+	testCases.Get("/", s.ListTestCasesHandler())
 
 	tests := s.Routes.Group("/tests")
 
@@ -295,6 +317,7 @@ func (s *TestkubeAPI) InitRoutes() {
 	tests.Post("/:id/abort", s.AbortTestHandler())
 
 	tests.Get("/:id/metrics", s.TestMetricsHandler())
+	tests.Get("/:id/flakiness", s.TestFlakinessHandler())
 
 	tests.Post("/:id/executions", s.ExecuteTestsHandler())
 
@@ -332,6 +355,9 @@ func (s *TestkubeAPI) InitRoutes() {
 	testSuiteExecutions.Get("/:executionID", s.GetTestSuiteExecutionHandler())
 	testSuiteExecutions.Get("/:executionID/artifacts", s.ListTestSuiteArtifactsHandler())
 	testSuiteExecutions.Patch("/:executionID", s.AbortTestSuiteExecutionHandler())
+	testSuiteExecutions.Post("/:executionID/rerun", s.RerunTestSuiteExecutionHandler())
+	testSuiteExecutions.Post("/:executionID/annotations", s.AddTestSuiteExecutionAnnotationHandler())
+	testSuiteExecutions.Delete("/:executionID/annotations/:annotationID", s.DeleteTestSuiteExecutionAnnotationHandler())
 
 	testSuiteWithExecutions := s.Routes.Group("/test-suite-with-executions")
 	testSuiteWithExecutions.Get("/", s.ListTestSuiteWithExecutionsHandler())
@@ -380,6 +406,7 @@ func (s *TestkubeAPI) InitRoutes() {
 	configs := s.Routes.Group("/config")
 	configs.Get("/", s.GetConfigsHandler())
 	configs.Patch("/", s.UpdateConfigsHandler())
+	configs.Post("/retention", s.EnforceRetentionHandler())
 
 	debug := s.Routes.Group("/debug")
 	debug.Get("/listeners", s.GetDebugListenersHandler())
@@ -507,7 +534,7 @@ func (s TestkubeAPI) StartTelemetryHeartbeats(ctx context.Context, ch chan struc
 
 // TODO should we use single generic filter for all list based resources ?
 // currently filters for e.g. tests are done "by hand"
-func getFilterFromRequest(c *fiber.Ctx) result.Filter {
+func getFilterFromRequest(c *fiber.Ctx) *result.FilterImpl {
 
 	filter := result.NewExecutionsFilter()
 
@@ -566,5 +593,20 @@ func getFilterFromRequest(c *fiber.Ctx) result.Filter {
 		filter = filter.WithSelector(selector)
 	}
 
+	cursor := c.Query("cursor")
+	if cursor != "" {
+		filter = filter.WithCursor(cursor)
+	}
+
+	annotationCategory := c.Query("annotationCategory")
+	if annotationCategory != "" {
+		filter = filter.WithAnnotationCategory(annotationCategory)
+	}
+
+	parentExecutionId := c.Query("parentExecutionId")
+	if parentExecutionId != "" {
+		filter = filter.WithParentExecutionId(parentExecutionId)
+	}
+
 	return filter
 }
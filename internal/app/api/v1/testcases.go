@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+// ListTestCasesHandler is a method for getting test cases parsed from JUnit reports of executions,
+// it handles both /test-cases and /executions/{executionID}/test-cases endpoints
+func (s *TestkubeAPI) ListTestCasesHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		errPrefix := "failed to list test cases"
+		filter, err := getTestCasesFilterFromRequest(c)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		testCases, err := s.TestCaseResults.GetTestCases(c.Context(), filter)
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: db client failed to get test cases: %w", errPrefix, err))
+		}
+
+		return c.JSON(testCases)
+	}
+}
+
+func getTestCasesFilterFromRequest(c *fiber.Ctx) (*testcase.FilterImpl, error) {
+	filter := testcase.NewTestCasesFilter()
+
+	// id for /executions/ID/test-cases
+	executionId := c.Params("executionID", "")
+	if executionId == "" {
+		executionId = c.Query("executionId", "")
+	}
+
+	if executionId != "" {
+		filter = filter.WithExecutionId(executionId)
+	}
+
+	if testName := c.Query("testName", ""); testName != "" {
+		filter = filter.WithTestName(testName)
+	}
+
+	if testSuiteName := c.Query("testSuiteName", ""); testSuiteName != "" {
+		filter = filter.WithTestSuiteName(testSuiteName)
+	}
+
+	if name := c.Query("name", ""); name != "" {
+		filter = filter.WithName(name)
+	}
+
+	if classname := c.Query("classname", ""); classname != "" {
+		filter = filter.WithClassname(classname)
+	}
+
+	statuses, err := testkube.ParseTestCaseStatusList(c.Query("status", ""), ",")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) != 0 {
+		filter = filter.WithStatuses(statuses)
+	}
+
+	page, err := strconv.Atoi(c.Query("page", ""))
+	if err == nil {
+		filter = filter.WithPage(page)
+	}
+
+	pageSize, err := strconv.Atoi(c.Query("pageSize", ""))
+	if err == nil && pageSize != 0 {
+		filter = filter.WithPageSize(pageSize)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+	"github.com/kubeshop/testkube/pkg/server"
+)
+
+func TestTestkubeAPI_ListTestCasesHandler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	app := fiber.New()
+	testCaseRepo := testcase.NewMockRepository(mockCtrl)
+	s := &TestkubeAPI{
+		HTTPServer: server.HTTPServer{
+			Mux: app,
+			Log: log.DefaultLogger,
+		},
+		TestCaseResults: testCaseRepo,
+	}
+	app.Get("/test-cases", s.ListTestCasesHandler())
+	app.Get("/executions/:executionID/test-cases", s.ListTestCasesHandler())
+
+	t.Run("failed test cases by class and name", func(t *testing.T) {
+		expectedFilter := testcase.NewTestCasesFilter().WithClassname("LoginSpec").WithName("should_reject_bad_password").
+			WithStatuses([]string{testkube.FAILED_TestCaseStatus})
+		expectedTestCases := []testkube.TestCaseResult{{ExecutionId: "1", Classname: "LoginSpec", Name: "should_reject_bad_password",
+			Status: testkube.FAILED_TestCaseStatus}}
+		testCaseRepo.EXPECT().GetTestCases(gomock.Any(), expectedFilter).Return(expectedTestCases, nil)
+
+		req := httptest.NewRequest("GET", "/test-cases?classname=LoginSpec&name=should_reject_bad_password&status=failed", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		var testCases []testkube.TestCaseResult
+		assert.Equal(t, 200, resp.StatusCode)
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&testCases))
+		assert.Equal(t, expectedTestCases, testCases)
+	})
+
+	t.Run("test cases of execution", func(t *testing.T) {
+		testCaseRepo.EXPECT().GetTestCases(gomock.Any(), testcase.NewTestCasesFilter().WithExecutionId("1").WithPageSize(10)).
+			Return([]testkube.TestCaseResult{}, nil)
+
+		req := httptest.NewRequest("GET", "/executions/1/test-cases?pageSize=10", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/test-cases?status=broken", nil)
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, 400, resp.StatusCode)
+	})
+}
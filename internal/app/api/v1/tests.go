@@ -153,7 +153,7 @@ func (s TestkubeAPI) ListTestsHandler() fiber.Handler {
 	}
 }
 
-// ListTestsHandler is a method for getting list of all available tests
+// TestMetricsHandler returns metrics for given test, with the trend when granularity is requested
 func (s TestkubeAPI) TestMetricsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		testName := c.Params("id")
@@ -170,11 +170,26 @@ func (s TestkubeAPI) TestMetricsHandler() fiber.Handler {
 			last = DefaultLastDays
 		}
 
-		metrics, err := s.ExecutionResults.GetTestMetrics(context.Background(), testName, limit, last)
+		granularity, err := getMetricsGranularity(c)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("failed to get metrics for test %s: %w", testName, err))
+		}
+
+		// trend is calculated from all executions in the period, so they are limited afterwards
+		repositoryLimit := limit
+		if granularity != "" {
+			repositoryLimit = 0
+		}
+
+		metrics, err := s.ExecutionResults.GetTestMetrics(context.Background(), testName, repositoryLimit, last)
 		if err != nil {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("failed to get metrics for test %s: %w", testName, err))
 		}
 
+		if granularity != "" {
+			metrics = withMetricsTrend(metrics, granularity, c.Query("groupBy"), limit)
+		}
+
 		return c.JSON(metrics)
 	}
 }
@@ -493,6 +508,10 @@ func (s TestkubeAPI) DeleteTestHandler() fiber.Handler {
 			if err = s.ExecutionResults.DeleteByTest(c.Context(), name); err != nil {
 				return s.Warn(c, http.StatusInternalServerError, fmt.Errorf("test %s was deleted but deleting test executions returned error: %w", name, err))
 			}
+
+			if err = s.TestCaseResults.DeleteByTests(c.Context(), []string{name}); err != nil {
+				return s.Warn(c, http.StatusInternalServerError, fmt.Errorf("test %s was deleted but deleting test cases returned error: %w", name, err))
+			}
 		}
 
 		return c.SendStatus(http.StatusNoContent)
@@ -576,6 +595,17 @@ func (s TestkubeAPI) DeleteTestsHandler() fiber.Handler {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not delete executions: %w", errPrefix, err))
 		}
 
+		// delete all test cases for tests
+		if selector == "" {
+			err = s.TestCaseResults.DeleteAll(c.Context())
+		} else {
+			err = s.TestCaseResults.DeleteByTests(c.Context(), testNames)
+		}
+
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not delete test cases: %w", errPrefix, err))
+		}
+
 		return c.SendStatus(http.StatusNoContent)
 	}
 }
@@ -88,6 +88,10 @@ func (s TestkubeAPI) CreateTestSuiteHandler() fiber.Handler {
 			testSuite.Namespace = s.Namespace
 		}
 
+		if err := testsuitesmapper.MapCRToAPI(testSuite).ValidateStepGraph(); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
 		s.Log.Infow("creating test suite", "testSuite", testSuite)
 
 		created, err := s.TestsSuitesClient.Create(&testSuite)
@@ -170,6 +174,10 @@ func (s TestkubeAPI) UpdateTestSuiteHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadRequest, err)
 		}
 
+		if err = testsuitesmapper.MapCRToAPI(*testSuiteSpec).ValidateStepGraph(); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
 		updatedTestSuite, err := s.TestsSuitesClient.Update(testSuiteSpec)
 
 		s.Metrics.IncUpdateTestSuite(err)
@@ -262,6 +270,11 @@ func (s TestkubeAPI) DeleteTestSuiteHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not delete test suite test executions: %w", errPrefix, err))
 		}
 
+		// delete test cases for test suite
+		if err = s.TestCaseResults.DeleteByTestSuites(c.Context(), []string{name}); err != nil {
+			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not delete test suite test cases: %w", errPrefix, err))
+		}
+
 		// delete executions for test suite
 		if err = s.TestExecutionResults.DeleteByTestSuite(c.Context(), name); err != nil {
 			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not delete test suite executions: %w", errPrefix, err))
@@ -316,6 +329,17 @@ func (s TestkubeAPI) DeleteTestSuitesHandler() fiber.Handler {
 			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not list test suite test executions: %w", errPrefix, err))
 		}
 
+		// delete all test cases for test suites
+		if selector == "" {
+			err = s.TestCaseResults.DeleteForAllTestSuites(c.Context())
+		} else {
+			err = s.TestCaseResults.DeleteByTestSuites(c.Context(), testSuiteNames)
+		}
+
+		if err != nil {
+			return s.Error(c, http.StatusBadGateway, fmt.Errorf("%s: client could not delete test suite test cases: %w", errPrefix, err))
+		}
+
 		// delete all executions for test suites
 		if selector == "" {
 			err = s.TestExecutionResults.DeleteAll(c.Context())
@@ -374,7 +398,7 @@ func (s TestkubeAPI) ListTestSuitesHandler() fiber.Handler {
 	}
 }
 
-// TestSuiteMetricsHandler returns basic metrics for given testsuite
+// TestSuiteMetricsHandler returns basic metrics for given testsuite, with the trend when granularity is requested
 func (s TestkubeAPI) TestSuiteMetricsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		errPrefix := "failed to get test suite metrics"
@@ -395,11 +419,26 @@ func (s TestkubeAPI) TestSuiteMetricsHandler() fiber.Handler {
 			last = DefaultLastDays
 		}
 
-		metrics, err := s.TestExecutionResults.GetTestSuiteMetrics(context.Background(), testSuiteName, limit, last)
+		granularity, err := getMetricsGranularity(c)
+		if err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		// trend is calculated from all executions in the period, so they are limited afterwards
+		repositoryLimit := limit
+		if granularity != "" {
+			repositoryLimit = 0
+		}
+
+		metrics, err := s.TestExecutionResults.GetTestSuiteMetrics(context.Background(), testSuiteName, repositoryLimit, last)
 		if err != nil {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: failed to get metrics from client: %w", errPrefix, err))
 		}
 
+		if granularity != "" {
+			metrics = withMetricsTrend(metrics, granularity, c.Query("groupBy"), limit)
+		}
+
 		return c.JSON(metrics)
 	}
 }
@@ -618,6 +657,9 @@ func (s TestkubeAPI) ListTestSuiteExecutionsHandler() fiber.Handler {
 
 		ctx := c.Context()
 		executionsTotals, err := s.TestExecutionResults.GetExecutionsTotals(ctx, filter)
+		if isInvalidCursor(err) {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
 		if err != nil {
 			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: client could not get executions totals: %w", errPrefix, err))
 		}
@@ -639,6 +681,7 @@ func (s TestkubeAPI) ListTestSuiteExecutionsHandler() fiber.Handler {
 			Totals:   &allExecutionsTotals,
 			Filtered: &executionsTotals,
 			Results:  testsuitesmapper.MapToTestExecutionSummary(executions),
+			Next:     nextTestSuiteExecutionsCursor(executions, filter.PageSize()),
 		})
 	}
 }
@@ -796,6 +839,41 @@ func (s TestkubeAPI) AbortTestSuiteExecutionHandler() fiber.Handler {
 	}
 }
 
+// RerunTestSuiteExecutionHandler starts new execution of the finished test suite execution steps,
+// with only=failed passed step results are reused
+func (s TestkubeAPI) RerunTestSuiteExecutionHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.Context()
+		id := c.Params("executionID")
+		errPrefix := fmt.Sprintf("failed to rerun test suite execution %s", id)
+
+		only := c.Query("only")
+		if only != "" && only != "failed" {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: unsupported only value %s, use failed", errPrefix, only))
+		}
+
+		execution, err := s.TestExecutionResults.Get(ctx, id)
+		if err == mongo.ErrNoDocuments {
+			return s.Error(c, http.StatusNotFound, fmt.Errorf("%s: test suite with execution id/name %s not found", errPrefix, id))
+		}
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: could not get test suite execution: %w", errPrefix, err))
+		}
+
+		if !execution.IsCompleted() {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: test suite execution hasn't finished yet", errPrefix))
+		}
+
+		rerun, err := s.scheduler.RerunTestSuiteExecution(ctx, execution, only == "failed")
+		if err != nil {
+			return s.Error(c, http.StatusInternalServerError, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
+		c.Status(http.StatusCreated)
+		return c.JSON(rerun)
+	}
+}
+
 // ListTestSuiteTestsHandler for getting list of all available Tests for TestSuites
 func (s TestkubeAPI) ListTestSuiteTestsHandler() fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -871,5 +949,15 @@ func getExecutionsFilterFromRequest(c *fiber.Ctx) testresult.Filter {
 		filter = filter.WithSelector(selector)
 	}
 
+	cursor := c.Query("cursor")
+	if cursor != "" {
+		filter = filter.WithCursor(cursor)
+	}
+
+	annotationCategory := c.Query("annotationCategory")
+	if annotationCategory != "" {
+		filter = filter.WithAnnotationCategory(annotationCategory)
+	}
+
 	return filter
 }
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -18,6 +20,8 @@ import (
 	"github.com/kubeshop/testkube/pkg/keymap/triggers"
 	triggerskeymapmapper "github.com/kubeshop/testkube/pkg/mapper/keymap/triggers"
 	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+	"github.com/kubeshop/testkube/pkg/triggerexpression"
+	triggersservice "github.com/kubeshop/testkube/pkg/triggers"
 	"github.com/kubeshop/testkube/pkg/utils"
 )
 
@@ -41,6 +45,12 @@ func (s *TestkubeAPI) CreateTestTriggerHandler() fiber.Handler {
 				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: could not parse json request: %w", errPrefix, err))
 			}
 
+			if request.ResourceKind != nil {
+				if err = request.ResourceKind.Validate(); err != nil {
+					return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid resource kind: %w", errPrefix, err))
+				}
+			}
+
 			testTrigger = testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(request)
 			// default namespace if not defined in upsert request
 			if testTrigger.Namespace == "" {
@@ -58,6 +68,9 @@ func (s *TestkubeAPI) CreateTestTriggerHandler() fiber.Handler {
 		}
 
 		errPrefix = errPrefix + " " + testTrigger.Name
+		if err := s.validateTestTrigger(&testTrigger); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
 
 		s.Log.Infow("creating test trigger", "testTrigger", testTrigger)
 
@@ -93,6 +106,12 @@ func (s *TestkubeAPI) UpdateTestTriggerHandler() fiber.Handler {
 			if err != nil {
 				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: could not parse json request: %w", errPrefix, err))
 			}
+
+			if request.ResourceKind != nil {
+				if err = request.ResourceKind.Validate(); err != nil {
+					return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid resource kind: %w", errPrefix, err))
+				}
+			}
 		}
 
 		namespace := s.Namespace
@@ -114,6 +133,15 @@ func (s *TestkubeAPI) UpdateTestTriggerHandler() fiber.Handler {
 		crdTestTrigger := testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(request)
 		testTrigger.Spec = crdTestTrigger.Spec
 		testTrigger.Labels = request.Labels
+		testtriggersmapper.SetResourceKind(testTrigger, request.ResourceKind)
+		testtriggersmapper.SetAction(testTrigger, *request.Action)
+		testtriggersmapper.SetWebhookURL(testTrigger, request.WebhookUrl)
+		testtriggersmapper.SetExpression(testTrigger, request.Expression)
+		testtriggersmapper.SetThrottling(testTrigger, request.Throttling)
+		if err = s.validateTestTrigger(testTrigger); err != nil {
+			return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+		}
+
 		testTrigger, err = s.TestKubeClientset.TestsV1().TestTriggers(namespace).Update(c.UserContext(), testTrigger, v1.UpdateOptions{})
 
 		s.Metrics.IncUpdateTestTrigger(err)
@@ -139,6 +167,17 @@ func (s *TestkubeAPI) BulkUpdateTestTriggersHandler() fiber.Handler {
 
 		namespaces := make(map[string]struct{}, 0)
 		for _, upsertRequest := range request {
+			if upsertRequest.ResourceKind != nil {
+				if err = upsertRequest.ResourceKind.Validate(); err != nil {
+					return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: invalid resource kind: %w", errPrefix, err))
+				}
+			}
+
+			crdTestTrigger := testtriggersmapper.MapTestTriggerUpsertRequestToTestTriggerCRD(upsertRequest)
+			if err = s.validateTestTrigger(&crdTestTrigger); err != nil {
+				return s.Error(c, http.StatusBadRequest, fmt.Errorf("%s: %w", errPrefix, err))
+			}
+
 			namespace := s.Namespace
 			if upsertRequest.Namespace != "" {
 				namespace = upsertRequest.Namespace
@@ -313,10 +352,50 @@ func (s *TestkubeAPI) GetTestTriggerKeyMapHandler() fiber.Handler {
 	}
 }
 
+// validateTestTrigger checks test trigger fields which are not validated by the TestTrigger CRD
+func (s *TestkubeAPI) validateTestTrigger(t *testtriggersv1.TestTrigger) error {
+	if err := triggerexpression.Validate(testtriggersmapper.GetExpression(t)); err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+
+	// the TestTrigger CRD keeps run action for actions stored in annotation, so the operator and api servers
+	// which don't read the annotation would run tests instead
+	if action := testtriggersmapper.GetAction(t); action.IsAnnotated() && !s.enableTestTriggerAnnotatedActions {
+		return fmt.Errorf("%s action requires ENABLE_TEST_TRIGGER_ANNOTATED_ACTIONS on all api server replicas", action)
+	}
+
+	switch action := testtriggersmapper.GetAction(t); action {
+	case testkube.RUN_TestTriggerActions, testkube.ABORT_TestTriggerActions, testkube.NOTIFY_TestTriggerActions:
+	case testkube.WEBHOOK_TestTriggerActions:
+		webhookURL, err := url.ParseRequestURI(testtriggersmapper.GetWebhookURL(t))
+		if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+			return errors.New("webhook action requires http or https webhook url")
+		}
+	default:
+		return fmt.Errorf("unsupported action %s", action)
+	}
+
+	if throttling := testtriggersmapper.GetThrottling(t); throttling != nil {
+		if err := throttling.Validate(); err != nil {
+			return fmt.Errorf("invalid throttling: %w", err)
+		}
+
+		if err := triggersservice.ValidateDedupKey(throttling.DedupKey); err != nil {
+			return fmt.Errorf("invalid throttling: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // generateTestTriggerName function generates a trigger name from the TestTrigger spec
 // function also takes care of name collisions, not exceeding k8s max object name (63 characters) and not ending with a hyphen '-'
 func generateTestTriggerName(t *testtriggersv1.TestTrigger) string {
-	name := fmt.Sprintf("trigger-%s-%s-%s-%s", t.Spec.Resource, t.Spec.Event, t.Spec.Action, t.Spec.Execution)
+	resource := string(t.Spec.Resource)
+	if kind, err := testtriggersmapper.GetResourceKind(t); err == nil && kind != nil {
+		resource = strings.ToLower(kind.Kind)
+	}
+	name := fmt.Sprintf("trigger-%s-%s-%s-%s", resource, t.Spec.Event, testtriggersmapper.GetAction(t), t.Spec.Execution)
 	if len(name) > testTriggerMaxNameLength {
 		name = name[:testTriggerMaxNameLength-1]
 	}
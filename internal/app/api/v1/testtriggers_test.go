@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+)
+
+func TestTestkubeAPI_validateTestTrigger_annotatedActions(t *testing.T) {
+	t.Parallel()
+
+	trigger := &testtriggersv1.TestTrigger{}
+	testtriggersmapper.SetAction(trigger, testkube.NOTIFY_TestTriggerActions)
+
+	s := &TestkubeAPI{}
+	assert.ErrorContains(t, s.validateTestTrigger(trigger), "ENABLE_TEST_TRIGGER_ANNOTATED_ACTIONS")
+
+	s.enableTestTriggerAnnotatedActions = true
+	assert.NoError(t, s.validateTestTrigger(trigger))
+
+	s.enableTestTriggerAnnotatedActions = false
+	testtriggersmapper.SetAction(trigger, testkube.RUN_TestTriggerActions)
+	assert.NoError(t, s.validateTestTrigger(trigger))
+}
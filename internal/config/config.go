@@ -18,6 +18,11 @@ type Config struct {
 	APIMongoAllowDiskUse              bool          `envconfig:"API_MONGO_ALLOW_DISK_USE" default:"false"`
 	APIMongoDB                        string        `envconfig:"API_MONGO_DB" default:"testkube"`
 	APIMongoDBType                    string        `envconfig:"API_MONGO_DB_TYPE" default:"mongo"`
+	APIDBType                         string        `envconfig:"API_DB_TYPE" default:"mongo"`
+	APIPostgresDSN                    string        `envconfig:"API_POSTGRES_DSN" default:"postgres://localhost:5432/testkube?sslmode=disable"`
+	APISQLitePath                     string        `envconfig:"API_SQLITE_PATH" default:"testkube.db"`
+	TestkubeLiteMode                  bool          `envconfig:"TESTKUBE_LITE_MODE" default:"false"`
+	TestkubeLiteDataDir               string        `envconfig:"TESTKUBE_LITE_DATA_DIR" default:"testkube-data"`
 	SlackToken                        string        `envconfig:"SLACK_TOKEN" default:""`
 	SlackConfig                       string        `envconfig:"SLACK_CONFIG" default:""`
 	SlackTemplate                     string        `envconfig:"SLACK_TEMPLATE" default:""`
@@ -61,12 +66,15 @@ type Config struct {
 	CDEventsTarget                    string        `envconfig:"CDEVENTS_TARGET" default:""`
 	TestkubeDashboardURI              string        `envconfig:"TESTKUBE_DASHBOARD_URI" default:""`
 	DisableReconciler                 bool          `envconfig:"DISABLE_RECONCILER" default:"false"`
+	RetentionInterval                 time.Duration `envconfig:"RETENTION_INTERVAL" default:"1h"`
+	RetentionDryRun                   bool          `envconfig:"RETENTION_DRY_RUN" default:"false"`
 	TestkubeClusterName               string        `envconfig:"TESTKUBE_CLUSTER_NAME" default:""`
 	CompressArtifacts                 bool          `envconfig:"COMPRESSARTIFACTS" default:"false"`
 	TestkubeHelmchartVersion          string        `envconfig:"TESTKUBE_HELMCHART_VERSION" default:""`
 	DebugListenAddr                   string        `envconfig:"DEBUG_LISTEN_ADDR" default:"0.0.0.0:1337"`
 	EnableDebugServer                 bool          `envconfig:"ENABLE_DEBUG_SERVER" default:"false"`
 	EnableSecretsEndpoint             bool          `envconfig:"ENABLE_SECRETS_ENDPOINT" default:"false"`
+	EnableTestTriggerAnnotatedActions bool          `envconfig:"ENABLE_TEST_TRIGGER_ANNOTATED_ACTIONS" default:"false"`
 }
 
 func Get() (*Config, error) {
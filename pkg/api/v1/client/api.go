@@ -23,6 +23,11 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.Artifact](client, config),
 			NewProxyClient[testkube.ServerInfo](client, config),
 			NewProxyClient[testkube.DebugInfo](client, config),
+			NewProxyClient[testkube.TestCaseResult](client, config),
+			NewProxyClient[testkube.TestFlakiness](client, config),
+			NewProxyClient[testkube.ExecutionDiff](client, config),
+			NewProxyClient[testkube.ExecutionsArchiveReport](client, config),
+			NewProxyClient[testkube.ExecutionAnnotation](client, config),
 		),
 		TestSuiteClient: NewTestSuiteClient(
 			NewProxyClient[testkube.TestSuite](client, config),
@@ -31,10 +36,11 @@ func NewProxyAPIClient(client kubernetes.Interface, config APIConfig) APIClient
 			NewProxyClient[testkube.TestSuiteWithExecutionSummary](client, config),
 			NewProxyClient[testkube.TestSuiteExecutionsResult](client, config),
 			NewProxyClient[testkube.Artifact](client, config),
+			NewProxyClient[testkube.ExecutionAnnotation](client, config),
 		),
 		ExecutorClient:   NewExecutorClient(NewProxyClient[testkube.ExecutorDetails](client, config)),
 		WebhookClient:    NewWebhookClient(NewProxyClient[testkube.Webhook](client, config)),
-		ConfigClient:     NewConfigClient(NewProxyClient[testkube.Config](client, config)),
+		ConfigClient:     NewConfigClient(NewProxyClient[testkube.Config](client, config), NewProxyClient[testkube.RetentionReport](client, config)),
 		TestSourceClient: NewTestSourceClient(NewProxyClient[testkube.TestSource](client, config)),
 		CopyFileClient:   NewCopyFileProxyClient(client, config),
 		TemplateClient:   NewTemplateClient(NewProxyClient[testkube.Template](client, config)),
@@ -53,6 +59,11 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.ServerInfo](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.DebugInfo](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestCaseResult](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.TestFlakiness](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionDiff](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionsArchiveReport](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionAnnotation](httpClient, apiURI, apiPathPrefix),
 		),
 		TestSuiteClient: NewTestSuiteClient(
 			NewDirectClient[testkube.TestSuite](httpClient, apiURI, apiPathPrefix),
@@ -61,10 +72,11 @@ func NewDirectAPIClient(httpClient *http.Client, sseClient *http.Client, apiURI,
 			NewDirectClient[testkube.TestSuiteWithExecutionSummary](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.TestSuiteExecutionsResult](httpClient, apiURI, apiPathPrefix),
 			NewDirectClient[testkube.Artifact](httpClient, apiURI, apiPathPrefix),
+			NewDirectClient[testkube.ExecutionAnnotation](httpClient, apiURI, apiPathPrefix),
 		),
 		ExecutorClient:   NewExecutorClient(NewDirectClient[testkube.ExecutorDetails](httpClient, apiURI, apiPathPrefix)),
 		WebhookClient:    NewWebhookClient(NewDirectClient[testkube.Webhook](httpClient, apiURI, apiPathPrefix)),
-		ConfigClient:     NewConfigClient(NewDirectClient[testkube.Config](httpClient, apiURI, apiPathPrefix)),
+		ConfigClient:     NewConfigClient(NewDirectClient[testkube.Config](httpClient, apiURI, apiPathPrefix), NewDirectClient[testkube.RetentionReport](httpClient, apiURI, apiPathPrefix)),
 		TestSourceClient: NewTestSourceClient(NewDirectClient[testkube.TestSource](httpClient, apiURI, apiPathPrefix)),
 		CopyFileClient:   NewCopyFileDirectClient(httpClient, apiURI, apiPathPrefix),
 		TemplateClient:   NewTemplateClient(NewDirectClient[testkube.Template](httpClient, apiURI, apiPathPrefix)),
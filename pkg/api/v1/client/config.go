@@ -3,20 +3,26 @@ package client
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
 
 // NewConfigClient creates new Cnfig client
-func NewConfigClient(configTransport Transport[testkube.Config]) ConfigClient {
+func NewConfigClient(
+	configTransport Transport[testkube.Config],
+	retentionReportTransport Transport[testkube.RetentionReport],
+) ConfigClient {
 	return ConfigClient{
-		configTransport: configTransport,
+		configTransport:          configTransport,
+		retentionReportTransport: retentionReportTransport,
 	}
 }
 
 // ConfigClient is a client for config
 type ConfigClient struct {
-	configTransport Transport[testkube.Config]
+	configTransport          Transport[testkube.Config]
+	retentionReportTransport Transport[testkube.RetentionReport]
 }
 
 func (c ConfigClient) UpdateConfig(config testkube.Config) (outputConfig testkube.Config, err error) {
@@ -34,3 +40,13 @@ func (c ConfigClient) GetConfig() (config testkube.Config, err error) {
 	uri := c.configTransport.GetURI("/config")
 	return c.configTransport.Execute(http.MethodGet, uri, nil, nil)
 }
+
+// EnforceRetention deletes executions not kept by retention policies, in dry run mode they are only reported
+func (c ConfigClient) EnforceRetention(dryRun bool) (report testkube.RetentionReport, err error) {
+	uri := c.retentionReportTransport.GetURI("/config/retention")
+	params := map[string]string{
+		"dryRun": strconv.FormatBool(dryRun),
+	}
+
+	return c.retentionReportTransport.Execute(http.MethodPost, uri, nil, params)
+}
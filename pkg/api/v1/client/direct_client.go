@@ -76,7 +76,12 @@ func (t DirectClient[A]) baseExec(method, uri, resource string, body []byte, par
 		buffer = bytes.NewBuffer(body)
 	}
 
-	req, err := http.NewRequest(method, uri, buffer)
+	return t.baseExecStream(method, uri, resource, buffer, params)
+}
+
+// baseExecStream is base execute method streaming the request body
+func (t DirectClient[A]) baseExecStream(method, uri, resource string, body io.Reader, params map[string]string) (resp *http.Response, err error) {
+	req, err := http.NewRequest(method, uri, body)
 	if err != nil {
 		return resp, err
 	}
@@ -118,6 +123,17 @@ func (t DirectClient[A]) Execute(method, uri string, body []byte, params map[str
 	return t.getFromResponse(resp)
 }
 
+// ExecuteStream is a method to make an api call for a single object with streamed request body
+func (t DirectClient[A]) ExecuteStream(method, uri string, body io.Reader, params map[string]string) (result A, err error) {
+	resp, err := t.baseExecStream(method, uri, fmt.Sprintf("%T", result), body, params)
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	return t.getFromResponse(resp)
+}
+
 // ExecuteMultiple is a method to make an api call for multiple objects
 func (t DirectClient[A]) ExecuteMultiple(method, uri string, body []byte, params map[string]string) (result []A, err error) {
 	resp, err := t.baseExec(method, uri, fmt.Sprintf("%T", result), body, params)
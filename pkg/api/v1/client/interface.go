@@ -1,6 +1,7 @@
 package client
 
 import (
+	"io"
 	"time"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -32,6 +33,7 @@ type TestAPI interface {
 	DeleteTests(selector string) error
 	ListTests(selector string) (tests testkube.Tests, err error)
 	ListTestWithExecutionSummaries(selector string) (tests testkube.TestWithExecutionSummaries, err error)
+	GetTestFlakiness(id string) (flakiness testkube.TestFlakiness, err error)
 	ExecuteTest(id, executionName string, options ExecuteTestOptions) (executions testkube.Execution, err error)
 	ExecuteTests(selector string, concurrencyLevel int, options ExecuteTestOptions) (executions []testkube.Execution, err error)
 	Logs(id string) (logs chan output.Output, err error)
@@ -41,11 +43,18 @@ type TestAPI interface {
 type ExecutionAPI interface {
 	GetExecution(executionID string) (execution testkube.Execution, err error)
 	ListExecutions(id string, limit int, selector string) (executions testkube.ExecutionsResult, err error)
+	ListMatrixExecutions(parentExecutionID string) (executions testkube.ExecutionsResult, err error)
+	ListTestCases(options ListTestCasesOptions) (testCases testkube.TestCaseResults, err error)
+	DiffExecutions(executionID, otherExecutionID string) (diff testkube.ExecutionDiff, err error)
+	AddExecutionAnnotation(executionID string, annotation testkube.ExecutionAnnotation) (testkube.ExecutionAnnotation, error)
+	DeleteExecutionAnnotation(executionID, annotationID string) error
 	AbortExecution(test string, id string) error
 	AbortExecutions(test string) error
 	GetExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error)
 	DownloadFile(executionID, fileName, destination string) (artifact string, err error)
 	DownloadArchive(executionID, destination string, masks []string) (archive string, err error)
+	ExportExecutions(options ExportExecutionsOptions, file string) (archive string, err error)
+	ImportExecutions(file string) (report testkube.ExecutionsArchiveReport, err error)
 }
 
 // TestSuiteAPI describes test suite api methods
@@ -69,7 +78,10 @@ type TestSuiteExecutionAPI interface {
 	WatchTestSuiteExecution(executionID string) (execution chan testkube.TestSuiteExecution, err error)
 	AbortTestSuiteExecution(executionID string) error
 	AbortTestSuiteExecutions(testSuiteName string) error
+	RerunTestSuiteExecution(executionID string, onlyFailed bool) (execution testkube.TestSuiteExecution, err error)
 	GetTestSuiteExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error)
+	AddTestSuiteExecutionAnnotation(executionID string, annotation testkube.ExecutionAnnotation) (testkube.ExecutionAnnotation, error)
+	DeleteTestSuiteExecutionAnnotation(executionID, annotationID string) error
 }
 
 // ExecutorAPI describes executor api methods
@@ -106,6 +118,7 @@ type TemplateAPI interface {
 type ConfigAPI interface {
 	UpdateConfig(config testkube.Config) (outputConfig testkube.Config, err error)
 	GetConfig() (config testkube.Config, err error)
+	EnforceRetention(dryRun bool) (report testkube.RetentionReport, err error)
 }
 
 // ServiceAPI describes service api methods
@@ -204,6 +217,11 @@ type ExecuteTestOptions struct {
 	EnvConfigMaps                      []testkube.EnvReference
 	EnvSecrets                         []testkube.EnvReference
 	RunningContext                     *testkube.RunningContext
+	RetryPolicy                        *testkube.RetryPolicy
+	Matrix                             map[string][]string
+	Shards                             int32
+	PriorityClass                      testkube.PriorityClass
+	DryRun                             bool
 }
 
 // ExecuteTestSuiteOptions contains test suite run options
@@ -223,18 +241,41 @@ type ExecuteTestSuiteOptions struct {
 	PvcTemplateReference     string
 }
 
+// ListTestCasesOptions contains filters for listing test cases across executions
+type ListTestCasesOptions struct {
+	ExecutionID   string
+	TestName      string
+	TestSuiteName string
+	Name          string
+	Classname     string
+	Statuses      string
+	Limit         int
+}
+
+// ExportExecutionsOptions contains filters for exporting executions
+type ExportExecutionsOptions struct {
+	TestName  string
+	Statuses  string
+	StartDate string
+	EndDate   string
+	LastNDays int
+	Selector  string
+	Artifacts bool
+}
+
 // Gettable is an interface of gettable objects
 type Gettable interface {
 	testkube.Test | testkube.TestSuite | testkube.ExecutorDetails |
 		testkube.Webhook | testkube.TestWithExecution | testkube.TestSuiteWithExecution | testkube.TestWithExecutionSummary |
 		testkube.TestSuiteWithExecutionSummary | testkube.Artifact | testkube.ServerInfo | testkube.Config | testkube.DebugInfo |
-		testkube.TestSource | testkube.Template
+		testkube.TestSource | testkube.Template | testkube.TestFlakiness | testkube.ExecutionDiff
 }
 
 // Executable is an interface of executable objects
 type Executable interface {
 	testkube.Execution | testkube.TestSuiteExecution |
-		testkube.ExecutionsResult | testkube.TestSuiteExecutionsResult
+		testkube.ExecutionsResult | testkube.TestSuiteExecutionsResult | testkube.RetentionReport |
+		testkube.TestCaseResult | testkube.ExecutionsArchiveReport | testkube.ExecutionAnnotation
 }
 
 // All is an interface of all objects
@@ -246,6 +287,7 @@ type All interface {
 type Transport[A All] interface {
 	Execute(method, uri string, body []byte, params map[string]string) (result A, err error)
 	ExecuteMultiple(method, uri string, body []byte, params map[string]string) (result []A, err error)
+	ExecuteStream(method, uri string, body io.Reader, params map[string]string) (result A, err error)
 	Delete(uri, selector string, isContentExpected bool) error
 	ExecuteMethod(method, uri, selector string, isContentExpected bool) error
 	GetURI(pathTemplate string, params ...interface{}) string
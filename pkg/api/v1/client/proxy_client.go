@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -62,6 +63,22 @@ func (t ProxyClient[A]) baseExec(method, uri, resource string, body []byte, para
 		req.Body(body)
 	}
 
+	return t.doRequest(req, method, resource, params)
+}
+
+// baseExecStream is base execute method streaming the request body
+func (t ProxyClient[A]) baseExecStream(method, uri, resource string, body io.Reader, params map[string]string) (resp rest.Result, err error) {
+	req := t.getProxy(method).
+		Suffix(uri)
+	if body != nil {
+		req.Body(body)
+	}
+
+	return t.doRequest(req, method, resource, params)
+}
+
+func (t ProxyClient[A]) doRequest(req *rest.Request, method, resource string, params map[string]string) (resp rest.Result, err error) {
+
 	for key, value := range params {
 		if value != "" {
 			req.Param(key, value)
@@ -87,6 +104,16 @@ func (t ProxyClient[A]) Execute(method, uri string, body []byte, params map[stri
 	return t.getFromResponse(resp)
 }
 
+// ExecuteStream is a method to make an api call for a single object with streamed request body
+func (t ProxyClient[A]) ExecuteStream(method, uri string, body io.Reader, params map[string]string) (result A, err error) {
+	resp, err := t.baseExecStream(method, uri, fmt.Sprintf("%T", result), body, params)
+	if err != nil {
+		return result, err
+	}
+
+	return t.getFromResponse(resp)
+}
+
 // ExecuteMultiple is a method to make an api call for multiple objects
 func (t ProxyClient[A]) ExecuteMultiple(method, uri string, body []byte, params map[string]string) (result []A, err error) {
 	resp, err := t.baseExec(method, uri, fmt.Sprintf("%T", result), body, params)
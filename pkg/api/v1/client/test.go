@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
@@ -21,6 +23,11 @@ func NewTestClient(
 	artifactTransport Transport[testkube.Artifact],
 	serverInfoTransport Transport[testkube.ServerInfo],
 	debugInfoTransport Transport[testkube.DebugInfo],
+	testCaseTransport Transport[testkube.TestCaseResult],
+	testFlakinessTransport Transport[testkube.TestFlakiness],
+	executionDiffTransport Transport[testkube.ExecutionDiff],
+	executionsArchiveReportTransport Transport[testkube.ExecutionsArchiveReport],
+	executionAnnotationTransport Transport[testkube.ExecutionAnnotation],
 ) TestClient {
 	return TestClient{
 		testTransport:                     testTransport,
@@ -31,6 +38,11 @@ func NewTestClient(
 		artifactTransport:                 artifactTransport,
 		serverInfoTransport:               serverInfoTransport,
 		debugInfoTransport:                debugInfoTransport,
+		testCaseTransport:                 testCaseTransport,
+		testFlakinessTransport:            testFlakinessTransport,
+		executionDiffTransport:            executionDiffTransport,
+		executionsArchiveReportTransport:  executionsArchiveReportTransport,
+		executionAnnotationTransport:      executionAnnotationTransport,
 	}
 }
 
@@ -44,6 +56,11 @@ type TestClient struct {
 	artifactTransport                 Transport[testkube.Artifact]
 	serverInfoTransport               Transport[testkube.ServerInfo]
 	debugInfoTransport                Transport[testkube.DebugInfo]
+	testCaseTransport                 Transport[testkube.TestCaseResult]
+	testFlakinessTransport            Transport[testkube.TestFlakiness]
+	executionDiffTransport            Transport[testkube.ExecutionDiff]
+	executionsArchiveReportTransport  Transport[testkube.ExecutionsArchiveReport]
+	executionAnnotationTransport      Transport[testkube.ExecutionAnnotation]
 }
 
 // GetTest returns single test by id
@@ -58,6 +75,12 @@ func (c TestClient) GetTestWithExecution(id string) (test testkube.TestWithExecu
 	return c.testWithExecutionTransport.Execute(http.MethodGet, uri, nil, nil)
 }
 
+// GetTestFlakiness returns flakiness of the test computed from its executions
+func (c TestClient) GetTestFlakiness(id string) (flakiness testkube.TestFlakiness, err error) {
+	uri := c.testFlakinessTransport.GetURI("/tests/%s/flakiness", id)
+	return c.testFlakinessTransport.Execute(http.MethodGet, uri, nil, nil)
+}
+
 // ListTests list all tests
 func (c TestClient) ListTests(selector string) (tests testkube.Tests, err error) {
 	uri := c.testTransport.GetURI("/tests")
@@ -167,6 +190,11 @@ func (c TestClient) ExecuteTest(id, executionName string, options ExecuteTestOpt
 		EnvConfigMaps:                      options.EnvConfigMaps,
 		EnvSecrets:                         options.EnvSecrets,
 		RunningContext:                     options.RunningContext,
+		RetryPolicy:                        options.RetryPolicy,
+		Matrix:                             options.Matrix,
+		Shards:                             options.Shards,
+		PriorityClass:                      options.PriorityClass,
+		DryRun:                             options.DryRun,
 	}
 
 	body, err := json.Marshal(request)
@@ -208,6 +236,11 @@ func (c TestClient) ExecuteTests(selector string, concurrencyLevel int, options
 		NegativeTest:                       options.NegativeTest,
 		IsNegativeTestChangedOnRun:         options.IsNegativeTestChangedOnRun,
 		RunningContext:                     options.RunningContext,
+		RetryPolicy:                        options.RetryPolicy,
+		Matrix:                             options.Matrix,
+		Shards:                             options.Shards,
+		PriorityClass:                      options.PriorityClass,
+		DryRun:                             options.DryRun,
 	}
 
 	body, err := json.Marshal(request)
@@ -250,6 +283,90 @@ func (c TestClient) ListExecutions(id string, limit int, selector string) (execu
 	return c.executionsResultTransport.Execute(http.MethodGet, uri, nil, params)
 }
 
+// ListMatrixExecutions lists executions started for the combinations of the same matrix
+func (c TestClient) ListMatrixExecutions(parentExecutionID string) (executions testkube.ExecutionsResult, err error) {
+	uri := c.executionsResultTransport.GetURI("/executions/")
+	params := map[string]string{
+		"parentExecutionId": parentExecutionID,
+		"pageSize":          fmt.Sprintf("%d", testkube.MaxMatrixCombinations),
+	}
+
+	return c.executionsResultTransport.Execute(http.MethodGet, uri, nil, params)
+}
+
+// ListTestCases lists test cases of all executions, or of a single one when execution id is set
+func (c TestClient) ListTestCases(options ListTestCasesOptions) (testCases testkube.TestCaseResults, err error) {
+	uri := c.testCaseTransport.GetURI("/test-cases")
+	if options.ExecutionID != "" {
+		uri = c.testCaseTransport.GetURI("/executions/%s/test-cases", options.ExecutionID)
+	}
+
+	params := map[string]string{
+		"testName":      options.TestName,
+		"testSuiteName": options.TestSuiteName,
+		"name":          options.Name,
+		"classname":     options.Classname,
+		"status":        options.Statuses,
+		"pageSize":      fmt.Sprintf("%d", options.Limit),
+	}
+
+	return c.testCaseTransport.ExecuteMultiple(http.MethodGet, uri, nil, params)
+}
+
+// DiffExecutions compares two executions by id or name
+func (c TestClient) DiffExecutions(executionID, otherExecutionID string) (diff testkube.ExecutionDiff, err error) {
+	uri := c.executionDiffTransport.GetURI("/executions/%s/diff/%s", executionID, otherExecutionID)
+	return c.executionDiffTransport.Execute(http.MethodGet, uri, nil, nil)
+}
+
+// AddExecutionAnnotation adds triage annotation to the execution
+func (c TestClient) AddExecutionAnnotation(executionID string, annotation testkube.ExecutionAnnotation) (testkube.ExecutionAnnotation, error) {
+	uri := c.executionAnnotationTransport.GetURI("/executions/%s/annotations", executionID)
+	body, err := json.Marshal(annotation)
+	if err != nil {
+		return annotation, err
+	}
+
+	return c.executionAnnotationTransport.Execute(http.MethodPost, uri, body, nil)
+}
+
+// DeleteExecutionAnnotation deletes triage annotation from the execution
+func (c TestClient) DeleteExecutionAnnotation(executionID, annotationID string) error {
+	uri := c.executionAnnotationTransport.GetURI("/executions/%s/annotations/%s", executionID, annotationID)
+	return c.executionAnnotationTransport.Delete(uri, "", true)
+}
+
+// ExportExecutions downloads archive with executions matching the options to the file
+func (c TestClient) ExportExecutions(options ExportExecutionsOptions, file string) (archive string, err error) {
+	uri := c.executionTransport.GetURI("/executions/export")
+	params := map[string][]string{
+		"testName":  {options.TestName},
+		"status":    {options.Statuses},
+		"startDate": {options.StartDate},
+		"endDate":   {options.EndDate},
+		"selector":  {options.Selector},
+		"artifacts": {strconv.FormatBool(options.Artifacts)},
+	}
+
+	if options.LastNDays != 0 {
+		params["last"] = []string{strconv.Itoa(options.LastNDays)}
+	}
+
+	return c.executionTransport.GetFile(uri, filepath.Base(file), filepath.Dir(file), params)
+}
+
+// ImportExecutions uploads archive with executions created by ExportExecutions
+func (c TestClient) ImportExecutions(file string) (report testkube.ExecutionsArchiveReport, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return report, err
+	}
+	defer f.Close()
+
+	uri := c.executionsArchiveReportTransport.GetURI("/executions/import")
+	return c.executionsArchiveReportTransport.ExecuteStream(http.MethodPost, uri, f, nil)
+}
+
 // Logs returns logs stream from job pods, based on job pods logs
 func (c TestClient) Logs(id string) (logs chan output.Output, err error) {
 	logs = make(chan output.Output)
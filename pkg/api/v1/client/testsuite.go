@@ -18,6 +18,7 @@ func NewTestSuiteClient(
 	testSuiteWithExecutionSummaryTransport Transport[testkube.TestSuiteWithExecutionSummary],
 	testSuiteExecutionsResultTransport Transport[testkube.TestSuiteExecutionsResult],
 	testSuiteArtifactTransport Transport[testkube.Artifact],
+	testSuiteExecutionAnnotationTransport Transport[testkube.ExecutionAnnotation],
 ) TestSuiteClient {
 	return TestSuiteClient{
 		testSuiteTransport:                     testSuiteTransport,
@@ -26,6 +27,7 @@ func NewTestSuiteClient(
 		testSuiteWithExecutionSummaryTransport: testSuiteWithExecutionSummaryTransport,
 		testSuiteExecutionsResultTransport:     testSuiteExecutionsResultTransport,
 		testSuiteArtifactTransport:             testSuiteArtifactTransport,
+		testSuiteExecutionAnnotationTransport:  testSuiteExecutionAnnotationTransport,
 	}
 }
 
@@ -37,6 +39,7 @@ type TestSuiteClient struct {
 	testSuiteWithExecutionSummaryTransport Transport[testkube.TestSuiteWithExecutionSummary]
 	testSuiteExecutionsResultTransport     Transport[testkube.TestSuiteExecutionsResult]
 	testSuiteArtifactTransport             Transport[testkube.Artifact]
+	testSuiteExecutionAnnotationTransport  Transport[testkube.ExecutionAnnotation]
 }
 
 // GetTestSuite returns single test suite by id
@@ -137,6 +140,18 @@ func (c TestSuiteClient) AbortTestSuiteExecutions(testSuiteName string) error {
 	return c.testSuiteExecutionTransport.ExecuteMethod(http.MethodPost, uri, "", false)
 }
 
+// RerunTestSuiteExecution starts new execution of the test suite execution steps, with onlyFailed
+// passed step results are reused and other steps run again
+func (c TestSuiteClient) RerunTestSuiteExecution(executionID string, onlyFailed bool) (execution testkube.TestSuiteExecution, err error) {
+	uri := c.testSuiteExecutionTransport.GetURI("/test-suite-executions/%s/rerun", executionID)
+	params := map[string]string{}
+	if onlyFailed {
+		params["only"] = "failed"
+	}
+
+	return c.testSuiteExecutionTransport.Execute(http.MethodPost, uri, nil, params)
+}
+
 // GetTestSuiteExecutionArtifacts returns test suite execution artifacts by excution id
 func (c TestSuiteClient) GetTestSuiteExecutionArtifacts(executionID string) (artifacts testkube.Artifacts, err error) {
 	uri := c.testSuiteArtifactTransport.GetURI("/test-suite-executions/%s/artifacts", executionID)
@@ -246,3 +261,21 @@ func (c TestSuiteClient) ListTestSuiteExecutions(testID string, limit int, selec
 
 	return c.testSuiteExecutionsResultTransport.Execute(http.MethodGet, uri, nil, params)
 }
+
+// AddTestSuiteExecutionAnnotation adds triage annotation to the test suite execution
+func (c TestSuiteClient) AddTestSuiteExecutionAnnotation(executionID string, annotation testkube.ExecutionAnnotation) (
+	testkube.ExecutionAnnotation, error) {
+	uri := c.testSuiteExecutionAnnotationTransport.GetURI("/test-suite-executions/%s/annotations", executionID)
+	body, err := json.Marshal(annotation)
+	if err != nil {
+		return annotation, err
+	}
+
+	return c.testSuiteExecutionAnnotationTransport.Execute(http.MethodPost, uri, body, nil)
+}
+
+// DeleteTestSuiteExecutionAnnotation deletes triage annotation from the test suite execution
+func (c TestSuiteClient) DeleteTestSuiteExecutionAnnotation(executionID, annotationID string) error {
+	uri := c.testSuiteExecutionAnnotationTransport.GetURI("/test-suite-executions/%s/annotations/%s", executionID, annotationID)
+	return c.testSuiteExecutionAnnotationTransport.Delete(uri, "", true)
+}
@@ -14,4 +14,10 @@ type Config struct {
 	Id              string `json:"id"`
 	ClusterId       string `json:"clusterId"`
 	EnableTelemetry bool   `json:"enableTelemetry"`
+	// retention policies for test and test suite executions
+	RetentionPolicies []RetentionPolicy `json:"retentionPolicies"`
+	// quarantine of flaky tests
+	Quarantine *QuarantinePolicy `json:"quarantine,omitempty"`
+	// concurrency limits of running test executions
+	Queue *QueuePolicy `json:"queue,omitempty"`
 }
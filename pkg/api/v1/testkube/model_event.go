@@ -23,4 +23,6 @@ type Event struct {
 	ClusterName string `json:"clusterName,omitempty"`
 	// environment variables
 	Envs map[string]string `json:"envs,omitempty"`
+	// variables describing the test trigger event, set for trigger notifications
+	TriggerVariables map[string]string `json:"triggerVariables,omitempty"`
 }
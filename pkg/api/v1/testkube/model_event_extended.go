@@ -96,6 +96,36 @@ func NewEventEndTestSuiteTimeout(execution *TestSuiteExecution) Event {
 	}
 }
 
+func NewEventUpdatedTestExecution(execution *Execution) Event {
+	return Event{
+		Id:            uuid.NewString(),
+		Type_:         EventUpdated,
+		Resource:      EventResourcePtr(TESTEXECUTION_EventResource),
+		ResourceId:    execution.Id,
+		TestExecution: execution,
+	}
+}
+
+func NewEventUpdatedTestSuiteExecution(execution *TestSuiteExecution) Event {
+	return Event{
+		Id:                 uuid.NewString(),
+		Type_:              EventUpdated,
+		Resource:           EventResourcePtr(TESTSUITEEXECUTION_EventResource),
+		ResourceId:         execution.Id,
+		TestSuiteExecution: execution,
+	}
+}
+
+func NewEventTriggerNotification(triggerName string, variables map[string]string) Event {
+	return Event{
+		Id:               uuid.NewString(),
+		Type_:            EventTriggerNotification,
+		Resource:         EventResourcePtr(TRIGGER_EventResource),
+		ResourceId:       triggerName,
+		TriggerVariables: variables,
+	}
+}
+
 func (e Event) Type() EventType {
 	if e.Type_ != nil {
 		return *e.Type_
@@ -26,4 +26,5 @@ const (
 	CREATED_EventType               EventType = "created"
 	UPDATED_EventType               EventType = "updated"
 	DELETED_EventType               EventType = "deleted"
+	TRIGGER_NOTIFICATION_EventType  EventType = "trigger-notification"
 )
@@ -14,6 +14,7 @@ var AllEventTypes = []EventType{
 	CREATED_EventType,
 	DELETED_EventType,
 	UPDATED_EventType,
+	TRIGGER_NOTIFICATION_EventType,
 }
 
 func (t EventType) String() string {
@@ -38,6 +39,7 @@ var (
 	EventCreated             = EventTypePtr(CREATED_EventType)
 	EventDeleted             = EventTypePtr(DELETED_EventType)
 	EventUpdated             = EventTypePtr(UPDATED_EventType)
+	EventTriggerNotification = EventTypePtr(TRIGGER_NOTIFICATION_EventType)
 )
 
 func EventTypesFromSlice(types []string) []EventType {
@@ -75,4 +75,36 @@ type Execution struct {
 	ContainerShell string `json:"containerShell,omitempty"`
 	// test execution name started the test execution
 	TestExecutionName string `json:"testExecutionName,omitempty"`
+	// container image used for the execution
+	Image string `json:"image,omitempty"`
+	// annotations recorded during the triage of the execution
+	Annotations []ExecutionAnnotation `json:"annotations,omitempty"`
+	// execution attempt number, starting from 1
+	Attempt int32 `json:"attempt,omitempty"`
+	// id of the first execution attempt in case the execution is a retry
+	RetryOf string `json:"retryOf,omitempty"`
+	// another attempt of the execution may be started according to its retry policy
+	RetryPending bool `json:"retryPending,omitempty"`
+	// id of the api server replica retrying the execution
+	RunnerId string `json:"runnerId,omitempty"`
+	// time the replica retrying the execution last reported it's still retrying it
+	HeartbeatTime time.Time `json:"heartbeatTime,omitempty"`
+	// id of the parent execution grouping executions started for the matrix combinations
+	ParentExecutionId string `json:"parentExecutionId,omitempty"`
+	// matrix values of the execution
+	Matrix map[string]string `json:"matrix,omitempty"`
+	// number of pods the test was sharded across
+	Shards int32 `json:"shards,omitempty"`
+	// priority class of the execution in the execution queue
+	PriorityClass PriorityClass `json:"priorityClass,omitempty"`
+	// time the execution was queued, executions of the same priority class are admitted in this order
+	QueuedTime time.Time `json:"queuedTime,omitempty"`
+	// position of the queued execution in the execution queue, starting from 1
+	QueuePosition int32 `json:"queuePosition,omitempty"`
+	// request the queued execution was started with, used to start it after api server restart
+	ExecutionRequest *ExecutionRequest `json:"executionRequest,omitempty"`
+	// rendered Kubernetes manifests of the dry run execution in YAML
+	Manifests string `json:"manifests,omitempty"`
+	// whether the execution is a negative test, which passes when the test fails
+	NegativeTest bool `json:"negativeTest,omitempty"`
 }
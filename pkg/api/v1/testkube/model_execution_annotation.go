@@ -0,0 +1,30 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// execution annotation recording the triage conclusion
+type ExecutionAnnotation struct {
+	// annotation id
+	Id string `json:"id,omitempty"`
+	// annotation text
+	Text string `json:"text"`
+	// author of the annotation
+	Author string `json:"author,omitempty"`
+	// annotation category, e.g. infra, product-bug or flaky
+	Category string `json:"category,omitempty"`
+	// link to the external issue
+	IssueUrl string `json:"issueUrl,omitempty"`
+	// time the annotation was created
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+}
@@ -0,0 +1,45 @@
+package testkube
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewExecutionAnnotation returns annotation with generated id and creation time
+func NewExecutionAnnotation(annotation ExecutionAnnotation) ExecutionAnnotation {
+	annotation.Id = primitive.NewObjectID().Hex()
+	annotation.CreatedAt = time.Now()
+	return annotation
+}
+
+// Validate checks the annotation has text and valid issue link
+func (a ExecutionAnnotation) Validate() error {
+	if a.Text == "" {
+		return errors.New("annotation text is required")
+	}
+
+	if a.IssueUrl != "" {
+		u, err := url.ParseRequestURI(a.IssueUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("invalid issue url %s", a.IssueUrl)
+		}
+	}
+
+	return nil
+}
+
+// AnnotationCategories returns set of categories of the annotations
+func AnnotationCategories(annotations []ExecutionAnnotation) map[string]string {
+	categories := make(map[string]string)
+	for _, annotation := range annotations {
+		if annotation.Category != "" {
+			categories[annotation.Category] = "true"
+		}
+	}
+
+	return categories
+}
@@ -0,0 +1,32 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// differences between two test executions
+type ExecutionDiff struct {
+	// id of the first compared execution
+	ExecutionIdA string `json:"executionIdA"`
+	// name of the first compared execution
+	ExecutionNameA string `json:"executionNameA,omitempty"`
+	// id of the second compared execution
+	ExecutionIdB string `json:"executionIdB"`
+	// name of the second compared execution
+	ExecutionNameB string `json:"executionNameB,omitempty"`
+	// differing execution fields like status, image, args, git commit or duration
+	Fields []ExecutionDiffItem `json:"fields,omitempty"`
+	// differing resolved variables, secret values are masked
+	Variables []ExecutionDiffItem `json:"variables,omitempty"`
+	// differing step and assertion results
+	Steps []ExecutionDiffItem `json:"steps,omitempty"`
+	// unified diff of the execution outputs
+	Output string `json:"output,omitempty"`
+	// only the last lines of long outputs were compared
+	OutputTruncated bool `json:"outputTruncated,omitempty"`
+}
@@ -0,0 +1,18 @@
+package testkube
+
+type ExecutionDiffItems []ExecutionDiffItem
+
+func (list ExecutionDiffItems) Table() (header []string, output [][]string) {
+	header = []string{"Name", "A", "B"}
+
+	for _, item := range list {
+		output = append(output, []string{item.Name, item.A, item.B})
+	}
+
+	return
+}
+
+// IsEmpty checks if the executions don't differ
+func (d ExecutionDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && len(d.Variables) == 0 && len(d.Steps) == 0 && d.Output == ""
+}
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// single difference between two test executions
+type ExecutionDiffItem struct {
+	// name of the differing field, variable or step
+	Name string `json:"name"`
+	// value in the first execution, empty when it's missing
+	A string `json:"a,omitempty"`
+	// value in the second execution, empty when it's missing
+	B string `json:"b,omitempty"`
+}
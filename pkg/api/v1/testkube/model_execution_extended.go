@@ -2,6 +2,7 @@ package testkube
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -89,6 +90,50 @@ func (executions Executions) Table() (header []string, output [][]string) {
 	return
 }
 
+// MatrixExecutions are executions started for matrix combinations, rendered as a grid of matrix values
+type MatrixExecutions []Execution
+
+func (executions MatrixExecutions) Table() (header []string, output [][]string) {
+	var keys []string
+	found := map[string]struct{}{}
+	for _, e := range executions {
+		for key := range e.Matrix {
+			if _, ok := found[key]; !ok {
+				found[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	header = append(append([]string{"Test Name"}, keys...), "Status", "Id")
+	for _, e := range executions {
+		status := "unknown"
+		if e.ExecutionResult != nil && e.ExecutionResult.Status != nil {
+			status = string(*e.ExecutionResult.Status)
+		}
+
+		row := []string{e.TestName}
+		for _, key := range keys {
+			row = append(row, e.Matrix[key])
+		}
+
+		output = append(output, append(row, status, e.Id))
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		for k := range output[i] {
+			if output[i][k] != output[j][k] {
+				return output[i][k] < output[j][k]
+			}
+		}
+
+		return false
+	})
+
+	return
+}
+
 func (e *Execution) WithContent(content *TestContent) *Execution {
 	e.Content = content
 	return e
@@ -94,5 +94,16 @@ type ExecutionRequest struct {
 	EnvSecrets     []EnvReference  `json:"envSecrets,omitempty"`
 	RunningContext *RunningContext `json:"runningContext,omitempty"`
 	// test execution name started the test execution
-	TestExecutionName string `json:"testExecutionName,omitempty"`
+	TestExecutionName string       `json:"testExecutionName,omitempty"`
+	RetryPolicy       *RetryPolicy `json:"retryPolicy,omitempty"`
+	// matrix of variable values, one execution is started for each combination of the values
+	Matrix map[string][]string `json:"matrix,omitempty"`
+	// id of the parent execution grouping executions started for the matrix combinations
+	ParentExecutionId string `json:"parentExecutionId,omitempty"`
+	// number of pods running the test in parallel, results of the shards are merged into a single execution
+	Shards int32 `json:"shards,omitempty"`
+	// priority class of the execution in the execution queue, derived from the running context when not set
+	PriorityClass PriorityClass `json:"priorityClass,omitempty"`
+	// render Kubernetes manifests of the execution without creating them
+	DryRun bool `json:"dryRun,omitempty"`
 }
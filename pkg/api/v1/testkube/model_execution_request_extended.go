@@ -0,0 +1,102 @@
+package testkube
+
+import (
+	"fmt"
+	"sort"
+)
+
+// MaxMatrixCombinations is maximum number of executions started for a single matrix
+const MaxMatrixCombinations = 100
+
+// ExpandMatrix returns all combinations of matrix values, ordered by sorted matrix keys and values order
+func ExpandMatrix(matrix map[string][]string) []map[string]string {
+	if len(matrix) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(matrix))
+	for key := range matrix {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	combinations := []map[string]string{{}}
+	for _, key := range keys {
+		var expanded []map[string]string
+		for _, combination := range combinations {
+			for _, value := range matrix[key] {
+				next := make(map[string]string, len(combination)+1)
+				for k, v := range combination {
+					next[k] = v
+				}
+
+				next[key] = value
+				expanded = append(expanded, next)
+			}
+		}
+
+		combinations = expanded
+	}
+
+	return combinations
+}
+
+// ValidateMatrix checks that all matrix keys have values and the number of combinations isn't too high
+func ValidateMatrix(matrix map[string][]string) error {
+	combinations := 1
+	for key, values := range matrix {
+		if key == "" {
+			return fmt.Errorf("matrix key can't be empty")
+		}
+
+		if len(values) == 0 {
+			return fmt.Errorf("matrix key %s has no values", key)
+		}
+
+		combinations *= len(values)
+		if combinations > MaxMatrixCombinations {
+			return fmt.Errorf("matrix can have at most %d combinations", MaxMatrixCombinations)
+		}
+	}
+
+	return nil
+}
+
+// GetMatrixValues returns matrix values of the request which describes a single matrix combination
+func (r ExecutionRequest) GetMatrixValues() map[string]string {
+	if len(r.Matrix) == 0 {
+		return nil
+	}
+
+	values := make(map[string]string, len(r.Matrix))
+	for key, value := range r.Matrix {
+		if len(value) != 1 {
+			return nil
+		}
+
+		values[key] = value[0]
+	}
+
+	return values
+}
+
+// MaxShards is maximum number of pods a single execution can be sharded across
+const MaxShards = 50
+
+// ValidateShards checks that the number of shards is in the allowed range
+func ValidateShards(shards int32) error {
+	if shards < 0 || shards > MaxShards {
+		return fmt.Errorf("shards should be between 0 and %d", MaxShards)
+	}
+
+	return nil
+}
+
+// GetPriorityClass returns priority class of the request or the one of its running context when not set
+func (r ExecutionRequest) GetPriorityClass() PriorityClass {
+	if r.PriorityClass != "" {
+		return r.PriorityClass
+	}
+
+	return GetPriorityClass(r.RunningContext)
+}
@@ -0,0 +1,45 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandMatrix(t *testing.T) {
+	t.Run("returns all combinations ordered by keys", func(t *testing.T) {
+		combinations := ExpandMatrix(map[string][]string{
+			"region": {"eu", "us"},
+			"env":    {"a", "b", "c"},
+		})
+
+		assert.Equal(t, []map[string]string{
+			{"env": "a", "region": "eu"},
+			{"env": "a", "region": "us"},
+			{"env": "b", "region": "eu"},
+			{"env": "b", "region": "us"},
+			{"env": "c", "region": "eu"},
+			{"env": "c", "region": "us"},
+		}, combinations)
+	})
+
+	t.Run("returns nothing for empty matrix", func(t *testing.T) {
+		assert.Empty(t, ExpandMatrix(nil))
+	})
+}
+
+func TestValidateMatrix(t *testing.T) {
+	assert.NoError(t, ValidateMatrix(map[string][]string{"region": {"eu", "us"}}))
+	assert.Error(t, ValidateMatrix(map[string][]string{"region": {}}))
+	assert.Error(t, ValidateMatrix(map[string][]string{"": {"eu"}}))
+	assert.Error(t, ValidateMatrix(map[string][]string{
+		"a": {"1", "2", "3", "4", "5"},
+		"b": {"1", "2", "3", "4", "5"},
+		"c": {"1", "2", "3", "4", "5"},
+	}))
+}
+
+func TestExecutionRequest_GetMatrixValues(t *testing.T) {
+	assert.Equal(t, map[string]string{"region": "eu"}, ExecutionRequest{Matrix: map[string][]string{"region": {"eu"}}}.GetMatrixValues())
+	assert.Nil(t, ExecutionRequest{Matrix: map[string][]string{"region": {"eu", "us"}}}.GetMatrixValues())
+}
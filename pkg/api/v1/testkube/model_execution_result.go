@@ -21,4 +21,6 @@ type ExecutionResult struct {
 	// execution steps (for collection of requests)
 	Steps   []ExecutionStepResult   `json:"steps,omitempty"`
 	Reports *ExecutionResultReports `json:"reports,omitempty"`
+	// named outputs emitted by the executor, available to later test suite steps
+	Outputs map[string]string `json:"outputs,omitempty"`
 }
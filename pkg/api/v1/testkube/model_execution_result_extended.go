@@ -1,5 +1,10 @@
 package testkube
 
+import (
+	"fmt"
+	"strings"
+)
+
 func NewRunningExecutionResult() *ExecutionResult {
 	return &ExecutionResult{
 		Status: StatusPtr(RUNNING_ExecutionStatus),
@@ -37,6 +42,10 @@ func (e *ExecutionResult) Success() {
 	e.Status = StatusPtr(PASSED_ExecutionStatus)
 }
 
+func (e *ExecutionResult) Skip() {
+	e.Status = StatusPtr(SKIPPED_ExecutionStatus)
+}
+
 func (e *ExecutionResult) Error() {
 	e.Status = StatusPtr(FAILED_ExecutionStatus)
 }
@@ -68,6 +77,25 @@ func (e *ExecutionResult) IsTimeout() bool {
 	return *e.Status == TIMEOUT_ExecutionStatus
 }
 
+func (e *ExecutionResult) IsSkipped() bool {
+	return *e.Status == SKIPPED_ExecutionStatus
+}
+
+// AddOutputs adds named outputs which aren't set in the result yet
+func (e *ExecutionResult) AddOutputs(outputs map[string]string) {
+	for name, value := range outputs {
+		if _, ok := e.Outputs[name]; ok {
+			continue
+		}
+
+		if e.Outputs == nil {
+			e.Outputs = map[string]string{}
+		}
+
+		e.Outputs[name] = value
+	}
+}
+
 func (e *ExecutionResult) Err(err error) *ExecutionResult {
 	e.Status = ExecutionStatusFailed
 	e.ErrorMessage = err.Error()
@@ -128,5 +156,80 @@ func (e *ExecutionResult) GetDeepCopy() *ExecutionResult {
 		Steps:        e.Steps,
 		Reports:      reports,
 	}
+
+	if e.Outputs != nil {
+		result.Outputs = make(map[string]string, len(e.Outputs))
+		for name, value := range e.Outputs {
+			result.Outputs[name] = value
+		}
+	}
+
 	return &result
 }
+
+// shardStatusPriority orders shard statuses, the most important status is used for merged result
+var shardStatusPriority = []ExecutionStatus{
+	ABORTED_ExecutionStatus,
+	TIMEOUT_ExecutionStatus,
+	FAILED_ExecutionStatus,
+	RUNNING_ExecutionStatus,
+	QUEUED_ExecutionStatus,
+	PASSED_ExecutionStatus,
+}
+
+// MergeShardResults merges results of test shards into a single result,
+// the result fails when any of the shards fails
+func MergeShardResults(results []ExecutionResult) ExecutionResult {
+	if len(results) == 1 {
+		return results[0]
+	}
+
+	var (
+		merged   ExecutionResult
+		outputs  []string
+		errors   []string
+		statuses = map[ExecutionStatus]bool{}
+	)
+
+	for i, result := range results {
+		shard := fmt.Sprintf("shard %d/%d", i+1, len(results))
+		if result.Status != nil {
+			statuses[*result.Status] = true
+		}
+
+		if result.Output != "" {
+			outputs = append(outputs, fmt.Sprintf("=== %s ===\n%s", shard, result.Output))
+		}
+
+		if result.ErrorMessage != "" {
+			errors = append(errors, fmt.Sprintf("%s: %s", shard, result.ErrorMessage))
+		}
+
+		if merged.OutputType == "" {
+			merged.OutputType = result.OutputType
+		}
+
+		for _, step := range result.Steps {
+			step.Name = fmt.Sprintf("[%s] %s", shard, step.Name)
+			merged.Steps = append(merged.Steps, step)
+		}
+
+		if merged.Reports == nil && result.Reports != nil && *result.Reports != (ExecutionResultReports{}) {
+			merged.Reports = result.Reports
+		}
+
+		merged.AddOutputs(result.Outputs)
+	}
+
+	merged.Status = StatusPtr(PASSED_ExecutionStatus)
+	for _, status := range shardStatusPriority {
+		if statuses[status] {
+			merged.Status = StatusPtr(status)
+			break
+		}
+	}
+
+	merged.Output = strings.Join(outputs, "\n")
+	merged.ErrorMessage = strings.Join(errors, "\n")
+	return merged
+}
@@ -0,0 +1,60 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeShardResults(t *testing.T) {
+	t.Run("fails when any of the shards fails", func(t *testing.T) {
+		result := MergeShardResults([]ExecutionResult{
+			{
+				Status: StatusPtr(PASSED_ExecutionStatus),
+				Output: "first",
+				Steps:  []ExecutionStepResult{{Name: "login", Status: "passed"}},
+			},
+			{
+				Status:       StatusPtr(FAILED_ExecutionStatus),
+				Output:       "second",
+				ErrorMessage: "1 test failed",
+				Steps:        []ExecutionStepResult{{Name: "checkout", Status: "failed"}},
+				Reports:      &ExecutionResultReports{Junit: "<testsuites/>"},
+			},
+		})
+
+		assert.Equal(t, FAILED_ExecutionStatus, *result.Status)
+		assert.Equal(t, "=== shard 1/2 ===\nfirst\n=== shard 2/2 ===\nsecond", result.Output)
+		assert.Equal(t, "shard 2/2: 1 test failed", result.ErrorMessage)
+		assert.Equal(t, []ExecutionStepResult{
+			{Name: "[shard 1/2] login", Status: "passed"},
+			{Name: "[shard 2/2] checkout", Status: "failed"},
+		}, result.Steps)
+		assert.Equal(t, "<testsuites/>", result.Reports.Junit)
+	})
+
+	t.Run("passes when all shards pass", func(t *testing.T) {
+		result := MergeShardResults([]ExecutionResult{
+			{Status: StatusPtr(PASSED_ExecutionStatus), Outputs: map[string]string{"url": "a"}},
+			{Status: StatusPtr(PASSED_ExecutionStatus), Outputs: map[string]string{"url": "b", "token": "c"}},
+		})
+
+		assert.Equal(t, PASSED_ExecutionStatus, *result.Status)
+		assert.Equal(t, map[string]string{"url": "a", "token": "c"}, result.Outputs)
+	})
+
+	t.Run("prefers timeout over failure", func(t *testing.T) {
+		result := MergeShardResults([]ExecutionResult{
+			{Status: StatusPtr(FAILED_ExecutionStatus)},
+			{Status: StatusPtr(TIMEOUT_ExecutionStatus)},
+		})
+
+		assert.Equal(t, TIMEOUT_ExecutionStatus, *result.Status)
+	})
+
+	t.Run("returns single shard result unchanged", func(t *testing.T) {
+		result := MergeShardResults([]ExecutionResult{{Status: StatusPtr(PASSED_ExecutionStatus), Output: "only"}})
+
+		assert.Equal(t, "only", result.Output)
+	})
+}
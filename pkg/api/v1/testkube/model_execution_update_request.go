@@ -95,4 +95,6 @@ type ExecutionUpdateRequest struct {
 	RunningContext *RunningContext `json:"runningContext,omitempty"`
 	// test execution name started the test execution
 	TestExecutionName *string `json:"testExecutionName,omitempty"`
+	// number of pods running the test in parallel, results of the shards are merged into a single execution
+	Shards *int32 `json:"shards,omitempty"`
 }
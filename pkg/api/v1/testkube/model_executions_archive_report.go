@@ -0,0 +1,24 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// summary of executions exported to or imported from executions archive
+type ExecutionsArchiveReport struct {
+	// number of test executions
+	Executions int32 `json:"executions"`
+	// number of test suite executions
+	TestSuiteExecutions int32 `json:"testSuiteExecutions"`
+	// number of artifacts
+	Artifacts int32 `json:"artifacts"`
+	// ids of test executions skipped as they already exist
+	SkippedExecutions []string `json:"skippedExecutions,omitempty"`
+	// ids of test suite executions skipped as they already exist
+	SkippedTestSuiteExecutions []string `json:"skippedTestSuiteExecutions,omitempty"`
+}
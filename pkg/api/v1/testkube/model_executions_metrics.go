@@ -34,4 +34,6 @@ type ExecutionsMetrics struct {
 	FailedExecutions int32 `json:"failedExecutions,omitempty"`
 	// List of test/testsuite executions
 	Executions []ExecutionsMetricsExecutions `json:"executions,omitempty"`
+	// trend of executions metrics in time buckets, set when granularity is requested
+	Trend []ExecutionsMetricsBucket `json:"trend,omitempty"`
 }
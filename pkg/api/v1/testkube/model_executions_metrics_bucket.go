@@ -0,0 +1,36 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// executions metrics in one time bucket of the trend
+type ExecutionsMetricsBucket struct {
+	// start of the time bucket
+	StartTime time.Time `json:"startTime"`
+	// value of the label the executions are grouped by
+	Group string `json:"group,omitempty"`
+	// Percentage pass to fail ratio
+	PassFailRatio float64 `json:"passFailRatio"`
+	// total executions number
+	TotalExecutions int32 `json:"totalExecutions"`
+	// failed executions number
+	FailedExecutions int32 `json:"failedExecutions"`
+	// 50th percentile of all durations in milliseconds
+	ExecutionDurationP50ms int32 `json:"executionDurationP50ms"`
+	// 90th percentile of all durations in milliseconds
+	ExecutionDurationP90ms int32 `json:"executionDurationP90ms"`
+	// 95th percentile of all durations in milliseconds
+	ExecutionDurationP95ms int32 `json:"executionDurationP95ms"`
+	// 99th percentile of all durations in milliseconds
+	ExecutionDurationP99ms int32 `json:"executionDurationP99ms"`
+}
@@ -14,10 +14,11 @@ import (
 )
 
 type ExecutionsMetricsExecutions struct {
-	ExecutionId string    `json:"executionId,omitempty"`
-	Duration    string    `json:"duration,omitempty"`
-	DurationMs  int32     `json:"durationMs,omitempty"`
-	Status      string    `json:"status,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	StartTime   time.Time `json:"startTime,omitempty"`
+	ExecutionId string            `json:"executionId,omitempty"`
+	Duration    string            `json:"duration,omitempty"`
+	DurationMs  int32             `json:"durationMs,omitempty"`
+	Status      string            `json:"status,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	StartTime   time.Time         `json:"startTime,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
 }
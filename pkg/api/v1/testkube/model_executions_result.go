@@ -14,4 +14,6 @@ type ExecutionsResult struct {
 	Totals   *ExecutionsTotals  `json:"totals"`
 	Filtered *ExecutionsTotals  `json:"filtered,omitempty"`
 	Results  []ExecutionSummary `json:"results"`
+	// cursor of the next page, empty for the last page
+	Next string `json:"next,omitempty"`
 }
@@ -18,8 +18,10 @@ type ExecutorOutput struct {
 	// One of possible output types
 	Type_ string `json:"type"`
 	// Message/event data passed from executor (like log lines etc)
-	Content string           `json:"content,omitempty"`
-	Result  *ExecutionResult `json:"result,omitempty"`
+	Content string `json:"content,omitempty"`
+	// Name of the output value for output type
+	Name   string           `json:"name,omitempty"`
+	Result *ExecutionResult `json:"result,omitempty"`
 	// Timestamp of log
 	Time time.Time `json:"time,omitempty"`
 }
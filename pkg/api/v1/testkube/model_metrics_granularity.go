@@ -0,0 +1,19 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// MetricsGranularity : size of the time buckets of metrics trend
+type MetricsGranularity string
+
+// List of MetricsGranularity
+const (
+	HOUR_MetricsGranularity MetricsGranularity = "hour"
+	DAY_MetricsGranularity  MetricsGranularity = "day"
+)
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// PriorityClass : priority of the queued execution, ci executions are started before scheduled ones and scheduled before triggered ones
+type PriorityClass string
+
+// List of PriorityClass
+const (
+	CI_PriorityClass        PriorityClass = "ci"
+	SCHEDULED_PriorityClass PriorityClass = "scheduled"
+	TRIGGER_PriorityClass   PriorityClass = "trigger"
+)
@@ -0,0 +1,45 @@
+package testkube
+
+import (
+	"fmt"
+)
+
+// PriorityClasses are all supported priority classes ordered from the highest priority
+var PriorityClasses = []PriorityClass{CI_PriorityClass, SCHEDULED_PriorityClass, TRIGGER_PriorityClass}
+
+// GetPriorityClass returns priority class of the execution started in given running context,
+// scheduled and triggered executions have lower priority than the ones started by users and ci pipelines
+func GetPriorityClass(runningContext *RunningContext) PriorityClass {
+	if runningContext == nil {
+		return CI_PriorityClass
+	}
+
+	switch RunningContextType(runningContext.Type_) {
+	case RunningContextTypeScheduler:
+		return SCHEDULED_PriorityClass
+	case RunningContextTypeTestTrigger:
+		return TRIGGER_PriorityClass
+	}
+
+	return CI_PriorityClass
+}
+
+// Priority returns priority of the class, higher value is started first
+func (c PriorityClass) Priority() int {
+	for i, class := range PriorityClasses {
+		if class == c {
+			return len(PriorityClasses) - i
+		}
+	}
+
+	return 0
+}
+
+// ValidatePriorityClass checks that priority class is empty or one of the supported ones
+func ValidatePriorityClass(class PriorityClass) error {
+	if class == "" || class.Priority() != 0 {
+		return nil
+	}
+
+	return fmt.Errorf("unsupported priority class %s, use one of %v", class, PriorityClasses)
+}
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// quarantine of flaky tests, failures of quarantined tests don't fail their test suite executions
+type QuarantinePolicy struct {
+	// whether failures of flaky tests are quarantined
+	Enabled bool `json:"enabled"`
+	// flakiness score from which the test is considered flaky, 0.2 when not set
+	Threshold float64 `json:"threshold,omitempty"`
+	// number of latest test executions used for computing flakiness score, 100 when not set
+	Window int32 `json:"window,omitempty"`
+}
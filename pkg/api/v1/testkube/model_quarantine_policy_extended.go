@@ -0,0 +1,48 @@
+package testkube
+
+import (
+	"errors"
+)
+
+const (
+	// DefaultFlakinessThreshold is flakiness score from which the test is considered flaky
+	DefaultFlakinessThreshold = 0.2
+	// DefaultFlakinessWindow is number of latest test executions used for computing flakiness score
+	DefaultFlakinessWindow = 100
+)
+
+// GetThreshold returns flakiness threshold of the policy or the default one
+func (p *QuarantinePolicy) GetThreshold() float64 {
+	if p == nil || p.Threshold == 0 {
+		return DefaultFlakinessThreshold
+	}
+
+	return p.Threshold
+}
+
+// GetWindow returns flakiness window of the policy or the default one
+func (p *QuarantinePolicy) GetWindow() int {
+	if p == nil || p.Window == 0 {
+		return DefaultFlakinessWindow
+	}
+
+	return int(p.Window)
+}
+
+// IsEnabled checks if failures of flaky tests are quarantined
+func (p *QuarantinePolicy) IsEnabled() bool {
+	return p != nil && p.Enabled
+}
+
+// Validate checks that threshold is a valid flakiness score and window isn't negative
+func (p QuarantinePolicy) Validate() error {
+	if p.Threshold < 0 || p.Threshold > 1 {
+		return errors.New("quarantine threshold has to be between 0 and 1")
+	}
+
+	if p.Window < 0 {
+		return errors.New("quarantine window can't be negative")
+	}
+
+	return nil
+}
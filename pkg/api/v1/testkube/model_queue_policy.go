@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// concurrency limits of running test executions, executions over the limits are queued
+type QueuePolicy struct {
+	// maximum number of running test executions in the cluster, unlimited when not set
+	Concurrency int32 `json:"concurrency,omitempty"`
+	// maximum number of running test executions by test namespace
+	Namespaces map[string]int32 `json:"namespaces,omitempty"`
+	// maximum number of running test executions by executor name
+	Executors map[string]int32 `json:"executors,omitempty"`
+	// maximum number of running test executions by label in form of key=value
+	Labels map[string]int32 `json:"labels,omitempty"`
+}
@@ -0,0 +1,65 @@
+package testkube
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IsLimited checks if the policy limits concurrency of running test executions
+func (p *QueuePolicy) IsLimited() bool {
+	return p != nil && (p.Concurrency > 0 || len(p.Namespaces) != 0 || len(p.Executors) != 0 || len(p.Labels) != 0)
+}
+
+// GetLimits returns concurrency limits applied to the test execution with given namespace, executor and labels,
+// limits are keyed by the group of executions sharing them, limits which aren't positive are unlimited
+func (p *QueuePolicy) GetLimits(namespace, executor string, labels map[string]string) map[string]int32 {
+	limits := map[string]int32{}
+	if p == nil {
+		return limits
+	}
+
+	if p.Concurrency > 0 {
+		limits["cluster"] = p.Concurrency
+	}
+
+	if limit := p.Namespaces[namespace]; limit > 0 && namespace != "" {
+		limits["namespace/"+namespace] = limit
+	}
+
+	if limit := p.Executors[executor]; limit > 0 && executor != "" {
+		limits["executor/"+executor] = limit
+	}
+
+	for label, limit := range p.Labels {
+		key, value, _ := strings.Cut(label, "=")
+		if v, ok := labels[key]; ok && v == value && limit > 0 {
+			limits["label/"+label] = limit
+		}
+	}
+
+	return limits
+}
+
+// Validate checks that limits aren't negative and labels are in form of key=value
+func (p QueuePolicy) Validate() error {
+	if p.Concurrency < 0 {
+		return errors.New("queue concurrency can't be negative")
+	}
+
+	for _, limits := range []map[string]int32{p.Namespaces, p.Executors, p.Labels} {
+		for name, limit := range limits {
+			if limit < 0 {
+				return fmt.Errorf("queue concurrency of %s can't be negative", name)
+			}
+		}
+	}
+
+	for label := range p.Labels {
+		if key, _, ok := strings.Cut(label, "="); !ok || key == "" {
+			return fmt.Errorf("queue label %s has to be in form of key=value", label)
+		}
+	}
+
+	return nil
+}
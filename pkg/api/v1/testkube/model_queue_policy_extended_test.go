@@ -0,0 +1,71 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueuePolicy_GetLimits(t *testing.T) {
+	t.Parallel()
+
+	policy := &QueuePolicy{
+		Concurrency: 10,
+		Namespaces:  map[string]int32{"testkube": 5, "staging": 0},
+		Executors:   map[string]int32{"k6-executor": 2},
+		Labels:      map[string]int32{"team=qa": 3, "team=dev": 4},
+	}
+
+	t.Run("all matching limits", func(t *testing.T) {
+		t.Parallel()
+
+		limits := policy.GetLimits("testkube", "k6-executor", map[string]string{"team": "qa"})
+
+		assert.Equal(t, map[string]int32{
+			"cluster":              10,
+			"namespace/testkube":   5,
+			"executor/k6-executor": 2,
+			"label/team=qa":        3,
+		}, limits)
+	})
+
+	t.Run("zero limit is unlimited", func(t *testing.T) {
+		t.Parallel()
+
+		limits := policy.GetLimits("staging", "curl-executor", nil)
+
+		assert.Equal(t, map[string]int32{"cluster": 10}, limits)
+	})
+
+	t.Run("nil policy", func(t *testing.T) {
+		t.Parallel()
+
+		var policy *QueuePolicy
+
+		assert.False(t, policy.IsLimited())
+		assert.Empty(t, policy.GetLimits("testkube", "k6-executor", nil))
+	})
+}
+
+func TestQueuePolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, QueuePolicy{Concurrency: 3, Labels: map[string]int32{"team=qa": 1}}.Validate())
+	assert.Error(t, QueuePolicy{Concurrency: -1}.Validate())
+	assert.Error(t, QueuePolicy{Executors: map[string]int32{"k6-executor": -2}}.Validate())
+	assert.Error(t, QueuePolicy{Labels: map[string]int32{"team": 1}}.Validate())
+}
+
+func TestGetPriorityClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, CI_PriorityClass, GetPriorityClass(nil))
+	assert.Equal(t, CI_PriorityClass, GetPriorityClass(&RunningContext{Type_: string(RunningContextTypeUserCLI)}))
+	assert.Equal(t, SCHEDULED_PriorityClass, GetPriorityClass(&RunningContext{Type_: string(RunningContextTypeScheduler)}))
+	assert.Equal(t, TRIGGER_PriorityClass, GetPriorityClass(&RunningContext{Type_: string(RunningContextTypeTestTrigger)}))
+
+	assert.Greater(t, CI_PriorityClass.Priority(), SCHEDULED_PriorityClass.Priority())
+	assert.Greater(t, SCHEDULED_PriorityClass.Priority(), TRIGGER_PriorityClass.Priority())
+	assert.NoError(t, ValidatePriorityClass(""))
+	assert.Error(t, ValidatePriorityClass("urgent"))
+}
@@ -0,0 +1,24 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// retention policy for executions of a single test or test suite, execution is deleted only when none of the defined limits keeps it
+type RetentionPolicy struct {
+	// test name, either test or test suite has to be set
+	Test string `json:"test,omitempty"`
+	// test suite name, either test or test suite has to be set
+	TestSuite string `json:"testSuite,omitempty"`
+	// number of latest executions to keep
+	KeepLast int32 `json:"keepLast,omitempty"`
+	// number of days to keep executions for
+	KeepDays int32 `json:"keepDays,omitempty"`
+	// number of days to keep failed executions for
+	KeepFailedDays int32 `json:"keepFailedDays,omitempty"`
+}
@@ -0,0 +1,88 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"errors"
+	"fmt"
+)
+
+type RetentionPolicies []RetentionPolicy
+
+func (list RetentionPolicies) Table() (header []string, output [][]string) {
+	header = []string{"Test", "Test suite", "Keep last", "Keep days", "Keep failed days"}
+
+	for _, p := range list {
+		output = append(output, []string{
+			p.Test,
+			p.TestSuite,
+			fmt.Sprint(p.KeepLast),
+			fmt.Sprint(p.KeepDays),
+			fmt.Sprint(p.KeepFailedDays),
+		})
+	}
+
+	return
+}
+
+// Validate checks that every policy is valid and that there is only one policy per test and test suite
+func (list RetentionPolicies) Validate() error {
+	tests := map[string]bool{}
+	testSuites := map[string]bool{}
+	for _, p := range list {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		if p.Test != "" {
+			if tests[p.Test] {
+				return fmt.Errorf("duplicated retention policy for test %s", p.Test)
+			}
+			tests[p.Test] = true
+		}
+
+		if p.TestSuite != "" {
+			if testSuites[p.TestSuite] {
+				return fmt.Errorf("duplicated retention policy for test suite %s", p.TestSuite)
+			}
+			testSuites[p.TestSuite] = true
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that policy targets either test or test suite and defines at least one limit
+func (p RetentionPolicy) Validate() error {
+	if (p.Test == "") == (p.TestSuite == "") {
+		return errors.New("retention policy has to define either test or test suite")
+	}
+
+	if p.KeepLast < 0 || p.KeepDays < 0 || p.KeepFailedDays < 0 {
+		return errors.New("retention policy limits can't be negative")
+	}
+
+	if p.KeepLast == 0 && p.KeepDays == 0 && p.KeepFailedDays == 0 {
+		return errors.New("retention policy has to define at least one of keep last, keep days or keep failed days")
+	}
+
+	return nil
+}
+
+// Index returns position of the policy for the same test or test suite, -1 if there is none
+func (list RetentionPolicies) Index(test, testSuite string) int {
+	for i, p := range list {
+		if p.Test == test && p.TestSuite == testSuite {
+			return i
+		}
+	}
+
+	return -1
+}
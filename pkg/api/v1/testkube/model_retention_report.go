@@ -0,0 +1,18 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// executions deleted by retention policies
+type RetentionReport struct {
+	// whether executions were only reported and not deleted
+	DryRun              bool                  `json:"dryRun"`
+	Executions          []RetentionReportItem `json:"executions,omitempty"`
+	TestSuiteExecutions []RetentionReportItem `json:"testSuiteExecutions,omitempty"`
+}
@@ -0,0 +1,28 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"fmt"
+)
+
+func (r RetentionReport) Table() (header []string, output [][]string) {
+	header = []string{"Type", "Id", "Name", "Test", "Test suite", "Status", "Start time", "Artifacts"}
+
+	for _, e := range r.TestSuiteExecutions {
+		output = append(output, []string{"test suite execution", e.Id, e.Name, e.Test, e.TestSuite, e.Status, e.StartTime.String(), ""})
+	}
+
+	for _, e := range r.Executions {
+		output = append(output, []string{"execution", e.Id, e.Name, e.Test, e.TestSuite, e.Status, e.StartTime.String(), fmt.Sprint(e.Artifacts)})
+	}
+
+	return
+}
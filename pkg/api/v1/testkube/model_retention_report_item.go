@@ -0,0 +1,32 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// execution deleted by retention policy
+type RetentionReportItem struct {
+	// execution id
+	Id string `json:"id,omitempty"`
+	// execution name
+	Name string `json:"name,omitempty"`
+	// test name
+	Test string `json:"test,omitempty"`
+	// test suite name
+	TestSuite string `json:"testSuite,omitempty"`
+	// execution status
+	Status string `json:"status,omitempty"`
+	// execution start time
+	StartTime time.Time `json:"startTime,omitempty"`
+	// number of deleted artifact files
+	Artifacts int32 `json:"artifacts,omitempty"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// policy of retrying failed test executions
+type RetryPolicy struct {
+	// maximum number of attempts including the first one
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// delay before the first retry
+	Backoff string `json:"backoff,omitempty"`
+	// multiplier applied to the delay before each next retry, delay stays the same when not set
+	BackoffFactor float64 `json:"backoffFactor,omitempty"`
+	// statuses of the attempt to retry, all of them are retried when not set
+	Statuses []RetryStatus `json:"statuses,omitempty"`
+}
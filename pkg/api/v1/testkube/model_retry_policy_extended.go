@@ -0,0 +1,106 @@
+package testkube
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// MaxRetryAttempts is the upper limit of attempts of the retry policy
+	MaxRetryAttempts = 10
+	// MaxRetryBackoff is the upper limit of delay before a retry
+	MaxRetryBackoff = time.Hour
+)
+
+// GetMaxAttempts returns maximum number of attempts of the policy, single attempt when no policy is set
+func (p *RetryPolicy) GetMaxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	if p.MaxAttempts > MaxRetryAttempts {
+		return MaxRetryAttempts
+	}
+
+	return int(p.MaxAttempts)
+}
+
+// GetBackoff returns delay before the given retry, starting from 1, limited by MaxRetryBackoff
+func (p *RetryPolicy) GetBackoff(retry int) time.Duration {
+	if p == nil || p.Backoff == "" {
+		return 0
+	}
+
+	backoff, err := time.ParseDuration(p.Backoff)
+	if err != nil {
+		return 0
+	}
+
+	if p.BackoffFactor > 0 && retry > 1 {
+		backoff = time.Duration(math.Min(float64(backoff)*math.Pow(p.BackoffFactor, float64(retry-1)), float64(MaxRetryBackoff)))
+	}
+
+	if backoff > MaxRetryBackoff {
+		return MaxRetryBackoff
+	}
+
+	return backoff
+}
+
+// ShouldRetry checks if the attempt with given status should be retried
+func (p *RetryPolicy) ShouldRetry(status RetryStatus) bool {
+	if p == nil {
+		return false
+	}
+
+	if len(p.Statuses) == 0 {
+		return status == FAILED_RetryStatus || status == TIMEOUT_RetryStatus || status == ERROR_RetryStatus
+	}
+
+	for _, s := range p.Statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks that attempts and backoff are within limits, backoff is a valid duration and statuses are known
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return errors.New("retry max attempts can't be negative")
+	}
+
+	if p.MaxAttempts > MaxRetryAttempts {
+		return fmt.Errorf("retry max attempts can't be greater than %d", MaxRetryAttempts)
+	}
+
+	if p.Backoff != "" {
+		backoff, err := time.ParseDuration(p.Backoff)
+		if err != nil {
+			return fmt.Errorf("invalid retry backoff: %w", err)
+		}
+
+		if backoff < 0 || backoff > MaxRetryBackoff {
+			return fmt.Errorf("retry backoff must be between 0 and %s", MaxRetryBackoff)
+		}
+	}
+
+	if p.BackoffFactor < 0 {
+		return errors.New("retry backoff factor can't be negative")
+	}
+
+	for _, status := range p.Statuses {
+		switch status {
+		case FAILED_RetryStatus, TIMEOUT_RetryStatus, ERROR_RetryStatus:
+		default:
+			return fmt.Errorf("unknown retry status %s, use one of %s, %s or %s",
+				status, FAILED_RetryStatus, TIMEOUT_RetryStatus, ERROR_RetryStatus)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package testkube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_GetBackoff(t *testing.T) {
+	t.Run("returns no delay without policy", func(t *testing.T) {
+		var policy *RetryPolicy
+		assert.Equal(t, time.Duration(0), policy.GetBackoff(1))
+		assert.Equal(t, 1, policy.GetMaxAttempts())
+	})
+
+	t.Run("returns the same delay without factor", func(t *testing.T) {
+		policy := &RetryPolicy{Backoff: "10s"}
+		assert.Equal(t, 10*time.Second, policy.GetBackoff(1))
+		assert.Equal(t, 10*time.Second, policy.GetBackoff(3))
+	})
+
+	t.Run("multiplies delay by factor", func(t *testing.T) {
+		policy := &RetryPolicy{Backoff: "10s", BackoffFactor: 2}
+		assert.Equal(t, 10*time.Second, policy.GetBackoff(1))
+		assert.Equal(t, 20*time.Second, policy.GetBackoff(2))
+		assert.Equal(t, 40*time.Second, policy.GetBackoff(3))
+	})
+
+	t.Run("limits delay and attempts", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 100, Backoff: "10m", BackoffFactor: 10}
+		assert.Equal(t, MaxRetryBackoff, policy.GetBackoff(3))
+		assert.Equal(t, MaxRetryBackoff, policy.GetBackoff(1000))
+		assert.Equal(t, MaxRetryAttempts, policy.GetMaxAttempts())
+	})
+}
+
+func TestRetryPolicy_ShouldRetry(t *testing.T) {
+	t.Run("retries all statuses when not set", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 3}
+		assert.True(t, policy.ShouldRetry(FAILED_RetryStatus))
+		assert.True(t, policy.ShouldRetry(TIMEOUT_RetryStatus))
+		assert.True(t, policy.ShouldRetry(ERROR_RetryStatus))
+	})
+
+	t.Run("retries only listed statuses", func(t *testing.T) {
+		policy := &RetryPolicy{MaxAttempts: 3, Statuses: []RetryStatus{TIMEOUT_RetryStatus}}
+		assert.False(t, policy.ShouldRetry(FAILED_RetryStatus))
+		assert.True(t, policy.ShouldRetry(TIMEOUT_RetryStatus))
+	})
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	assert.NoError(t, RetryPolicy{MaxAttempts: 3, Backoff: "1m", BackoffFactor: 1.5, Statuses: []RetryStatus{ERROR_RetryStatus}}.Validate())
+	assert.Error(t, RetryPolicy{MaxAttempts: -1}.Validate())
+	assert.Error(t, RetryPolicy{MaxAttempts: MaxRetryAttempts + 1}.Validate())
+	assert.Error(t, RetryPolicy{Backoff: "2h"}.Validate())
+	assert.Error(t, RetryPolicy{Backoff: "soon"}.Validate())
+	assert.Error(t, RetryPolicy{Statuses: []RetryStatus{"aborted"}}.Validate())
+}
@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// RetryStatus : status of the execution attempt to retry, error means the executor failed to run the test
+type RetryStatus string
+
+// List of RetryStatus
+const (
+	FAILED_RetryStatus  RetryStatus = "failed"
+	TIMEOUT_RetryStatus RetryStatus = "timeout"
+	ERROR_RetryStatus   RetryStatus = "error"
+)
@@ -0,0 +1,24 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// flakiness of the test case computed from pass/fail flips in executions with the same inputs
+type TestCaseFlakiness struct {
+	// test case class name
+	Classname string `json:"classname,omitempty"`
+	// test case name
+	Name string `json:"name"`
+	// ratio of status flips to all compared pairs of runs with the same inputs, between 0 and 1
+	Score float64 `json:"score"`
+	// number of runs taken into account
+	Runs int32 `json:"runs"`
+	// number of status flips between runs with the same inputs
+	Flips int32 `json:"flips"`
+}
@@ -0,0 +1,40 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"time"
+)
+
+// test case result parsed from the JUnit report of the execution
+type TestCaseResult struct {
+	// execution id
+	ExecutionId string `json:"executionId"`
+	// execution name
+	ExecutionName string `json:"executionName,omitempty"`
+	// test name
+	TestName string `json:"testName,omitempty"`
+	// test suite name
+	TestSuiteName string `json:"testSuiteName,omitempty"`
+	// name of the JUnit test suite containing the test case
+	Suite string `json:"suite,omitempty"`
+	// test case name
+	Name string `json:"name"`
+	// test case class name
+	Classname string `json:"classname,omitempty"`
+	// test case duration in milliseconds
+	DurationMs int32 `json:"durationMs,omitempty"`
+	// test case status, one of passed, failed, error or skipped
+	Status string `json:"status"`
+	// failure, error or skip message
+	Message string `json:"message,omitempty"`
+	// execution start time
+	StartTime time.Time `json:"startTime,omitempty"`
+}
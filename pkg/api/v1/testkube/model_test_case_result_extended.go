@@ -0,0 +1,62 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// List of test case statuses
+const (
+	PASSED_TestCaseStatus  = "passed"
+	FAILED_TestCaseStatus  = "failed"
+	ERROR_TestCaseStatus   = "error"
+	SKIPPED_TestCaseStatus = "skipped"
+)
+
+type TestCaseResults []TestCaseResult
+
+func (testCases TestCaseResults) Table() (header []string, output [][]string) {
+	header = []string{"Execution", "Test Name", "Class", "Name", "Status", "Duration", "Message"}
+
+	for _, c := range testCases {
+		output = append(output, []string{
+			c.ExecutionName,
+			c.TestName,
+			c.Classname,
+			c.Name,
+			c.Status,
+			(time.Duration(c.DurationMs) * time.Millisecond).String(),
+			c.Message,
+		})
+	}
+
+	return
+}
+
+// ParseTestCaseStatusList parse a list of test case statuses from string
+func ParseTestCaseStatusList(source, separator string) (statusList []string, err error) {
+	if source == "" {
+		return nil, nil
+	}
+
+	for _, status := range strings.Split(source, separator) {
+		switch status {
+		case PASSED_TestCaseStatus, FAILED_TestCaseStatus, ERROR_TestCaseStatus, SKIPPED_TestCaseStatus:
+			statusList = append(statusList, status)
+		default:
+			return nil, fmt.Errorf("unknown test case status %v", status)
+		}
+	}
+
+	return statusList, nil
+}
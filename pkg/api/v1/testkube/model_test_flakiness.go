@@ -0,0 +1,30 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// flakiness of the test computed from pass/fail flips of its executions with the same inputs
+type TestFlakiness struct {
+	// test name
+	TestName string `json:"testName"`
+	// ratio of status flips to all compared pairs of executions with the same inputs, between 0 and 1
+	Score float64 `json:"score"`
+	// whether the score reached the flakiness threshold
+	Flaky bool `json:"flaky"`
+	// whether failures of the test don't fail its test suite executions
+	Quarantined bool `json:"quarantined"`
+	// number of finished executions taken into account
+	Executions int32 `json:"executions"`
+	// number of status flips between executions with the same inputs
+	Flips int32 `json:"flips"`
+	// flakiness score from which the test is considered flaky
+	Threshold float64 `json:"threshold"`
+	// flaky test cases parsed from JUnit reports
+	TestCases []TestCaseFlakiness `json:"testCases,omitempty"`
+}
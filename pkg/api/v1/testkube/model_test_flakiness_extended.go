@@ -0,0 +1,43 @@
+package testkube
+
+import (
+	"fmt"
+)
+
+type TestFlakinesses []TestFlakiness
+
+func (list TestFlakinesses) Table() (header []string, output [][]string) {
+	header = []string{"Test", "Score", "Flaky", "Quarantined", "Executions", "Flips", "Flaky test cases"}
+
+	for _, f := range list {
+		output = append(output, []string{
+			f.TestName,
+			fmt.Sprintf("%.2f", f.Score),
+			fmt.Sprint(f.Flaky),
+			fmt.Sprint(f.Quarantined),
+			fmt.Sprint(f.Executions),
+			fmt.Sprint(f.Flips),
+			fmt.Sprint(len(f.TestCases)),
+		})
+	}
+
+	return
+}
+
+type TestCaseFlakinesses []TestCaseFlakiness
+
+func (list TestCaseFlakinesses) Table() (header []string, output [][]string) {
+	header = []string{"Class", "Name", "Score", "Runs", "Flips"}
+
+	for _, f := range list {
+		output = append(output, []string{
+			f.Classname,
+			f.Name,
+			fmt.Sprintf("%.2f", f.Score),
+			fmt.Sprint(f.Runs),
+			fmt.Sprint(f.Flips),
+		})
+	}
+
+	return
+}
@@ -49,6 +49,22 @@ func (t TestSuite) GetTestNames() []string {
 	return set.Of(names...).ToArray()
 }
 
+// IsStepGraph checks if test suite steps declare dependencies and run as a graph instead of sequential batches
+func (t TestSuite) IsStepGraph() bool {
+	return IsStepGraph(t.allBatches())
+}
+
+// ValidateStepGraph checks if step names are unique, dependencies exist and don't form a cycle
+func (t TestSuite) ValidateStepGraph() error {
+	return ValidateStepGraph(t.allBatches())
+}
+
+func (t TestSuite) allBatches() []TestSuiteBatchStep {
+	batches := append([]TestSuiteBatchStep{}, t.Before...)
+	batches = append(batches, t.Steps...)
+	return append(batches, t.After...)
+}
+
 func (t *TestSuite) QuoteTestSuiteTextFields() {
 	if t.Description != "" {
 		t.Description = fmt.Sprintf("%q", t.Description)
@@ -11,6 +11,8 @@ package testkube
 
 // set of steps run in parallel
 type TestSuiteBatchStep struct {
-	StopOnFailure bool            `json:"stopOnFailure"`
-	Execute       []TestSuiteStep `json:"execute,omitempty"`
+	StopOnFailure bool `json:"stopOnFailure"`
+	// condition to run the batch: always, onSuccess, onFailure or an expression over earlier step statuses and variables
+	Condition string          `json:"condition,omitempty"`
+	Execute   []TestSuiteStep `json:"execute,omitempty"`
 }
@@ -13,4 +13,5 @@ package testkube
 type TestSuiteBatchStepExecutionResult struct {
 	Step    *TestSuiteBatchStep            `json:"step,omitempty"`
 	Execute []TestSuiteStepExecutionResult `json:"execute,omitempty"`
+	Status  *ExecutionStatus               `json:"status,omitempty"`
 }
@@ -0,0 +1,49 @@
+package testkube
+
+// Skip marks batch and all its steps as skipped
+func (r *TestSuiteBatchStepExecutionResult) Skip() {
+	for i := range r.Execute {
+		r.Execute[i].Skip()
+	}
+
+	r.Status = ExecutionStatusSkipped
+}
+
+// IsSkipped checks if all steps of the batch were skipped
+func (r *TestSuiteBatchStepExecutionResult) IsSkipped() bool {
+	if r.Status != nil {
+		return *r.Status == SKIPPED_ExecutionStatus
+	}
+
+	return false
+}
+
+// IsFinished checks if all steps of the batch have already finished
+func (r *TestSuiteBatchStepExecutionResult) IsFinished() bool {
+	for i := range r.Execute {
+		if !r.Execute[i].IsFinished() {
+			return false
+		}
+	}
+
+	return len(r.Execute) != 0
+}
+
+// CalculateStatus sets batch status based on statuses of its steps, quarantined failures don't fail the batch
+func (r *TestSuiteBatchStepExecutionResult) CalculateStatus() {
+	status := ExecutionStatusSkipped
+	for _, step := range r.Execute {
+		switch {
+		case step.IsSkipped():
+		case step.IsAborted():
+			r.Status = ExecutionStatusAborted
+			return
+		case step.IsFailed() && !step.Quarantined:
+			status = ExecutionStatusFailed
+		case *status == SKIPPED_ExecutionStatus:
+			status = ExecutionStatusPassed
+		}
+	}
+
+	r.Status = status
+}
@@ -44,4 +44,15 @@ type TestSuiteExecution struct {
 	RunningContext *RunningContext   `json:"runningContext,omitempty"`
 	// test suite execution name started the test suite execution
 	TestSuiteExecutionName string `json:"testSuiteExecutionName,omitempty"`
+	// names of flaky tests whose failures were quarantined and didn't fail the test suite execution
+	QuarantinedTests []string `json:"quarantinedTests,omitempty"`
+	// annotations recorded during the triage of the test suite execution
+	Annotations      []ExecutionAnnotation      `json:"annotations,omitempty"`
+	ExecutionRequest *TestSuiteExecutionRequest `json:"executionRequest,omitempty"`
+	// id of the test suite execution this execution reruns
+	RerunOf string `json:"rerunOf,omitempty"`
+	// id of the api server replica running the execution
+	RunnerId string `json:"runnerId,omitempty"`
+	// time the replica running the execution last reported it's still running it
+	HeartbeatTime time.Time `json:"heartbeatTime,omitempty"`
 }
@@ -45,6 +45,12 @@ func NewStartedTestSuiteExecution(testSuite TestSuite, request TestSuiteExecutio
 		testExecution.Variables[k] = v
 	}
 
+	// request is kept to resume the execution after api server restart, variables are already stored in the execution
+	executionRequest := request
+	executionRequest.Variables = nil
+	executionRequest.Sync = false
+	testExecution.ExecutionRequest = &executionRequest
+
 	// add queued execution steps
 	batches := append(testSuite.Before, testSuite.Steps...)
 	batches = append(batches, testSuite.After...)
@@ -64,6 +70,59 @@ func NewStartedTestSuiteExecution(testSuite TestSuite, request TestSuiteExecutio
 	return testExecution
 }
 
+// NewRerunTestSuiteExecution returns new execution of the test suite execution steps with the same variables,
+// with onlyFailed only failed, timed out and aborted steps are queued to run again and other step results are reused
+func NewRerunTestSuiteExecution(execution TestSuiteExecution, request TestSuiteExecutionRequest, onlyFailed bool) TestSuiteExecution {
+	request.Variables = nil
+	request.Sync = false
+	rerun := TestSuiteExecution{
+		Id:               primitive.NewObjectID().Hex(),
+		StartTime:        time.Now(),
+		Name:             request.Name,
+		Status:           TestSuiteExecutionStatusRunning,
+		SecretUUID:       request.SecretUUID,
+		TestSuite:        execution.TestSuite,
+		Labels:           execution.Labels,
+		Variables:        execution.Variables,
+		RunningContext:   request.RunningContext,
+		ExecutionRequest: &request,
+		RerunOf:          execution.Id,
+	}
+
+	for _, batch := range execution.ExecuteStepResults {
+		rerunBatch := TestSuiteBatchStepExecutionResult{
+			Step:   batch.Step,
+			Status: batch.Status,
+		}
+
+		for _, result := range batch.Execute {
+			if !onlyFailed || result.IsFailed() || result.IsAborted() || (result.Execution != nil && result.Execution.IsTimeout()) {
+				result = NewTestStepQueuedResult(result.Step)
+				rerunBatch.Status = nil
+			}
+
+			rerunBatch.Execute = append(rerunBatch.Execute, result)
+		}
+
+		rerun.ExecuteStepResults = append(rerun.ExecuteStepResults, rerunBatch)
+	}
+
+	return rerun
+}
+
+// IsStepGraph checks if execution steps declare dependencies and run as a graph instead of sequential batches
+func (e TestSuiteExecution) IsStepGraph() bool {
+	for _, batchStepResult := range e.ExecuteStepResults {
+		for _, stepResult := range batchStepResult.Execute {
+			if stepResult.Step != nil && len(stepResult.Step.DependsOn) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (e TestSuiteExecution) FailedStepsCount() (count int) {
 	for _, stepResult := range e.StepResults {
 		if stepResult.Execution != nil && stepResult.Execution.IsFailed() {
@@ -147,6 +206,41 @@ func (e TestSuiteExecution) Table() (header []string, output [][]string) {
 		}
 	}
 
+	if e.IsStepGraph() {
+		header = []string{"Status", "Step", "Depends on", "ID", "Error"}
+		output = make([][]string, 0)
+
+		for _, bs := range e.ExecuteStepResults {
+			for _, sr := range bs.Execute {
+				if sr.Step == nil {
+					continue
+				}
+
+				status := "no-execution-result"
+				var id, errorMessage string
+				if sr.Execution != nil && sr.Execution.ExecutionResult != nil {
+					if sr.Execution.ExecutionResult.Status != nil {
+						status = string(*sr.Execution.ExecutionResult.Status)
+					}
+
+					errorMessage = sr.Execution.ExecutionResult.ErrorMessage
+				}
+
+				if sr.Quarantined {
+					status += " (quarantined)"
+				}
+
+				if sr.Execution != nil && sr.Step.Type() == TestSuiteStepTypeExecuteTest {
+					id = sr.Execution.Id
+				}
+
+				output = append(output, []string{status, sr.Step.StepName(), strings.Join(sr.Step.DependsOn, ", "), id, errorMessage})
+			}
+		}
+
+		return
+	}
+
 	if len(e.ExecuteStepResults) != 0 {
 		header = []string{"Statuses", "Step", "IDs", "Errors"}
 		output = make([][]string, 0)
@@ -160,6 +254,10 @@ func (e TestSuiteExecution) Table() (header []string, output [][]string) {
 					status = string(*sr.Execution.ExecutionResult.Status)
 				}
 
+				if sr.Quarantined {
+					status += " (quarantined)"
+				}
+
 				statuses = append(statuses, status)
 				if sr.Step == nil {
 					continue
@@ -0,0 +1,81 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRerunTestSuiteExecution(t *testing.T) {
+	t.Parallel()
+
+	newExecution := func() TestSuiteExecution {
+		execution := NewStartedTestSuiteExecution(TestSuite{
+			Name: "suite",
+			Steps: []TestSuiteBatchStep{
+				{Execute: []TestSuiteStep{{Test: "api"}, {Test: "ui"}}},
+				{Execute: []TestSuiteStep{{Test: "cleanup"}}},
+				{Execute: []TestSuiteStep{{Test: "report"}}},
+				{Execute: []TestSuiteStep{{Test: "notify"}, {Test: "load"}}},
+			},
+		}, TestSuiteExecutionRequest{Name: "ts-suite-1", Variables: map[string]Variable{"env": NewBasicVariable("env", "staging")}})
+
+		execution.ExecuteStepResults[0].Execute[0].Execution.ExecutionResult.Success()
+		execution.ExecuteStepResults[0].Execute[1].Execution.ExecutionResult.Error()
+		execution.ExecuteStepResults[0].CalculateStatus()
+		execution.ExecuteStepResults[1].Execute[0].Execution.ExecutionResult.Abort()
+		execution.ExecuteStepResults[1].CalculateStatus()
+		execution.ExecuteStepResults[2].Execute[0].Execution.ExecutionResult.Success()
+		execution.ExecuteStepResults[2].CalculateStatus()
+		execution.ExecuteStepResults[3].Execute[0].Skip()
+		execution.ExecuteStepResults[3].Execute[1].Execution.ExecutionResult.Timeout()
+		execution.ExecuteStepResults[3].CalculateStatus()
+		execution.ExecuteStepResults[2].CalculateStatus()
+		execution.Status = TestSuiteExecutionStatusFailed
+		return execution
+	}
+
+	t.Run("only failed steps", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution()
+		rerun := NewRerunTestSuiteExecution(execution, TestSuiteExecutionRequest{Name: "ts-suite-2"}, true)
+
+		assert.NotEqual(t, execution.Id, rerun.Id)
+		assert.Equal(t, execution.Id, rerun.RerunOf)
+		assert.Equal(t, "ts-suite-2", rerun.Name)
+		assert.Equal(t, TestSuiteExecutionStatusRunning, rerun.Status)
+		assert.Equal(t, execution.Variables, rerun.Variables)
+
+		assert.Same(t, execution.ExecuteStepResults[0].Execute[0].Execution, rerun.ExecuteStepResults[0].Execute[0].Execution)
+		assert.NotEqual(t, execution.ExecuteStepResults[0].Execute[1].Execution.Id, rerun.ExecuteStepResults[0].Execute[1].Execution.Id)
+		assert.True(t, rerun.ExecuteStepResults[0].Execute[1].Execution.ExecutionResult.IsQueued())
+		assert.Nil(t, rerun.ExecuteStepResults[0].Status)
+
+		assert.True(t, rerun.ExecuteStepResults[1].Execute[0].Execution.ExecutionResult.IsQueued())
+		assert.Nil(t, rerun.ExecuteStepResults[1].Status)
+
+		assert.Same(t, execution.ExecuteStepResults[2].Execute[0].Execution, rerun.ExecuteStepResults[2].Execute[0].Execution)
+		assert.True(t, rerun.ExecuteStepResults[2].IsFinished())
+		assert.Equal(t, ExecutionStatusPassed, rerun.ExecuteStepResults[2].Status)
+
+		assert.Same(t, execution.ExecuteStepResults[3].Execute[0].Execution, rerun.ExecuteStepResults[3].Execute[0].Execution)
+		assert.True(t, rerun.ExecuteStepResults[3].Execute[0].IsSkipped())
+		assert.True(t, rerun.ExecuteStepResults[3].Execute[1].Execution.ExecutionResult.IsQueued())
+		assert.Nil(t, rerun.ExecuteStepResults[3].Status)
+	})
+
+	t.Run("all steps", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution()
+		rerun := NewRerunTestSuiteExecution(execution, TestSuiteExecutionRequest{Name: "ts-suite-2"}, false)
+
+		for i := range rerun.ExecuteStepResults {
+			assert.False(t, rerun.ExecuteStepResults[i].IsFinished())
+			for j := range rerun.ExecuteStepResults[i].Execute {
+				assert.True(t, rerun.ExecuteStepResults[i].Execute[j].Execution.ExecutionResult.IsQueued())
+			}
+		}
+	})
+}
@@ -14,4 +14,6 @@ type TestSuiteExecutionsResult struct {
 	Totals   *ExecutionsTotals           `json:"totals"`
 	Filtered *ExecutionsTotals           `json:"filtered,omitempty"`
 	Results  []TestSuiteExecutionSummary `json:"results"`
+	// cursor of the next page, empty for the last page
+	Next string `json:"next,omitempty"`
 }
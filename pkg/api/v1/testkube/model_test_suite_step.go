@@ -14,4 +14,11 @@ type TestSuiteStep struct {
 	Test string `json:"test,omitempty"`
 	// delay duration in time units
 	Delay string `json:"delay,omitempty"`
+	// step name used in dependencies of other steps, test name or delay is used when not set
+	Name string `json:"name,omitempty"`
+	// names of steps which need to finish before the step starts
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// condition to run the step: always, onSuccess, onFailure or an expression over earlier step statuses and variables
+	Condition   string       `json:"condition,omitempty"`
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
@@ -14,4 +14,6 @@ type TestSuiteStepExecutionResult struct {
 	Step      *TestSuiteStep `json:"step,omitempty"`
 	Test      *ObjectRef     `json:"test,omitempty"`
 	Execution *Execution     `json:"execution,omitempty"`
+	// whether failure of the flaky test was quarantined and didn't fail the test suite execution
+	Quarantined bool `json:"quarantined,omitempty"`
 }
@@ -25,6 +25,36 @@ func (r *TestSuiteStepExecutionResult) IsFailed() bool {
 	return true
 }
 
+// Skip marks step as skipped because its condition wasn't met
+func (r *TestSuiteStepExecutionResult) Skip() {
+	if r.Execution == nil {
+		r.Execution = NewQueuedExecution()
+	}
+
+	if r.Execution.ExecutionResult == nil {
+		r.Execution.ExecutionResult = &ExecutionResult{}
+	}
+
+	r.Execution.ExecutionResult.Skip()
+}
+
+func (r *TestSuiteStepExecutionResult) IsSkipped() bool {
+	if r.Execution != nil && r.Execution.ExecutionResult != nil && r.Execution.ExecutionResult.Status != nil {
+		return r.Execution.ExecutionResult.IsSkipped()
+	}
+
+	return false
+}
+
+// IsPassed checks if the step execution has passed
+func (r *TestSuiteStepExecutionResult) IsPassed() bool {
+	if r.Execution != nil && r.Execution.ExecutionResult != nil && r.Execution.ExecutionResult.Status != nil {
+		return r.Execution.ExecutionResult.IsPassed()
+	}
+
+	return false
+}
+
 func (r *TestSuiteStepExecutionResult) IsAborted() bool {
 	if r.Execution != nil {
 		return r.Execution.IsAborted()
@@ -32,3 +62,12 @@ func (r *TestSuiteStepExecutionResult) IsAborted() bool {
 
 	return false
 }
+
+// IsFinished checks if the step execution has already finished, queued and running steps aren't finished
+func (r *TestSuiteStepExecutionResult) IsFinished() bool {
+	if r.Execution == nil || r.Execution.ExecutionResult == nil || r.Execution.ExecutionResult.Status == nil {
+		return false
+	}
+
+	return !r.Execution.ExecutionResult.IsQueued() && !r.Execution.ExecutionResult.IsRunning()
+}
@@ -10,6 +10,15 @@ func (s TestSuiteStep) Type() *TestSuiteStepType {
 	return nil
 }
 
+// StepName returns name used to reference the step in dependencies
+func (s TestSuiteStep) StepName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+
+	return s.FullName()
+}
+
 func (s TestSuiteStep) FullName() string {
 	switch s.Type() {
 	case TestSuiteStepTypeDelay:
@@ -0,0 +1,96 @@
+package testkube
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsStepGraph checks if any step of the batches declares dependencies
+func IsStepGraph(batches []TestSuiteBatchStep) bool {
+	for _, batch := range batches {
+		for _, step := range batch.Execute {
+			if len(step.DependsOn) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateStepGraph checks if step names are unique, dependencies exist and don't form a cycle,
+// batches without dependencies are always valid
+func ValidateStepGraph(batches []TestSuiteBatchStep) error {
+	if !IsStepGraph(batches) {
+		return nil
+	}
+
+	var names []string
+	dependencies := map[string][]string{}
+	for _, batch := range batches {
+		for _, step := range batch.Execute {
+			name := step.StepName()
+			if _, ok := dependencies[name]; ok {
+				return fmt.Errorf("step %s is defined more than once, set unique step names", name)
+			}
+
+			names = append(names, name)
+			dependencies[name] = step.DependsOn
+			if dependencies[name] == nil {
+				dependencies[name] = []string{}
+			}
+		}
+	}
+
+	for _, name := range names {
+		for _, dependency := range dependencies[name] {
+			if _, ok := dependencies[dependency]; !ok {
+				return fmt.Errorf("step %s depends on unknown step %s", name, dependency)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i := range path {
+				if path[i] == name {
+					return fmt.Errorf("steps have a dependency cycle: %s", strings.Join(append(path[i:], name), " -> "))
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, name := range names {
+		if state[name] == unvisited {
+			if err := visit(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStepGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batches without dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		batches := []TestSuiteBatchStep{
+			{Execute: []TestSuiteStep{{Test: "api"}, {Test: "api"}}},
+		}
+
+		assert.False(t, IsStepGraph(batches))
+		assert.NoError(t, ValidateStepGraph(batches))
+	})
+
+	t.Run("valid graph", func(t *testing.T) {
+		t.Parallel()
+
+		batches := []TestSuiteBatchStep{
+			{Execute: []TestSuiteStep{{Test: "smoke"}, {Test: "api"}}},
+			{Execute: []TestSuiteStep{{Test: "ui", DependsOn: []string{"api"}}, {Name: "wait", Delay: "1s", DependsOn: []string{"smoke", "ui"}}}},
+		}
+
+		assert.True(t, IsStepGraph(batches))
+		assert.NoError(t, ValidateStepGraph(batches))
+	})
+
+	t.Run("duplicate step", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateStepGraph([]TestSuiteBatchStep{
+			{Execute: []TestSuiteStep{{Test: "api"}, {Test: "api", DependsOn: []string{"api"}}}},
+		})
+
+		assert.EqualError(t, err, "step api is defined more than once, set unique step names")
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateStepGraph([]TestSuiteBatchStep{
+			{Execute: []TestSuiteStep{{Test: "api", DependsOn: []string{"smoke"}}}},
+		})
+
+		assert.EqualError(t, err, "step api depends on unknown step smoke")
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateStepGraph([]TestSuiteBatchStep{
+			{Execute: []TestSuiteStep{{Test: "smoke"}, {Test: "api", DependsOn: []string{"smoke", "ui"}}}},
+			{Execute: []TestSuiteStep{{Test: "ui", DependsOn: []string{"cleanup"}}, {Test: "cleanup", DependsOn: []string{"api"}}}},
+		})
+
+		assert.EqualError(t, err, "steps have a dependency cycle: api -> ui -> cleanup -> api")
+	})
+}
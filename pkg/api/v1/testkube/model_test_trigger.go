@@ -15,15 +15,21 @@ type TestTrigger struct {
 	// test trigger namespace
 	Namespace string `json:"namespace,omitempty"`
 	// test trigger labels
-	Labels           map[string]string     `json:"labels,omitempty"`
-	Resource         *TestTriggerResources `json:"resource"`
-	ResourceSelector *TestTriggerSelector  `json:"resourceSelector"`
+	Labels           map[string]string        `json:"labels,omitempty"`
+	Resource         *TestTriggerResources    `json:"resource"`
+	ResourceKind     *TestTriggerResourceKind `json:"resourceKind,omitempty"`
+	ResourceSelector *TestTriggerSelector     `json:"resourceSelector"`
 	// listen for event for selected resource
-	Event             string                          `json:"event"`
-	ConditionSpec     *TestTriggerConditionSpec       `json:"conditionSpec,omitempty"`
-	ProbeSpec         *TestTriggerProbeSpec           `json:"probeSpec,omitempty"`
-	Action            *TestTriggerActions             `json:"action"`
+	Event string `json:"event"`
+	// expression matched against the resource, old resource and causes of the event
+	Expression    string                    `json:"expression,omitempty"`
+	ConditionSpec *TestTriggerConditionSpec `json:"conditionSpec,omitempty"`
+	ProbeSpec     *TestTriggerProbeSpec     `json:"probeSpec,omitempty"`
+	Action        *TestTriggerActions       `json:"action"`
+	// url called by the webhook action with the matched resource
+	WebhookUrl        string                          `json:"webhookUrl,omitempty"`
 	Execution         *TestTriggerExecutions          `json:"execution"`
 	TestSelector      *TestTriggerSelector            `json:"testSelector"`
 	ConcurrencyPolicy *TestTriggerConcurrencyPolicies `json:"concurrencyPolicy,omitempty"`
+	Throttling        *TestTriggerThrottling          `json:"throttling,omitempty"`
 }
@@ -14,5 +14,8 @@ type TestTriggerActions string
 
 // List of TestTriggerActions
 const (
-	RUN_TestTriggerActions TestTriggerActions = "run"
+	RUN_TestTriggerActions     TestTriggerActions = "run"
+	ABORT_TestTriggerActions   TestTriggerActions = "abort"
+	NOTIFY_TestTriggerActions  TestTriggerActions = "notify"
+	WEBHOOK_TestTriggerActions TestTriggerActions = "webhook"
 )
@@ -0,0 +1,10 @@
+package testkube
+
+func (a TestTriggerActions) String() string {
+	return string(a)
+}
+
+// IsAnnotated checks if the action is not supported by the TestTrigger CRD and is kept in annotation
+func (a TestTriggerActions) IsAnnotated() bool {
+	return a != "" && a != RUN_TestTriggerActions
+}
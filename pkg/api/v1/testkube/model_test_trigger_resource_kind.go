@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// kubernetes resource kind watched by test trigger, used for resources not listed in test trigger resources
+type TestTriggerResourceKind struct {
+	// resource api group, empty for core resources
+	Group string `json:"group,omitempty"`
+	// resource api version
+	Version string `json:"version"`
+	// resource kind
+	Kind string `json:"kind"`
+}
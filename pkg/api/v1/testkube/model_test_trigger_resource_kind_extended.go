@@ -0,0 +1,49 @@
+package testkube
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseTestTriggerResourceKind parses resource kind in group/version/kind format, core resources use version/kind
+func ParseTestTriggerResourceKind(value string) (*TestTriggerResourceKind, error) {
+	parts := strings.Split(value, "/")
+	var kind TestTriggerResourceKind
+	switch len(parts) {
+	case 2:
+		kind = TestTriggerResourceKind{Version: parts[0], Kind: parts[1]}
+	case 3:
+		kind = TestTriggerResourceKind{Group: parts[0], Version: parts[1], Kind: parts[2]}
+	default:
+		return nil, fmt.Errorf("invalid resource kind %s, use group/version/kind format", value)
+	}
+
+	if err := kind.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &kind, nil
+}
+
+// String returns resource kind in group/version/kind format, core resources use version/kind
+func (k TestTriggerResourceKind) String() string {
+	if k.Group == "" {
+		return k.Version + "/" + k.Kind
+	}
+
+	return k.Group + "/" + k.Version + "/" + k.Kind
+}
+
+// Validate checks that version and kind are set
+func (k TestTriggerResourceKind) Validate() error {
+	if k.Version == "" || k.Kind == "" {
+		return errors.New("resource kind requires version and kind")
+	}
+
+	if strings.Contains(k.Group, "/") || strings.Contains(k.Version, "/") || strings.Contains(k.Kind, "/") {
+		return fmt.Errorf("invalid resource kind %s", k)
+	}
+
+	return nil
+}
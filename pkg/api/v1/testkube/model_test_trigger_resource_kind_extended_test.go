@@ -0,0 +1,34 @@
+package testkube
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTestTriggerResourceKind(t *testing.T) {
+	t.Parallel()
+
+	t.Run("group version kind", func(t *testing.T) {
+		kind, err := ParseTestTriggerResourceKind("argoproj.io/v1alpha1/Rollout")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &TestTriggerResourceKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}, kind)
+		assert.Equal(t, "argoproj.io/v1alpha1/Rollout", kind.String())
+	})
+
+	t.Run("core version kind", func(t *testing.T) {
+		kind, err := ParseTestTriggerResourceKind("v1/Secret")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &TestTriggerResourceKind{Version: "v1", Kind: "Secret"}, kind)
+		assert.Equal(t, "v1/Secret", kind.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, value := range []string{"", "Rollout", "a/b/c/d", "argoproj.io//Rollout"} {
+			_, err := ParseTestTriggerResourceKind(value)
+			assert.Error(t, err, value)
+		}
+	})
+}
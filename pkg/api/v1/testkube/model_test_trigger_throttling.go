@@ -0,0 +1,20 @@
+/*
+ * Testkube API
+ *
+ * Testkube provides a Kubernetes-native framework for test definition, execution and results
+ *
+ * API version: 1.0.0
+ * Contact: testkube@kubeshop.io
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package testkube
+
+// limits how often test trigger fires for bursts of matching events
+type TestTriggerThrottling struct {
+	// duration without matching events the test trigger waits for before it fires with the latest event
+	Debounce string `json:"debounce,omitempty"`
+	// minimum duration between two firings of the test trigger
+	Cooldown string `json:"cooldown,omitempty"`
+	// trigger variables identifying the event, test trigger fires only once for the same values
+	DedupKey []string `json:"dedupKey,omitempty"`
+}
@@ -0,0 +1,64 @@
+package testkube
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// GetDebounce returns debounce duration, zero when the test trigger isn't debounced
+func (t *TestTriggerThrottling) GetDebounce() time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	return parseThrottlingDuration(t.Debounce)
+}
+
+// GetCooldown returns cooldown duration, zero when the test trigger has no cooldown
+func (t *TestTriggerThrottling) GetCooldown() time.Duration {
+	if t == nil {
+		return 0
+	}
+
+	return parseThrottlingDuration(t.Cooldown)
+}
+
+// Validate checks that debounce and cooldown are valid durations and dedup key has no empty variables
+func (t TestTriggerThrottling) Validate() error {
+	for name, value := range map[string]string{"debounce": t.Debounce, "cooldown": t.Cooldown} {
+		if value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		if duration < 0 {
+			return fmt.Errorf("%s can't be negative", name)
+		}
+	}
+
+	for _, variable := range t.DedupKey {
+		if variable == "" {
+			return errors.New("dedup key can't contain empty variable name")
+		}
+	}
+
+	return nil
+}
+
+func parseThrottlingDuration(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0
+	}
+
+	return duration
+}
@@ -0,0 +1,25 @@
+package testkube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestTriggerThrottling(t *testing.T) {
+	t.Parallel()
+
+	var empty *TestTriggerThrottling
+	assert.Zero(t, empty.GetDebounce())
+	assert.Zero(t, empty.GetCooldown())
+
+	throttling := &TestTriggerThrottling{Debounce: "30s", Cooldown: "5m", DedupKey: []string{"TRIGGER_IMAGE_TAG"}}
+	assert.NoError(t, throttling.Validate())
+	assert.Equal(t, 30*time.Second, throttling.GetDebounce())
+	assert.Equal(t, 5*time.Minute, throttling.GetCooldown())
+
+	assert.Error(t, TestTriggerThrottling{Debounce: "soon"}.Validate())
+	assert.Error(t, TestTriggerThrottling{Cooldown: "-1m"}.Validate())
+	assert.Error(t, TestTriggerThrottling{DedupKey: []string{""}}.Validate())
+}
@@ -17,5 +17,11 @@ func ValidateUpdateTestRequest(test TestUpdateRequest) error {
 			return errors.New("invalin cron expression in test schedule")
 		}
 	}
+
+	if test.ExecutionRequest != nil && *test.ExecutionRequest != nil && (*test.ExecutionRequest).Shards != nil {
+		if err := ValidateShards(*(*test.ExecutionRequest).Shards); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -86,5 +86,10 @@ func ValidateUpsertTestRequest(test TestUpsertRequest) error {
 			return errors.New("invalin cron expression in test schedule")
 		}
 	}
+	if test.ExecutionRequest != nil {
+		if err := ValidateShards(test.ExecutionRequest.Shards); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -0,0 +1,90 @@
+package celprogram
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// maxCachedPrograms limits number of cached programs, the cache is cleared when it's full
+const maxCachedPrograms = 1024
+
+// Compiler compiles CEL expressions evaluating to bool with variables of its environment,
+// programs are compiled once and cached by expression
+type Compiler struct {
+	// kind of compiled expressions used in errors, like expression or condition
+	kind     string
+	env      *cel.Env
+	envErr   error
+	mu       sync.Mutex
+	programs map[string]cel.Program
+}
+
+// NewCompiler returns compiler of expressions of given kind with declared variables
+func NewCompiler(kind string, variables ...cel.EnvOption) *Compiler {
+	env, err := cel.NewEnv(variables...)
+	return &Compiler{
+		kind:     kind,
+		env:      env,
+		envErr:   err,
+		programs: map[string]cel.Program{},
+	}
+}
+
+// Compile returns program of the expression, compiling it when it's not cached yet
+func (c *Compiler) Compile(expression string) (cel.Program, error) {
+	if c.envErr != nil {
+		return nil, c.envErr
+	}
+
+	c.mu.Lock()
+	program, ok := c.programs[expression]
+	c.mu.Unlock()
+	if ok {
+		return program, nil
+	}
+
+	ast, issues := c.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("compiling %s %q: %w", c.kind, expression, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("%s %q should evaluate to bool, got %v", c.kind, expression, ast.OutputType())
+	}
+
+	program, err := c.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.programs) >= maxCachedPrograms {
+		c.programs = map[string]cel.Program{}
+	}
+
+	c.programs[expression] = program
+	return program, nil
+}
+
+// Evaluate evaluates the expression with given variables
+func (c *Compiler) Evaluate(expression string, variables map[string]interface{}) (bool, error) {
+	program, err := c.Compile(expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.Eval(variables)
+	if err != nil {
+		return false, fmt.Errorf("evaluating %s %q: %w", c.kind, expression, err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%s %q should evaluate to bool, got %v", c.kind, expression, out.Type())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package celprogram
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompiler(t *testing.T) {
+	t.Parallel()
+
+	compiler := NewCompiler("expression", cel.Variable("name", cel.StringType))
+
+	t.Run("evaluates expression", func(t *testing.T) {
+		t.Parallel()
+
+		matched, err := compiler.Evaluate(`name == "api"`, map[string]interface{}{"name": "api"})
+		assert.NoError(t, err)
+		assert.True(t, matched)
+
+		matched, err = compiler.Evaluate(`name == "api"`, map[string]interface{}{"name": "ui"})
+		assert.NoError(t, err)
+		assert.False(t, matched)
+	})
+
+	t.Run("returns errors of invalid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := compiler.Compile(`name ==`)
+		assert.ErrorContains(t, err, "compiling expression")
+
+		_, err = compiler.Compile(`name`)
+		assert.ErrorContains(t, err, "should evaluate to bool")
+
+		_, err = compiler.Compile(`unknown == 1`)
+		assert.Error(t, err)
+	})
+}
+
+func TestCompiler_cache(t *testing.T) {
+	t.Parallel()
+
+	compiler := NewCompiler("condition", cel.Variable("failed", cel.BoolType))
+
+	_, err := compiler.Compile(`failed`)
+	assert.NoError(t, err)
+	_, err = compiler.Compile(`failed`)
+	assert.NoError(t, err)
+	_, err = compiler.Compile(`!`)
+	assert.Error(t, err)
+	assert.Len(t, compiler.programs, 1)
+
+	for i := 0; i < maxCachedPrograms; i++ {
+		_, err = compiler.Compile(fmt.Sprintf("failed || %d > 0", i))
+		assert.NoError(t, err)
+	}
+	assert.LessOrEqual(t, len(compiler.programs), maxCachedPrograms)
+}
@@ -22,5 +22,9 @@ const (
 	CmdResultDeleteByTests          executor.Command = "result_delete_by_tests"
 	CmdResultDeleteByTestSuites     executor.Command = "result_delete_by_test_suites"
 	CmdResultDeleteForAllTestSuites executor.Command = "result_delete_for_all_test_suites"
+	CmdResultDeleteByIds            executor.Command = "result_delete_by_ids"
 	CmdResultGetTestMetrics         executor.Command = "result_get_test_metrics"
+	CmdResultClaim                  executor.Command = "result_claim"
+	CmdResultAddAnnotation          executor.Command = "result_add_annotation"
+	CmdResultDeleteAnnotation       executor.Command = "result_delete_annotation"
 )
@@ -304,6 +304,95 @@ func (r *CloudRepository) DeleteForAllTestSuites(ctx context.Context) error {
 	return nil
 }
 
+func (r *CloudRepository) DeleteByIds(ctx context.Context, ids []string) error {
+	req := DeleteByIdsRequest{Ids: ids}
+	_, err := r.executor.Execute(ctx, CmdResultDeleteByIds, req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AdmitExecution changes status of the queued execution to running, execution is read and updated as a whole
+func (r *CloudRepository) AdmitExecution(ctx context.Context, id string) (bool, error) {
+	execution, err := r.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if !execution.IsQueued() {
+		return false, nil
+	}
+
+	execution.ExecutionResult.Status = testkube.ExecutionStatusRunning
+	return true, r.UpdateResult(ctx, id, execution)
+}
+
+// AbortQueuedExecution changes status of the queued execution to aborted, execution is read and updated as a whole
+func (r *CloudRepository) AbortQueuedExecution(ctx context.Context, id string) (bool, error) {
+	execution, err := r.Get(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if execution.ExecutionResult == nil || !execution.ExecutionResult.IsQueued() {
+		return false, nil
+	}
+
+	execution.ExecutionResult.Abort()
+	execution.EndTime = time.Now()
+	return true, r.UpdateResult(ctx, id, execution)
+}
+
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore, compare and set is done by the control plane
+func (r *CloudRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	req := ClaimRequest{ID: id, RunnerID: runnerID, StaleBefore: staleBefore}
+	response, err := r.executor.Execute(ctx, CmdResultClaim, req)
+	if err != nil {
+		return false, err
+	}
+	var commandResponse ClaimResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return false, err
+	}
+	return commandResponse.Claimed, nil
+}
+
+// AddAnnotation adds annotation to execution result, annotation is appended by the control plane
+func (r *CloudRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	req := AddAnnotationRequest{ID: id, Annotation: annotation}
+	response, err := r.executor.Execute(ctx, CmdResultAddAnnotation, req)
+	if err != nil {
+		return err
+	}
+	var commandResponse AddAnnotationResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return err
+	}
+	if !commandResponse.Found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteAnnotation deletes annotation from execution result, annotation is removed by the control plane
+func (r *CloudRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	req := DeleteAnnotationRequest{ID: id, AnnotationID: annotationID}
+	response, err := r.executor.Execute(ctx, CmdResultDeleteAnnotation, req)
+	if err != nil {
+		return err
+	}
+	var commandResponse DeleteAnnotationResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return err
+	}
+	if !commandResponse.Found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (r *CloudRepository) GetTestMetrics(ctx context.Context, name string, limit, last int) (testkube.ExecutionsMetrics, error) {
 	req := GetTestMetricsRequest{Name: name, Limit: limit, Last: last}
 	response, err := r.executor.Execute(ctx, CmdResultGetTestMetrics, req)
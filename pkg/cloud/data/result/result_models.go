@@ -138,6 +138,12 @@ type DeleteByTestSuitesRequest struct {
 
 type DeleteByTestSuitesResponse struct{}
 
+type DeleteByIdsRequest struct {
+	Ids []string `json:"ids"`
+}
+
+type DeleteByIdsResponse struct{}
+
 type DeleteForAllTestSuitesResponse struct {
 }
 
@@ -150,3 +156,31 @@ type GetTestMetricsRequest struct {
 type GetTestMetricsResponse struct {
 	Metrics testkube.ExecutionsMetrics `json:"metrics"`
 }
+
+type ClaimRequest struct {
+	ID          string    `json:"id"`
+	RunnerID    string    `json:"runnerId"`
+	StaleBefore time.Time `json:"staleBefore"`
+}
+
+type ClaimResponse struct {
+	Claimed bool `json:"claimed"`
+}
+
+type AddAnnotationRequest struct {
+	ID         string                       `json:"id"`
+	Annotation testkube.ExecutionAnnotation `json:"annotation"`
+}
+
+type AddAnnotationResponse struct {
+	Found bool `json:"found"`
+}
+
+type DeleteAnnotationRequest struct {
+	ID           string `json:"id"`
+	AnnotationID string `json:"annotationId"`
+}
+
+type DeleteAnnotationResponse struct {
+	Found bool `json:"found"`
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/cloud/data/executor"
@@ -155,3 +156,55 @@ func TestCloudResultRepository_Update(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestCloudResultRepository_Claim(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockExecutor := executor.NewMockExecutor(mockCtrl)
+	repo := &CloudRepository{executor: mockExecutor}
+
+	staleBefore := time.Now().Add(-time.Minute)
+	expectedResponseBytes, _ := json.Marshal(ClaimResponse{Claimed: true})
+	mockExecutor.EXPECT().Execute(ctx, CmdResultClaim, ClaimRequest{ID: "id1", RunnerID: "replica-1", StaleBefore: staleBefore}).
+		Return(expectedResponseBytes, nil)
+
+	claimed, err := repo.Claim(ctx, "id1", "replica-1", staleBefore)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestCloudResultRepository_AddAnnotation(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockExecutor := executor.NewMockExecutor(mockCtrl)
+	repo := &CloudRepository{executor: mockExecutor}
+
+	annotation := testkube.ExecutionAnnotation{Id: "annotation1", Text: "flaky"}
+	expectedResponseBytes, _ := json.Marshal(AddAnnotationResponse{Found: true})
+	mockExecutor.EXPECT().Execute(ctx, CmdResultAddAnnotation, AddAnnotationRequest{ID: "id1", Annotation: annotation}).
+		Return(expectedResponseBytes, nil)
+
+	assert.NoError(t, repo.AddAnnotation(ctx, "id1", annotation))
+}
+
+func TestCloudResultRepository_DeleteAnnotation(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockExecutor := executor.NewMockExecutor(mockCtrl)
+	repo := &CloudRepository{executor: mockExecutor}
+
+	expectedResponseBytes, _ := json.Marshal(DeleteAnnotationResponse{Found: false})
+	mockExecutor.EXPECT().Execute(ctx, CmdResultDeleteAnnotation, DeleteAnnotationRequest{ID: "id1", AnnotationID: "annotation1"}).
+		Return(expectedResponseBytes, nil)
+
+	assert.ErrorIs(t, repo.DeleteAnnotation(ctx, "id1", "annotation1"), mongo.ErrNoDocuments)
+}
@@ -0,0 +1,13 @@
+package testcase
+
+import "github.com/kubeshop/testkube/pkg/cloud/data/executor"
+
+const (
+	CmdTestCaseGetTestCases           executor.Command = "test_case_get_test_cases"
+	CmdTestCaseReplace                executor.Command = "test_case_replace"
+	CmdTestCaseDeleteByExecutionIds   executor.Command = "test_case_delete_by_execution_ids"
+	CmdTestCaseDeleteByTests          executor.Command = "test_case_delete_by_tests"
+	CmdTestCaseDeleteByTestSuites     executor.Command = "test_case_delete_by_test_suites"
+	CmdTestCaseDeleteForAllTestSuites executor.Command = "test_case_delete_for_all_test_suites"
+	CmdTestCaseDeleteAll              executor.Command = "test_case_delete_all"
+)
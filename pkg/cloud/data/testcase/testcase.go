@@ -0,0 +1,75 @@
+package testcase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/cloud"
+	"github.com/kubeshop/testkube/pkg/cloud/data/executor"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+var _ testcase.Repository = (*CloudRepository)(nil)
+
+type CloudRepository struct {
+	executor executor.Executor
+}
+
+func NewCloudRepository(client cloud.TestKubeCloudAPIClient, grpcConn *grpc.ClientConn, apiKey string) *CloudRepository {
+	return &CloudRepository{executor: executor.NewCloudGRPCExecutor(client, grpcConn, apiKey)}
+}
+
+func (r *CloudRepository) GetTestCases(ctx context.Context, filter testcase.Filter) ([]testkube.TestCaseResult, error) {
+	filterImpl, ok := filter.(*testcase.FilterImpl)
+	if !ok {
+		return nil, errors.New("invalid filter")
+	}
+	req := GetTestCasesRequest{Filter: filterImpl}
+	response, err := r.executor.Execute(ctx, CmdTestCaseGetTestCases, req)
+	if err != nil {
+		return nil, err
+	}
+	var commandResponse GetTestCasesResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return nil, err
+	}
+	return commandResponse.TestCases, nil
+}
+
+func (r *CloudRepository) Replace(ctx context.Context, executionId string, testCases []testkube.TestCaseResult) error {
+	req := ReplaceRequest{ExecutionId: executionId, TestCases: testCases}
+	_, err := r.executor.Execute(ctx, CmdTestCaseReplace, req)
+	return err
+}
+
+func (r *CloudRepository) DeleteByExecutionIds(ctx context.Context, ids []string) error {
+	req := DeleteByExecutionIdsRequest{Ids: ids}
+	_, err := r.executor.Execute(ctx, CmdTestCaseDeleteByExecutionIds, req)
+	return err
+}
+
+func (r *CloudRepository) DeleteByTests(ctx context.Context, testNames []string) error {
+	req := DeleteByTestsRequest{TestNames: testNames}
+	_, err := r.executor.Execute(ctx, CmdTestCaseDeleteByTests, req)
+	return err
+}
+
+func (r *CloudRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames []string) error {
+	req := DeleteByTestSuitesRequest{TestSuiteNames: testSuiteNames}
+	_, err := r.executor.Execute(ctx, CmdTestCaseDeleteByTestSuites, req)
+	return err
+}
+
+func (r *CloudRepository) DeleteForAllTestSuites(ctx context.Context) error {
+	_, err := r.executor.Execute(ctx, CmdTestCaseDeleteForAllTestSuites, DeleteForAllTestSuitesRequest{})
+	return err
+}
+
+func (r *CloudRepository) DeleteAll(ctx context.Context) error {
+	_, err := r.executor.Execute(ctx, CmdTestCaseDeleteAll, DeleteAllRequest{})
+	return err
+}
@@ -0,0 +1,47 @@
+package testcase
+
+import (
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+type GetTestCasesRequest struct {
+	Filter *testcase.FilterImpl `json:"filter"`
+}
+
+type GetTestCasesResponse struct {
+	TestCases []testkube.TestCaseResult `json:"testCases"`
+}
+
+type ReplaceRequest struct {
+	ExecutionId string                    `json:"executionId"`
+	TestCases   []testkube.TestCaseResult `json:"testCases"`
+}
+
+type ReplaceResponse struct{}
+
+type DeleteByExecutionIdsRequest struct {
+	Ids []string `json:"ids"`
+}
+
+type DeleteByExecutionIdsResponse struct{}
+
+type DeleteByTestsRequest struct {
+	TestNames []string `json:"testNames"`
+}
+
+type DeleteByTestsResponse struct{}
+
+type DeleteByTestSuitesRequest struct {
+	TestSuiteNames []string `json:"testSuiteNames"`
+}
+
+type DeleteByTestSuitesResponse struct{}
+
+type DeleteForAllTestSuitesRequest struct{}
+
+type DeleteForAllTestSuitesResponse struct{}
+
+type DeleteAllRequest struct{}
+
+type DeleteAllResponse struct{}
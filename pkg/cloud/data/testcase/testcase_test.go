@@ -0,0 +1,51 @@
+package testcase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/cloud/data/executor"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+var ctx = context.Background()
+
+func TestCloudRepository_GetTestCases(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockExecutor := executor.NewMockExecutor(mockCtrl)
+	repo := &CloudRepository{executor: mockExecutor}
+
+	filter := testcase.NewTestCasesFilter().WithName("should_reject_bad_password").WithStatuses([]string{testkube.FAILED_TestCaseStatus})
+	expectedTestCases := []testkube.TestCaseResult{{ExecutionId: "id1", Name: "should_reject_bad_password", Status: testkube.FAILED_TestCaseStatus}}
+	expectedResponseBytes, _ := json.Marshal(GetTestCasesResponse{TestCases: expectedTestCases})
+	mockExecutor.EXPECT().Execute(ctx, CmdTestCaseGetTestCases, GetTestCasesRequest{Filter: filter}).Return(expectedResponseBytes, nil)
+
+	testCases, err := repo.GetTestCases(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTestCases, testCases)
+}
+
+func TestCloudRepository_Replace(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockExecutor := executor.NewMockExecutor(mockCtrl)
+	repo := &CloudRepository{executor: mockExecutor}
+
+	testCases := []testkube.TestCaseResult{{Name: "should_reject_bad_password", Status: testkube.FAILED_TestCaseStatus}}
+	mockExecutor.EXPECT().Execute(ctx, CmdTestCaseReplace, ReplaceRequest{ExecutionId: "id1", TestCases: testCases}).Return(nil, nil)
+
+	assert.NoError(t, repo.Replace(ctx, "id1", testCases))
+}
@@ -16,5 +16,9 @@ const (
 	CmdTestResultDeleteByTestSuite     executor.Command = "test_result_delete_by_test_suite"
 	CmdTestResultDeleteAll             executor.Command = "test_result_delete_all"
 	CmdTestResultDeleteByTestSuites    executor.Command = "test_result_delete_by_test_suites"
+	CmdTestResultDeleteByIds           executor.Command = "test_result_delete_by_ids"
 	CmdTestResultGetTestSuiteMetrics   executor.Command = "test_result_get_test_suite_metrics"
+	CmdTestResultClaim                 executor.Command = "test_result_claim"
+	CmdTestResultAddAnnotation         executor.Command = "test_result_add_annotation"
+	CmdTestResultDeleteAnnotation      executor.Command = "test_result_delete_annotation"
 )
@@ -222,6 +222,61 @@ func (r *CloudRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames
 	return err
 }
 
+func (r *CloudRepository) DeleteByIds(ctx context.Context, ids []string) error {
+	req := DeleteByIdsRequest{Ids: ids}
+	_, err := r.executor.Execute(ctx, CmdTestResultDeleteByIds, req)
+	return err
+}
+
+// AddAnnotation adds annotation to execution result, annotation is appended by the control plane
+func (r *CloudRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	req := AddAnnotationRequest{ID: id, Annotation: annotation}
+	response, err := r.executor.Execute(ctx, CmdTestResultAddAnnotation, req)
+	if err != nil {
+		return err
+	}
+	var commandResponse AddAnnotationResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return err
+	}
+	if !commandResponse.Found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteAnnotation deletes annotation from execution result, annotation is removed by the control plane
+func (r *CloudRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	req := DeleteAnnotationRequest{ID: id, AnnotationID: annotationID}
+	response, err := r.executor.Execute(ctx, CmdTestResultDeleteAnnotation, req)
+	if err != nil {
+		return err
+	}
+	var commandResponse DeleteAnnotationResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return err
+	}
+	if !commandResponse.Found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore, compare and set is done by the control plane
+func (r *CloudRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	req := ClaimRequest{ID: id, RunnerID: runnerID, StaleBefore: staleBefore}
+	response, err := r.executor.Execute(ctx, CmdTestResultClaim, req)
+	if err != nil {
+		return false, err
+	}
+	var commandResponse ClaimResponse
+	if err := json.Unmarshal(response, &commandResponse); err != nil {
+		return false, err
+	}
+	return commandResponse.Claimed, nil
+}
+
 func (r *CloudRepository) GetTestSuiteMetrics(ctx context.Context, name string, limit, last int) (testkube.ExecutionsMetrics, error) {
 	req := GetTestSuiteMetricsRequest{Name: name, Limit: limit, Last: last}
 	response, err := r.executor.Execute(ctx, CmdTestResultGetTestSuiteMetrics, req)
@@ -100,6 +100,12 @@ type DeleteByTestSuitesRequest struct {
 
 type DeleteByTestSuitesResponse struct{}
 
+type DeleteByIdsRequest struct {
+	Ids []string `json:"ids"`
+}
+
+type DeleteByIdsResponse struct{}
+
 type GetTestSuiteMetricsRequest struct {
 	Name  string `json:"name"`
 	Limit int    `json:"limit"`
@@ -109,3 +115,31 @@ type GetTestSuiteMetricsRequest struct {
 type GetTestSuiteMetricsResponse struct {
 	Metrics testkube.ExecutionsMetrics `json:"metrics"`
 }
+
+type ClaimRequest struct {
+	ID          string    `json:"id"`
+	RunnerID    string    `json:"runnerId"`
+	StaleBefore time.Time `json:"staleBefore"`
+}
+
+type ClaimResponse struct {
+	Claimed bool `json:"claimed"`
+}
+
+type AddAnnotationRequest struct {
+	ID         string                       `json:"id"`
+	Annotation testkube.ExecutionAnnotation `json:"annotation"`
+}
+
+type AddAnnotationResponse struct {
+	Found bool `json:"found"`
+}
+
+type DeleteAnnotationRequest struct {
+	ID           string `json:"id"`
+	AnnotationID string `json:"annotationId"`
+}
+
+type DeleteAnnotationResponse struct {
+	Found bool `json:"found"`
+}
@@ -33,6 +33,8 @@ type Params struct {
 	CloudAPIURL               string `envconfig:"RUNNER_CLOUD_API_URL"`                         // RUNNER_CLOUD_API_URL
 	CloudConnectionTimeoutSec int    `envconfig:"RUNNER_CLOUD_CONNECTION_TIMEOUT" default:"10"` // RUNNER_CLOUD_CONNECTION_TIMEOUT
 	SlavesConfigs             string `envconfig:"RUNNER_SLAVES_CONFIGS"`                        // RUNNER_SLAVES_CONFIGS
+	ShardIndex                int    `envconfig:"SHARD_INDEX"`                                  // SHARD_INDEX
+	ShardTotal                int    `envconfig:"SHARD_TOTAL"`                                  // SHARD_TOTAL
 }
 
 // LoadTestkubeVariables loads the parameters provided as environment variables in the Test CRD
@@ -73,6 +75,8 @@ func printParams(params Params) {
 	output.PrintLogf("RUNNER_CLOUD_API_URL=\"%s\"", params.CloudAPIURL)
 	printSensitiveParam("RUNNER_CLOUD_API_KEY", params.CloudAPIKey)
 	output.PrintLogf("RUNNER_CLOUD_CONNECTION_TIMEOUT=%d", params.CloudConnectionTimeoutSec)
+	output.PrintLogf("SHARD_INDEX=%d", params.ShardIndex)
+	output.PrintLogf("SHARD_TOTAL=%d", params.ShardTotal)
 }
 
 // printSensitiveParam shows in logs if a parameter is set or not
@@ -0,0 +1,171 @@
+package bus
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/log"
+)
+
+var (
+	_ Bus = (*InProcessBus)(nil)
+)
+
+// InProcessBufferSize is the number of events buffered for each subscription before publishing blocks
+const InProcessBufferSize = 1024
+
+// NewInProcessBus returns event bus delivering events within the current process,
+// it's meant for single-node installations without NATS
+func NewInProcessBus() *InProcessBus {
+	return &InProcessBus{
+		queues: map[string][]*inProcessSubscription{},
+	}
+}
+
+// InProcessBus mimics NATS semantics: topics are dot separated with "*" and ">" wildcards,
+// and every event is delivered to only one subscriber of the given queue
+type InProcessBus struct {
+	mu     sync.RWMutex
+	queues map[string][]*inProcessSubscription
+	next   map[string]int
+}
+
+type inProcessSubscription struct {
+	topic   string
+	events  chan testkube.Event
+	done    chan struct{}
+	handler Handler
+}
+
+func (s *inProcessSubscription) run() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case event := <-s.events:
+			if err := s.handler(event); err != nil {
+				log.DefaultLogger.Errorw("error handling event", "topic", s.topic, "error", err)
+			}
+		}
+	}
+}
+
+func (s *inProcessSubscription) deliver(event testkube.Event) {
+	select {
+	case <-s.done:
+	case s.events <- event:
+	}
+}
+
+// Publish publishes event on events topic
+func (b *InProcessBus) Publish(event testkube.Event) error {
+	return b.PublishTopic(SubscriptionName, event)
+}
+
+// Subscribe subscribes to events topic
+func (b *InProcessBus) Subscribe(queueName string, handler Handler) error {
+	return b.SubscribeTopic(SubscriptionName, queueName, handler)
+}
+
+// PublishTopic publishes event on given topic
+func (b *InProcessBus) PublishTopic(topic string, event testkube.Event) error {
+	var targets []*inProcessSubscription
+
+	b.mu.Lock()
+	if b.next == nil {
+		b.next = map[string]int{}
+	}
+	for queue, subscriptions := range b.queues {
+		var matching []*inProcessSubscription
+		for _, s := range subscriptions {
+			if matchTopic(s.topic, topic) {
+				matching = append(matching, s)
+			}
+		}
+
+		if len(matching) == 0 {
+			continue
+		}
+
+		// round-robin between queue members like NATS queue groups do
+		targets = append(targets, matching[b.next[queue]%len(matching)])
+		b.next[queue]++
+	}
+	b.mu.Unlock()
+
+	for _, s := range targets {
+		s.deliver(event)
+	}
+
+	return nil
+}
+
+// SubscribeTopic subscribes to given topic
+func (b *InProcessBus) SubscribeTopic(topic, queueName string, handler Handler) error {
+	queue := common.ListenerName(queueName)
+	s := &inProcessSubscription{
+		topic:   topic,
+		events:  make(chan testkube.Event, InProcessBufferSize),
+		done:    make(chan struct{}),
+		handler: handler,
+	}
+
+	b.mu.Lock()
+	b.queues[queue] = append(b.queues[queue], s)
+	b.mu.Unlock()
+
+	go s.run()
+	return nil
+}
+
+func (b *InProcessBus) Unsubscribe(queueName string) error {
+	queue := common.ListenerName(queueName)
+
+	b.mu.Lock()
+	subscriptions := b.queues[queue]
+	delete(b.queues, queue)
+	b.mu.Unlock()
+
+	for _, s := range subscriptions {
+		close(s.done)
+	}
+	return nil
+}
+
+func (b *InProcessBus) Close() error {
+	b.mu.Lock()
+	queues := b.queues
+	b.queues = map[string][]*inProcessSubscription{}
+	b.mu.Unlock()
+
+	for _, subscriptions := range queues {
+		for _, s := range subscriptions {
+			close(s.done)
+		}
+	}
+	return nil
+}
+
+// matchTopic checks if topic matches the subscription pattern using NATS wildcard rules
+func matchTopic(pattern, topic string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	topicTokens := strings.Split(topic, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(topicTokens) > i
+		}
+
+		if i >= len(topicTokens) {
+			return false
+		}
+
+		if token != "*" && token != topicTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(topicTokens)
+}
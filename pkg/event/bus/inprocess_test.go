@@ -0,0 +1,98 @@
+package bus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestInProcessBus(t *testing.T) {
+	t.Run("delivers events on matching topics", func(t *testing.T) {
+		bus := NewInProcessBus()
+		defer bus.Close()
+
+		received := make(chan testkube.Event, 10)
+		err := bus.SubscribeTopic("events.>", "listener", func(event testkube.Event) error {
+			received <- event
+			return nil
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, bus.PublishTopic("events.start-test", testkube.Event{Id: "1"}))
+		assert.NoError(t, bus.PublishTopic(InternalPublishTopic, testkube.Event{Id: "2"}))
+
+		select {
+		case event := <-received:
+			assert.Equal(t, "1", event.Id)
+		case <-time.After(time.Second):
+			t.Fatal("event not delivered")
+		}
+
+		select {
+		case event := <-received:
+			t.Fatalf("unexpected event %s", event.Id)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("delivers event to one member of the queue", func(t *testing.T) {
+		bus := NewInProcessBus()
+		defer bus.Close()
+
+		received := make(chan string, 10)
+		for _, name := range []string{"a", "b"} {
+			name := name
+			err := bus.Subscribe("queue", func(event testkube.Event) error {
+				received <- name
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+
+		assert.NoError(t, bus.Publish(testkube.Event{Id: "1"}))
+		assert.NoError(t, bus.Publish(testkube.Event{Id: "2"}))
+
+		members := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			select {
+			case name := <-received:
+				members[name] = true
+			case <-time.After(time.Second):
+				t.Fatal("event not delivered")
+			}
+		}
+		assert.Len(t, members, 2)
+	})
+
+	t.Run("stops delivering after unsubscribe", func(t *testing.T) {
+		bus := NewInProcessBus()
+		defer bus.Close()
+
+		received := make(chan testkube.Event, 10)
+		assert.NoError(t, bus.Subscribe("queue", func(event testkube.Event) error {
+			received <- event
+			return nil
+		}))
+		assert.NoError(t, bus.Unsubscribe("queue"))
+		assert.NoError(t, bus.Publish(testkube.Event{Id: "1"}))
+
+		select {
+		case event := <-received:
+			t.Fatalf("unexpected event %s", event.Id)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func TestMatchTopic(t *testing.T) {
+	assert.True(t, matchTopic("events", "events"))
+	assert.True(t, matchTopic("events.>", "events.start-test"))
+	assert.True(t, matchTopic("events.>", "events.start-test.name"))
+	assert.False(t, matchTopic("events.>", "events"))
+	assert.True(t, matchTopic("events.*", "events.start-test"))
+	assert.False(t, matchTopic("events.*", "events.start-test.name"))
+	assert.False(t, matchTopic("internal.>", "events.start-test"))
+}
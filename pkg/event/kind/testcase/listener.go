@@ -0,0 +1,80 @@
+package testcase
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/junitreport"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+var _ common.Listener = (*TestCaseListener)(nil)
+
+// NewTestCaseListener returns listener storing test cases parsed from JUnit reports of finished executions
+func NewTestCaseListener(repository testcase.Repository) *TestCaseListener {
+	return &TestCaseListener{
+		Log:        log.DefaultLogger,
+		repository: repository,
+	}
+}
+
+type TestCaseListener struct {
+	Log        *zap.SugaredLogger
+	repository testcase.Repository
+}
+
+func (l *TestCaseListener) Name() string {
+	return "testcase"
+}
+
+func (l *TestCaseListener) Selector() string {
+	return ""
+}
+
+func (l *TestCaseListener) Events() []testkube.EventType {
+	return []testkube.EventType{
+		testkube.END_TEST_SUCCESS_EventType,
+		testkube.END_TEST_FAILED_EventType,
+		testkube.END_TEST_ABORTED_EventType,
+		testkube.END_TEST_TIMEOUT_EventType,
+	}
+}
+
+func (l *TestCaseListener) Metadata() map[string]string {
+	return map[string]string{
+		"name":   l.Name(),
+		"events": fmt.Sprintf("%v", l.Events()),
+	}
+}
+
+func (l *TestCaseListener) Notify(event testkube.Event) (result testkube.EventResult) {
+	if event.TestExecution == nil {
+		return testkube.NewSuccessEventResult(event.Id, "no execution")
+	}
+
+	testCases, err := junitreport.Parse(*event.TestExecution)
+	if err != nil {
+		l.Log.Errorw("parsing junit report error", "executionId", event.TestExecution.Id, "error", err)
+		return testkube.NewFailedEventResult(event.Id, err)
+	}
+
+	if len(testCases) == 0 {
+		return testkube.NewSuccessEventResult(event.Id, "no test cases")
+	}
+
+	if err = l.repository.Replace(context.Background(), event.TestExecution.Id, testCases); err != nil {
+		l.Log.Errorw("storing test cases error", "executionId", event.TestExecution.Id, "error", err)
+		return testkube.NewFailedEventResult(event.Id, err)
+	}
+
+	return testkube.NewSuccessEventResult(event.Id, fmt.Sprintf("stored %d test cases", len(testCases)))
+}
+
+func (l *TestCaseListener) Kind() string {
+	return "testcase"
+}
@@ -0,0 +1,53 @@
+package testcase
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+const report = `<testsuite name="Login">
+  <testcase name="should_reject_bad_password" classname="LoginSpec" time="1"><failure message="expected 401"/></testcase>
+</testsuite>`
+
+func TestTestCaseListener_Notify(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repository := testcase.NewMockRepository(mockCtrl)
+	listener := NewTestCaseListener(repository)
+
+	t.Run("stores test cases from junit report", func(t *testing.T) {
+		execution := testkube.NewQueuedExecution()
+		execution.Id = "execution-1"
+		execution.TestName = "login"
+		execution.ExecutionResult.Reports = &testkube.ExecutionResultReports{Junit: report}
+
+		repository.EXPECT().Replace(gomock.Any(), "execution-1", []testkube.TestCaseResult{{
+			ExecutionId: "execution-1",
+			TestName:    "login",
+			Suite:       "Login",
+			Name:        "should_reject_bad_password",
+			Classname:   "LoginSpec",
+			DurationMs:  1000,
+			Status:      testkube.FAILED_TestCaseStatus,
+			Message:     "expected 401",
+		}}).Return(nil)
+
+		result := listener.Notify(testkube.NewEventEndTestFailed(execution))
+
+		assert.Equal(t, "", result.Error_)
+	})
+
+	t.Run("ignores executions without junit report", func(t *testing.T) {
+		result := listener.Notify(testkube.NewEventEndTestSuccess(testkube.NewQueuedExecution()))
+
+		assert.Equal(t, "", result.Error_)
+	})
+}
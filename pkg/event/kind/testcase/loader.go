@@ -0,0 +1,28 @@
+package testcase
+
+import (
+	"github.com/kubeshop/testkube/pkg/event/kind/common"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+var _ common.ListenerLoader = (*TestCaseLoader)(nil)
+
+func NewTestCaseLoader(repository testcase.Repository) *TestCaseLoader {
+	return &TestCaseLoader{
+		listener: NewTestCaseListener(repository),
+	}
+}
+
+// TestCaseLoader is a reconciler for the test cases listener, there is always a single listener
+type TestCaseLoader struct {
+	listener *TestCaseListener
+}
+
+func (r *TestCaseLoader) Kind() string {
+	return "testcase"
+}
+
+// Load returns single test cases listener
+func (r *TestCaseLoader) Load() (listeners common.Listeners, err error) {
+	return common.Listeners{r.listener}, nil
+}
@@ -0,0 +1,94 @@
+// Package executionarchive exports execution history to a portable tar.gz archive and imports it back.
+//
+// The archive is streamed entry by entry with the following layout:
+//
+//	manifest.json
+//	executions/<id>/execution.json
+//	executions/<id>/output.log
+//	executions/<id>/artifacts/<file>
+//	testsuite-executions/<id>.json
+package executionarchive
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Version is version of the archive layout written by the exporter
+const Version = 1
+
+const (
+	manifestFile           = "manifest.json"
+	executionsDir          = "executions"
+	testSuiteExecutionsDir = "testsuite-executions"
+	executionFile          = "execution.json"
+	outputFile             = "output.log"
+	artifactsDir           = "artifacts"
+)
+
+// Manifest is the first entry of the archive
+type Manifest struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Artifacts bool      `json:"artifacts"`
+}
+
+func executionPath(id, file string) string {
+	return path.Join(executionsDir, id, file)
+}
+
+func testSuiteExecutionPath(id string) string {
+	return path.Join(testSuiteExecutionsDir, id+".json")
+}
+
+// entry is parsed name of the archive entry
+type entry struct {
+	testSuite bool
+	id        string
+	file      string
+}
+
+// parseEntry splits archive entry name, artifact names keep their subdirectories
+func parseEntry(name string) (e entry, ok bool) {
+	name = path.Clean(strings.TrimPrefix(name, "./"))
+	if strings.HasPrefix(name, testSuiteExecutionsDir+"/") {
+		id := strings.TrimSuffix(strings.TrimPrefix(name, testSuiteExecutionsDir+"/"), ".json")
+		return entry{testSuite: true, id: id}, id != "" && !strings.Contains(id, "/")
+	}
+
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) != 3 || parts[0] != executionsDir || parts[1] == "" {
+		return e, false
+	}
+
+	return entry{id: parts[1], file: parts[2]}, true
+}
+
+// stepExecutions returns executions of the test suite steps
+func stepExecutions(execution testkube.TestSuiteExecution) (executions []testkube.Execution) {
+	for _, step := range execution.StepResults {
+		if step.Execution != nil && step.Execution.Id != "" {
+			executions = append(executions, *step.Execution)
+		}
+	}
+
+	for _, batch := range execution.ExecuteStepResults {
+		for _, step := range batch.Execute {
+			if step.Execution != nil && step.Execution.Id != "" {
+				executions = append(executions, *step.Execution)
+			}
+		}
+	}
+
+	return executions
+}
+
+// hasArtifactsFolder checks if artifacts are stored in the folder per execution of the default bucket,
+// other artifacts can't be told apart from the files of other executions
+func hasArtifactsFolder(execution testkube.Execution) bool {
+	return execution.ArtifactRequest == nil ||
+		(execution.ArtifactRequest.StorageBucket == "" && !execution.ArtifactRequest.OmitFolderPerExecution)
+}
@@ -0,0 +1,151 @@
+package executionarchive
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/storage/local"
+)
+
+type repositories struct {
+	results     *result.SQLRepository
+	testResults *testresult.SQLRepository
+	artifacts   *local.ArtifactClient
+}
+
+func getRepositories(t *testing.T) repositories {
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return repositories{
+		results:     result.NewSQLRepository(db),
+		testResults: testresult.NewSQLRepository(db),
+		artifacts:   local.NewLocalArtifactClient(local.NewClient(t.TempDir(), "testkube-artifacts")),
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	source := getRepositories(t)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	passed := testkube.PASSED_ExecutionStatus
+	failed := testkube.FAILED_ExecutionStatus
+	insertExecution := func(id, testName, testSuiteName string, number int32, status *testkube.ExecutionStatus, startTime time.Time) testkube.Execution {
+		execution := testkube.Execution{
+			Id:              id,
+			Name:            id,
+			Number:          number,
+			TestName:        testName,
+			TestSuiteName:   testSuiteName,
+			StartTime:       startTime,
+			EndTime:         startTime.Add(time.Minute),
+			ExecutionResult: &testkube.ExecutionResult{Status: status, Output: "output of " + id},
+		}
+		require.NoError(t, source.results.Insert(ctx, execution))
+		require.NoError(t, source.artifacts.UploadFile(ctx, id, "reports/junit.xml", strings.NewReader("report of "+id), -1))
+		return execution
+	}
+
+	insertExecution("test-1", "test", "", 1, &passed, now.Add(-3*time.Hour))
+	insertExecution("test-2", "test", "", 2, &failed, now.Add(-2*time.Hour))
+	insertExecution("other-7", "other", "", 7, &passed, now.Add(-time.Hour))
+	step := insertExecution("step-1", "step", "suite", 4, &failed, now.Add(-time.Hour))
+
+	suiteStatus := testkube.FAILED_TestSuiteExecutionStatus
+	require.NoError(t, source.testResults.Insert(ctx, testkube.TestSuiteExecution{
+		Id:        "suite-execution",
+		Name:      "ts-suite-3",
+		TestSuite: &testkube.ObjectRef{Name: "suite"},
+		Status:    &suiteStatus,
+		StartTime: now.Add(-time.Hour),
+		EndTime:   now.Add(-time.Hour).Add(time.Minute),
+		ExecuteStepResults: []testkube.TestSuiteBatchStepExecutionResult{
+			{Execute: []testkube.TestSuiteStepExecutionResult{{Execution: &step}}},
+		},
+	}))
+
+	t.Run("round trip", func(t *testing.T) {
+		data := &bytes.Buffer{}
+		report, err := NewExporter(source.results, source.testResults, source.artifacts).
+			Export(ctx, data, result.NewExecutionsFilter(), true)
+		require.NoError(t, err)
+		assert.Equal(t, testkube.ExecutionsArchiveReport{Executions: 4, TestSuiteExecutions: 1, Artifacts: 4}, report)
+
+		target := getRepositories(t)
+		// existing execution is kept
+		require.NoError(t, target.results.Insert(ctx, testkube.Execution{Id: "test-1", Name: "existing", TestName: "test"}))
+
+		report, err = NewImporter(target.results, target.testResults, target.artifacts).Import(ctx, bytes.NewReader(data.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, testkube.ExecutionsArchiveReport{Executions: 3, TestSuiteExecutions: 1, Artifacts: 3,
+			SkippedExecutions: []string{"test-1"}}, report)
+
+		existing, err := target.results.Get(ctx, "test-1")
+		require.NoError(t, err)
+		assert.Equal(t, "existing", existing.Name)
+
+		execution, err := target.results.Get(ctx, "test-2")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), execution.Number)
+		assert.Equal(t, "output of test-2", execution.ExecutionResult.Output)
+		assert.True(t, execution.IsFailed())
+
+		reader, err := target.artifacts.DownloadFile(ctx, "reports/junit.xml", "test-2", "test", "")
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "report of test-2", string(content))
+
+		suiteExecution, err := target.testResults.Get(ctx, "suite-execution")
+		require.NoError(t, err)
+		assert.Equal(t, "ts-suite-3", suiteExecution.Name)
+		require.Len(t, suiteExecution.ExecuteStepResults, 1)
+		assert.Equal(t, "step-1", suiteExecution.ExecuteStepResults[0].Execute[0].Execution.Id)
+
+		number, err := target.results.GetNextExecutionNumber(ctx, "other")
+		require.NoError(t, err)
+		assert.Equal(t, int32(8), number)
+
+		number, err = target.results.GetNextExecutionNumber(ctx, "ts-suite")
+		require.NoError(t, err)
+		assert.Equal(t, int32(4), number)
+	})
+
+	t.Run("filtered by test name and status", func(t *testing.T) {
+		data := &bytes.Buffer{}
+		report, err := NewExporter(source.results, source.testResults, nil).
+			Export(ctx, data, result.NewExecutionsFilter().WithTestName("test").WithStatus("failed"), true)
+		require.NoError(t, err)
+		assert.Equal(t, testkube.ExecutionsArchiveReport{Executions: 1}, report)
+
+		target := getRepositories(t)
+		report, err = NewImporter(target.results, target.testResults, target.artifacts).Import(ctx, data)
+		require.NoError(t, err)
+		assert.Equal(t, testkube.ExecutionsArchiveReport{Executions: 1}, report)
+
+		_, err = target.results.Get(ctx, "test-2")
+		assert.NoError(t, err)
+		_, err = target.results.Get(ctx, "test-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		target := getRepositories(t)
+		_, err := NewImporter(target.results, target.testResults, nil).Import(ctx, strings.NewReader("not an archive"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,274 @@
+package executionarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/local"
+	"github.com/kubeshop/testkube/pkg/storage/minio"
+)
+
+// NewExporter returns exporter of execution history, artifacts storage is optional
+func NewExporter(
+	resultsRepository result.Repository,
+	testResultsRepository testresult.Repository,
+	artifactsStorage storage.ArtifactsStorage,
+) *Exporter {
+	return &Exporter{
+		resultsRepository:     resultsRepository,
+		testResultsRepository: testResultsRepository,
+		artifactsStorage:      artifactsStorage,
+		now:                   time.Now,
+	}
+}
+
+// Exporter writes executions with their outputs and artifacts to the archive
+type Exporter struct {
+	resultsRepository     result.Repository
+	testResultsRepository testresult.Repository
+	artifactsStorage      storage.ArtifactsStorage
+	now                   func() time.Time
+}
+
+// Export writes executions matching the filter to the archive. Test suite executions are matched by the date range,
+// statuses and selector of the filter, they are skipped when filtering by test name. Executions of their steps
+// are exported together with them. Artifacts are exported only when requested and artifacts storage is available.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, filter *result.FilterImpl, artifacts bool) (
+	report testkube.ExecutionsArchiveReport, err error) {
+	artifacts = artifacts && e.artifactsStorage != nil
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	writer := &archiveWriter{tw: tw, now: e.now()}
+
+	if err = writer.writeJSON(manifestFile, Manifest{Version: Version, CreatedAt: writer.now, Artifacts: artifacts}); err != nil {
+		return report, fmt.Errorf("writing manifest: %w", err)
+	}
+
+	// executions are read page by page following the cursor, so new executions don't shift the pages
+	exported := make(map[string]struct{})
+	executionsFilter := *filter
+	executionsFilter.WithPage(0).WithPageSize(result.PageDefaultLimit).WithCursor("")
+	for {
+		executions, err := e.resultsRepository.GetExecutions(ctx, &executionsFilter)
+		if err != nil {
+			return report, fmt.Errorf("getting executions: %w", err)
+		}
+
+		for _, execution := range executions {
+			if err = e.exportExecution(ctx, writer, execution.Id, artifacts, exported, &report); err != nil {
+				return report, err
+			}
+		}
+
+		if len(executions) < result.PageDefaultLimit {
+			break
+		}
+
+		last := executions[len(executions)-1]
+		executionsFilter.WithCursor(common.EncodeCursor(last.StartTime, last.Id))
+	}
+
+	if !filter.TestNameDefined() {
+		testSuiteFilter := testSuiteExecutionsFilter(filter)
+		for {
+			executions, err := e.testResultsRepository.GetExecutions(ctx, testSuiteFilter)
+			if err != nil {
+				return report, fmt.Errorf("getting test suite executions: %w", err)
+			}
+
+			for _, execution := range executions {
+				for _, step := range stepExecutions(execution) {
+					if err = e.exportExecution(ctx, writer, step.Id, artifacts, exported, &report); err != nil {
+						return report, err
+					}
+				}
+
+				if err = writer.writeJSON(testSuiteExecutionPath(execution.Id), execution); err != nil {
+					return report, fmt.Errorf("writing test suite execution %s: %w", execution.Id, err)
+				}
+				report.TestSuiteExecutions++
+			}
+
+			if len(executions) < testresult.PageDefaultLimit {
+				break
+			}
+
+			last := executions[len(executions)-1]
+			testSuiteFilter.WithCursor(common.EncodeCursor(last.StartTime, last.Id))
+		}
+	}
+
+	if err = tw.Close(); err != nil {
+		return report, err
+	}
+
+	return report, gw.Close()
+}
+
+// exportExecution writes execution with its output and artifacts, executions are written only once
+func (e *Exporter) exportExecution(ctx context.Context, writer *archiveWriter, id string, artifacts bool,
+	exported map[string]struct{}, report *testkube.ExecutionsArchiveReport) error {
+	if _, ok := exported[id]; ok {
+		return nil
+	}
+	exported[id] = struct{}{}
+
+	// executions are listed without outputs, getting them one by one loads the output too
+	execution, err := e.resultsRepository.Get(ctx, id)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// step execution could be already removed
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting execution %s: %w", id, err)
+	}
+
+	output := ""
+	if execution.ExecutionResult != nil {
+		output = execution.ExecutionResult.Output
+		execution.ExecutionResult = execution.ExecutionResult.GetDeepCopy()
+		execution.ExecutionResult.Output = ""
+	}
+
+	if err = writer.writeJSON(executionPath(id, executionFile), execution); err != nil {
+		return fmt.Errorf("writing execution %s: %w", id, err)
+	}
+
+	if err = writer.writeFile(executionPath(id, outputFile), int64(len(output)), []byte(output)); err != nil {
+		return fmt.Errorf("writing output of execution %s: %w", id, err)
+	}
+	report.Executions++
+
+	if !artifacts || !hasArtifactsFolder(execution) {
+		return nil
+	}
+
+	files, err := e.artifactsStorage.ListFiles(ctx, id, execution.TestName, execution.TestSuiteName)
+	if err != nil {
+		if errors.Is(err, minio.ErrArtifactsNotFound) || errors.Is(err, local.ErrArtifactsNotFound) {
+			return nil
+		}
+		return fmt.Errorf("listing artifacts of execution %s: %w", id, err)
+	}
+
+	for _, file := range files {
+		if err = e.exportArtifact(ctx, writer, execution, file); err != nil {
+			return fmt.Errorf("writing artifact %s of execution %s: %w", file.Name, id, err)
+		}
+		report.Artifacts++
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportArtifact(ctx context.Context, writer *archiveWriter, execution testkube.Execution, file testkube.Artifact) error {
+	reader, err := e.artifactsStorage.DownloadFile(ctx, file.Name, execution.Id, execution.TestName, execution.TestSuiteName)
+	if err != nil {
+		return err
+	}
+
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	return writer.writeStream(executionPath(execution.Id, artifactsDir+"/"+file.Name), int64(file.Size), reader)
+}
+
+type archiveWriter struct {
+	tw  *tar.Writer
+	now time.Time
+}
+
+func (w *archiveWriter) writeJSON(name string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return w.writeFile(name, int64(len(data)), data)
+}
+
+func (w *archiveWriter) writeFile(name string, size int64, data []byte) error {
+	if err := w.writeHeader(name, size); err != nil {
+		return err
+	}
+
+	_, err := w.tw.Write(data)
+	return err
+}
+
+// writeStream writes entry of the known size, the reader has to provide exactly size bytes
+func (w *archiveWriter) writeStream(name string, size int64, reader io.Reader) error {
+	if err := w.writeHeader(name, size); err != nil {
+		return err
+	}
+
+	written, err := io.Copy(w.tw, reader)
+	if err != nil {
+		return err
+	}
+
+	if written != size {
+		return fmt.Errorf("expected %d bytes, got %d", size, written)
+	}
+
+	return nil
+}
+
+func (w *archiveWriter) writeHeader(name string, size int64) error {
+	return w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  w.now,
+	})
+}
+
+// testSuiteExecutionsFilter converts filter of test executions to test suite executions one
+func testSuiteExecutionsFilter(filter *result.FilterImpl) *testresult.FilterImpl {
+	testSuiteFilter := testresult.NewExecutionsFilter().WithPageSize(testresult.PageDefaultLimit)
+	if filter.LastNDaysDefined() {
+		testSuiteFilter = testSuiteFilter.WithLastNDays(filter.LastNDays())
+	}
+
+	if filter.StartDateDefined() {
+		testSuiteFilter = testSuiteFilter.WithStartDate(filter.StartDate())
+	}
+
+	if filter.EndDateDefined() {
+		testSuiteFilter = testSuiteFilter.WithEndDate(filter.EndDate())
+	}
+
+	if filter.StatusesDefined() {
+		statuses := make([]string, len(filter.Statuses()))
+		for i, status := range filter.Statuses() {
+			statuses[i] = string(status)
+		}
+		testSuiteFilter = testSuiteFilter.WithStatus(strings.Join(statuses, ","))
+	}
+
+	if filter.TextSearchDefined() {
+		testSuiteFilter = testSuiteFilter.WithTextSearch(filter.TextSearch())
+	}
+
+	if filter.Selector() != "" {
+		testSuiteFilter = testSuiteFilter.WithSelector(filter.Selector())
+	}
+
+	return testSuiteFilter
+}
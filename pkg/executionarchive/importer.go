@@ -0,0 +1,257 @@
+package executionarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/storage"
+)
+
+// sequences is implemented by results repositories able to move execution numbers forward
+type sequences interface {
+	UpdateExecutionNumber(ctx context.Context, name string, number int32) error
+}
+
+// NewImporter returns importer of execution history, artifacts storage is optional
+func NewImporter(
+	resultsRepository result.Repository,
+	testResultsRepository testresult.Repository,
+	artifactsStorage storage.ArtifactsStorage,
+) *Importer {
+	return &Importer{
+		resultsRepository:     resultsRepository,
+		testResultsRepository: testResultsRepository,
+		artifactsStorage:      artifactsStorage,
+	}
+}
+
+// Importer reads executions with their outputs and artifacts from the archive
+type Importer struct {
+	resultsRepository     result.Repository
+	testResultsRepository testresult.Repository
+	artifactsStorage      storage.ArtifactsStorage
+}
+
+// Import stores executions from the archive keeping their ids and numbers. Executions which already exist
+// are skipped together with their artifacts. Execution number sequences are moved forward to the imported numbers.
+func (i *Importer) Import(ctx context.Context, r io.Reader) (report testkube.ExecutionsArchiveReport, err error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return report, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gr.Close()
+
+	state := &importState{
+		report:  &report,
+		skipped: make(map[string]struct{}),
+		numbers: make(map[string]int32),
+	}
+
+	tr := tar.NewReader(gr)
+	for first := true; ; first = false {
+		header, err := tr.Next()
+		if err == io.EOF {
+			if first {
+				return report, errors.New("archive is empty")
+			}
+			break
+		}
+		if err != nil {
+			return report, fmt.Errorf("reading archive: %w", err)
+		}
+
+		if first {
+			if err = readManifest(header, tr); err != nil {
+				return report, err
+			}
+			continue
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		e, ok := parseEntry(header.Name)
+		if !ok {
+			continue
+		}
+
+		if err = i.importEntry(ctx, state, e, header, tr); err != nil {
+			return report, err
+		}
+	}
+
+	if err = i.flush(ctx, state); err != nil {
+		return report, err
+	}
+
+	if numbers, ok := i.resultsRepository.(sequences); ok {
+		for name, number := range state.numbers {
+			if err = numbers.UpdateExecutionNumber(ctx, name, number); err != nil {
+				return report, fmt.Errorf("updating execution number of %s: %w", name, err)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// importState keeps execution waiting for its output, execution entries are always written together
+type importState struct {
+	report  *testkube.ExecutionsArchiveReport
+	pending *testkube.Execution
+	skipped map[string]struct{}
+	numbers map[string]int32
+}
+
+func (i *Importer) importEntry(ctx context.Context, state *importState, e entry, header *tar.Header, r io.Reader) error {
+	switch {
+	case e.testSuite:
+		if err := i.flush(ctx, state); err != nil {
+			return err
+		}
+
+		var execution testkube.TestSuiteExecution
+		if err := json.NewDecoder(r).Decode(&execution); err != nil {
+			return fmt.Errorf("decoding %s: %w", header.Name, err)
+		}
+
+		return i.importTestSuiteExecution(ctx, state, execution)
+
+	case e.file == executionFile:
+		if err := i.flush(ctx, state); err != nil {
+			return err
+		}
+
+		var execution testkube.Execution
+		if err := json.NewDecoder(r).Decode(&execution); err != nil {
+			return fmt.Errorf("decoding %s: %w", header.Name, err)
+		}
+
+		_, err := i.resultsRepository.Get(ctx, execution.Id)
+		if err == nil {
+			state.skipped[execution.Id] = struct{}{}
+			state.report.SkippedExecutions = append(state.report.SkippedExecutions, execution.Id)
+			return nil
+		}
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return fmt.Errorf("checking execution %s: %w", execution.Id, err)
+		}
+
+		state.pending = &execution
+		return nil
+
+	case e.file == outputFile:
+		if state.pending == nil || state.pending.Id != e.id {
+			return nil
+		}
+
+		output, err := io.ReadAll(r)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", header.Name, err)
+		}
+
+		if len(output) != 0 {
+			if state.pending.ExecutionResult == nil {
+				state.pending.ExecutionResult = &testkube.ExecutionResult{}
+			}
+			state.pending.ExecutionResult.Output = string(output)
+		}
+
+		return i.flush(ctx, state)
+
+	case strings.HasPrefix(e.file, artifactsDir+"/"):
+		if err := i.flush(ctx, state); err != nil {
+			return err
+		}
+
+		if _, ok := state.skipped[e.id]; ok || i.artifactsStorage == nil {
+			return nil
+		}
+
+		name := strings.TrimPrefix(e.file, artifactsDir+"/")
+		if err := i.artifactsStorage.UploadFile(ctx, e.id, name, r, header.Size); err != nil {
+			return fmt.Errorf("uploading artifact %s of execution %s: %w", name, e.id, err)
+		}
+		state.report.Artifacts++
+	}
+
+	return nil
+}
+
+func (i *Importer) importTestSuiteExecution(ctx context.Context, state *importState, execution testkube.TestSuiteExecution) error {
+	_, err := i.testResultsRepository.Get(ctx, execution.Id)
+	if err == nil {
+		state.report.SkippedTestSuiteExecutions = append(state.report.SkippedTestSuiteExecutions, execution.Id)
+		return nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("checking test suite execution %s: %w", execution.Id, err)
+	}
+
+	if err = i.testResultsRepository.Insert(ctx, execution); err != nil {
+		return fmt.Errorf("inserting test suite execution %s: %w", execution.Id, err)
+	}
+
+	// test suite executions don't keep their numbers, default names end with them
+	if execution.TestSuite != nil {
+		prefix := "ts-" + execution.TestSuite.Name
+		if number, err := strconv.Atoi(strings.TrimPrefix(execution.Name, prefix+"-")); err == nil {
+			state.setNumber(prefix, int32(number))
+		}
+	}
+	state.report.TestSuiteExecutions++
+	return nil
+}
+
+// flush inserts execution waiting for its output
+func (i *Importer) flush(ctx context.Context, state *importState) error {
+	if state.pending == nil {
+		return nil
+	}
+
+	execution := *state.pending
+	state.pending = nil
+	if err := i.resultsRepository.Insert(ctx, execution); err != nil {
+		return fmt.Errorf("inserting execution %s: %w", execution.Id, err)
+	}
+
+	state.setNumber(execution.TestName, execution.Number)
+	state.report.Executions++
+	return nil
+}
+
+func (s *importState) setNumber(name string, number int32) {
+	if name != "" && number > s.numbers[name] {
+		s.numbers[name] = number
+	}
+}
+
+func readManifest(header *tar.Header, r io.Reader) error {
+	if header.Name != manifestFile {
+		return fmt.Errorf("archive doesn't start with %s", manifestFile)
+	}
+
+	var manifest Manifest
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return fmt.Errorf("decoding %s: %w", manifestFile, err)
+	}
+
+	if manifest.Version < 1 || manifest.Version > Version {
+		return fmt.Errorf("unsupported archive version %d", manifest.Version)
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package executiondiff
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// outputContextLines is number of unchanged lines shown around every change of the output
+	outputContextLines = 3
+	// secretMask replaces values of secret variables
+	secretMask = "********"
+	// MaxOutputSize is the maximum size in bytes of the compared outputs, larger outputs are rejected
+	MaxOutputSize = 10 << 20
+	// maxOutputLines is number of the last output lines which are compared, earlier lines are skipped
+	maxOutputLines = 2000
+)
+
+// ErrOutputTooLarge is returned when the output of compared execution exceeds MaxOutputSize
+var ErrOutputTooLarge = errors.New("execution output is too large to compare")
+
+// Compare returns differences between two executions, executions are expected to contain their outputs
+func Compare(a, b testkube.Execution) (diff testkube.ExecutionDiff, err error) {
+	diff.ExecutionIdA = a.Id
+	diff.ExecutionNameA = a.Name
+	diff.ExecutionIdB = b.Id
+	diff.ExecutionNameB = b.Name
+	diff.Fields = compareValues(fields(a), fields(b))
+	diff.Variables = compareValues(variables(a), variables(b))
+	diff.Steps = compareValues(steps(a), steps(b))
+
+	for _, execution := range []testkube.Execution{a, b} {
+		if size := len(output(execution)); size > MaxOutputSize {
+			return diff, fmt.Errorf("%w: output of execution %s has %d bytes, limit is %d bytes", ErrOutputTooLarge, execution.Id, size, MaxOutputSize)
+		}
+	}
+
+	// the diff is quadratic in the number of lines, so only the tails of long outputs are compared
+	linesA, truncatedA := tail(lines(output(a)), maxOutputLines)
+	linesB, truncatedB := tail(lines(output(b)), maxOutputLines)
+	diff.OutputTruncated = truncatedA || truncatedB
+	diff.Output, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        linesA,
+		B:        linesB,
+		FromFile: a.Name,
+		ToFile:   b.Name,
+		Context:  outputContextLines,
+	})
+	if err != nil {
+		return diff, fmt.Errorf("comparing outputs of executions %s and %s: %w", a.Id, b.Id, err)
+	}
+
+	return diff, nil
+}
+
+// value is a named value of the execution, values keep the order they were added in
+type value struct {
+	name  string
+	value string
+	// secret values are compared, but never shown
+	secret bool
+}
+
+func (v value) String() string {
+	if v.secret && v.value != "" {
+		return secretMask
+	}
+
+	return v.value
+}
+
+// compareValues returns values which differ or exist only in one of the executions, in the order of the first execution
+func compareValues(a, b []value) (items []testkube.ExecutionDiffItem) {
+	valuesB := make(map[string]value, len(b))
+	for _, v := range b {
+		valuesB[v.name] = v
+	}
+
+	found := make(map[string]bool, len(a))
+	for _, v := range a {
+		found[v.name] = true
+		if vb, ok := valuesB[v.name]; !ok || vb.value != v.value || vb.secret != v.secret {
+			items = append(items, testkube.ExecutionDiffItem{Name: v.name, A: v.String(), B: vb.String()})
+		}
+	}
+
+	for _, v := range b {
+		if !found[v.name] {
+			items = append(items, testkube.ExecutionDiffItem{Name: v.name, B: v.String()})
+		}
+	}
+
+	return items
+}
+
+func fields(execution testkube.Execution) []value {
+	values := []value{
+		{name: "test", value: execution.TestName},
+		{name: "status", value: status(execution)},
+		{name: "image", value: execution.Image},
+		{name: "command", value: strings.Join(execution.Command, " ")},
+		{name: "args", value: strings.Join(execution.Args, " ")},
+		{name: "duration", value: execution.Duration},
+	}
+
+	if execution.Content != nil {
+		values = append(values, value{name: "content type", value: execution.Content.Type_})
+		if execution.Content.Repository != nil {
+			values = append(values,
+				value{name: "git uri", value: execution.Content.Repository.Uri},
+				value{name: "git branch", value: execution.Content.Repository.Branch},
+				value{name: "git commit", value: execution.Content.Repository.Commit},
+				value{name: "git path", value: execution.Content.Repository.Path},
+			)
+		}
+	}
+
+	if execution.ExecutionResult != nil {
+		values = append(values, value{name: "error message", value: execution.ExecutionResult.ErrorMessage})
+	}
+
+	return values
+}
+
+func variables(execution testkube.Execution) []value {
+	names := make([]string, 0, len(execution.Variables))
+	for name := range execution.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := make([]value, len(names))
+	for i, name := range names {
+		variable := execution.Variables[name]
+		values[i] = value{
+			name:   name,
+			value:  variableValue(variable),
+			secret: variable.Type_ != nil && variable.IsSecret() && variable.Value != "",
+		}
+	}
+
+	return values
+}
+
+// variableValue returns value of the variable, references are used instead of values when they are not resolved
+func variableValue(variable testkube.Variable) string {
+	switch {
+	case variable.Value != "":
+		return variable.Value
+	case variable.SecretRef != nil:
+		return fmt.Sprintf("secret %s/%s", variable.SecretRef.Name, variable.SecretRef.Key)
+	case variable.ConfigMapRef != nil:
+		return fmt.Sprintf("config map %s/%s", variable.ConfigMapRef.Name, variable.ConfigMapRef.Key)
+	default:
+		return variable.Value
+	}
+}
+
+// steps returns step statuses and assertion results, repeated step names are told apart by their position
+func steps(execution testkube.Execution) (values []value) {
+	if execution.ExecutionResult == nil {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, step := range execution.ExecutionResult.Steps {
+		name := step.Name
+		counts[name]++
+		if counts[name] > 1 {
+			name = fmt.Sprintf("%s #%d", name, counts[name])
+		}
+
+		values = append(values, value{name: name, value: step.Status})
+		for _, assertion := range step.AssertionResults {
+			result := assertion.Status
+			if assertion.ErrorMessage != "" {
+				result += ": " + assertion.ErrorMessage
+			}
+
+			values = append(values, value{name: name + " / " + assertion.Name, value: result})
+		}
+	}
+
+	return values
+}
+
+func status(execution testkube.Execution) string {
+	if execution.ExecutionResult == nil || execution.ExecutionResult.Status == nil {
+		return ""
+	}
+
+	return string(*execution.ExecutionResult.Status)
+}
+
+// lines splits the output into lines ending with a new line, the last line doesn't have to end with one in the output
+func lines(output string) []string {
+	if output == "" {
+		return nil
+	}
+
+	result := strings.SplitAfter(strings.TrimSuffix(output, "\n"), "\n")
+	result[len(result)-1] += "\n"
+	return result
+}
+
+// tail returns the last n lines and reports if any lines were skipped
+func tail(lines []string, n int) ([]string, bool) {
+	if len(lines) <= n {
+		return lines, false
+	}
+
+	return lines[len(lines)-n:], true
+}
+
+func output(execution testkube.Execution) string {
+	if execution.ExecutionResult == nil {
+		return ""
+	}
+
+	return execution.ExecutionResult.Output
+}
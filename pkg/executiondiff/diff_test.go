@@ -0,0 +1,122 @@
+package executiondiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestCompare(t *testing.T) {
+	a := testkube.Execution{
+		Id:       "1",
+		Name:     "test-1",
+		TestName: "test",
+		Image:    "kubeshop/testkube-curl-executor:1.0.0",
+		Args:     []string{"--verbose"},
+		Duration: "1s",
+		Content:  &testkube.TestContent{Type_: "git", Repository: &testkube.Repository{Uri: "https://github.com/kubeshop/testkube", Branch: "main", Commit: "a"}},
+		Variables: map[string]testkube.Variable{
+			"url":    testkube.NewBasicVariable("url", "http://localhost"),
+			"token":  testkube.NewSecretVariable("token", "old"),
+			"same":   testkube.NewSecretVariable("same", "secret"),
+			"secret": testkube.NewSecretVariableReference("secret", "credentials", "password"),
+		},
+		ExecutionResult: &testkube.ExecutionResult{
+			Status: testkube.ExecutionStatusPassed,
+			Output: "start\nrequest ok\nend\n",
+			Steps: []testkube.ExecutionStepResult{
+				{Name: "request", Status: "passed", AssertionResults: []testkube.AssertionResult{{Name: "status", Status: "passed"}}},
+				{Name: "request", Status: "passed"},
+			},
+		},
+	}
+
+	b := testkube.Execution{
+		Id:       "2",
+		Name:     "test-2",
+		TestName: "test",
+		Image:    "kubeshop/testkube-curl-executor:1.1.0",
+		Args:     []string{"--verbose"},
+		Duration: "2s",
+		Content:  &testkube.TestContent{Type_: "git", Repository: &testkube.Repository{Uri: "https://github.com/kubeshop/testkube", Branch: "main", Commit: "b"}},
+		Variables: map[string]testkube.Variable{
+			"url":   testkube.NewBasicVariable("url", "http://localhost"),
+			"token": testkube.NewSecretVariable("token", "new"),
+			"same":  testkube.NewSecretVariable("same", "secret"),
+			"debug": testkube.NewBasicVariable("debug", "true"),
+		},
+		ExecutionResult: &testkube.ExecutionResult{
+			Status:       testkube.ExecutionStatusFailed,
+			ErrorMessage: "assertion failed",
+			Output:       "start\nrequest failed\nend\n",
+			Steps: []testkube.ExecutionStepResult{
+				{Name: "request", Status: "failed", AssertionResults: []testkube.AssertionResult{{Name: "status", Status: "failed", ErrorMessage: "expected 200"}}},
+				{Name: "request", Status: "passed"},
+			},
+		},
+	}
+
+	diff, err := Compare(a, b)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", diff.ExecutionIdA)
+	assert.Equal(t, "test-2", diff.ExecutionNameB)
+	assert.Equal(t, []testkube.ExecutionDiffItem{
+		{Name: "status", A: "passed", B: "failed"},
+		{Name: "image", A: "kubeshop/testkube-curl-executor:1.0.0", B: "kubeshop/testkube-curl-executor:1.1.0"},
+		{Name: "duration", A: "1s", B: "2s"},
+		{Name: "git commit", A: "a", B: "b"},
+		{Name: "error message", B: "assertion failed"},
+	}, diff.Fields)
+	assert.Equal(t, []testkube.ExecutionDiffItem{
+		{Name: "secret", A: "secret credentials/password"},
+		{Name: "token", A: secretMask, B: secretMask},
+		{Name: "debug", B: "true"},
+	}, diff.Variables)
+	assert.Equal(t, []testkube.ExecutionDiffItem{
+		{Name: "request", A: "passed", B: "failed"},
+		{Name: "request / status", A: "passed", B: "failed: expected 200"},
+	}, diff.Steps)
+	assert.Equal(t, "--- test-1\n+++ test-2\n@@ -1,3 +1,3 @@\n start\n-request ok\n+request failed\n end\n", diff.Output)
+	assert.False(t, diff.IsEmpty())
+}
+
+func TestCompare_Same(t *testing.T) {
+	execution := testkube.Execution{
+		Id:              "1",
+		Name:            "test-1",
+		Args:            []string{"--verbose"},
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusPassed, Output: "ok\n"},
+	}
+
+	diff, err := Compare(execution, execution)
+
+	assert.NoError(t, err)
+	assert.True(t, diff.IsEmpty())
+}
+
+func TestCompare_LongOutput(t *testing.T) {
+	execution := func(id, lastLine string) testkube.Execution {
+		return testkube.Execution{Id: id, Name: "test-" + id, ExecutionResult: &testkube.ExecutionResult{
+			Output: strings.Repeat("line\n", maxOutputLines) + lastLine + "\n",
+		}}
+	}
+
+	diff, err := Compare(execution("1", "passed"), execution("2", "failed"))
+
+	assert.NoError(t, err)
+	assert.True(t, diff.OutputTruncated)
+	assert.Contains(t, diff.Output, "-passed\n+failed\n")
+}
+
+func TestCompare_OutputTooLarge(t *testing.T) {
+	a := testkube.Execution{Id: "1", ExecutionResult: &testkube.ExecutionResult{Output: strings.Repeat("x", MaxOutputSize+1)}}
+	b := testkube.Execution{Id: "2"}
+
+	_, err := Compare(a, b)
+
+	assert.ErrorIs(t, err, ErrOutputTooLarge)
+}
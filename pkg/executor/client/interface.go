@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 
+	"k8s.io/apimachinery/pkg/runtime"
+
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/executor/output"
 )
@@ -26,6 +28,12 @@ type Executor interface {
 	// Abort aborts pending execution, do nothing when there is no pending execution
 	Abort(ctx context.Context, execution *testkube.Execution) (result *testkube.ExecutionResult, err error)
 
+	// Reattach watches the execution started before api server restart and stores its result when it's finished
+	Reattach(ctx context.Context, execution *testkube.Execution, options ExecuteOptions) (result *testkube.ExecutionResult, err error)
+
+	// DryRun renders Kubernetes objects which would be created for the execution without creating them
+	DryRun(ctx context.Context, execution *testkube.Execution, options ExecuteOptions) (objects []runtime.Object, err error)
+
 	Logs(ctx context.Context, id string) (logs chan output.Output, err error)
 }
 
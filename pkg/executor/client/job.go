@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
@@ -163,6 +165,7 @@ type JobOptions struct {
 	ClusterID             string
 	ArtifactRequest       *testkube.ArtifactRequest
 	WorkingDir            string
+	Shards                int32
 }
 
 // Logs returns job logs stream channel using kubernetes api
@@ -208,13 +211,34 @@ func (c *JobExecutor) Execute(ctx context.Context, execution *testkube.Execution
 	}
 
 	podsClient := c.ClientSet.CoreV1().Pods(c.Namespace)
+	l := c.Log.With("executionID", execution.Id, "type", "async")
+	if options.Request.Shards > 1 {
+		shardPods, err := executor.GetShardPods(ctx, podsClient, execution.Id, options.Request.Shards, 10)
+		if err != nil {
+			return result.Err(err), err
+		}
+
+		// for sync block and complete
+		if options.Sync {
+			return c.updateResultsFromShardPods(ctx, shardPods, l, execution, options.Request.NegativeTest)
+		}
+
+		// for async start goroutine and return in progress job
+		go func() {
+			_, err := c.updateResultsFromShardPods(ctx, shardPods, l, execution, options.Request.NegativeTest)
+			if err != nil {
+				l.Errorw("update results from jobs shard pods error", "error", err)
+			}
+		}()
+
+		return result, nil
+	}
+
 	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
 	if err != nil {
 		return result.Err(err), err
 	}
 
-	l := c.Log.With("executionID", execution.Id, "type", "async")
-
 	for _, pod := range pods.Items {
 		if pod.Status.Phase != corev1.PodRunning && pod.Labels["job-name"] == execution.Id {
 			// for sync block and complete
@@ -239,6 +263,47 @@ func (c *JobExecutor) Execute(ctx context.Context, execution *testkube.Execution
 	return testkube.NewRunningExecutionResult(), nil
 }
 
+// Reattach watches pods of the job started before api server restart and stores execution results when it's finished
+func (c *JobExecutor) Reattach(ctx context.Context, execution *testkube.Execution, options ExecuteOptions) (result *testkube.ExecutionResult, err error) {
+	podsClient := c.ClientSet.CoreV1().Pods(c.Namespace)
+	l := c.Log.With("executionID", execution.Id, "type", "reattach")
+	if execution.Shards > 1 {
+		shardPods, err := executor.GetShardPods(ctx, podsClient, execution.Id, execution.Shards, 10)
+		if err != nil {
+			return c.failReattach(ctx, l, execution, err)
+		}
+
+		return c.updateResultsFromShardPods(ctx, shardPods, l, execution, options.Request.NegativeTest)
+	}
+
+	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
+	if err != nil {
+		return c.failReattach(ctx, l, execution, err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Labels["job-name"] == execution.Id {
+			return c.updateResultsFromPod(ctx, pod, l, execution, options.Request.NegativeTest)
+		}
+	}
+
+	return c.failReattach(ctx, l, execution, errors.Errorf("no pods found for job %s", execution.Id))
+}
+
+// failReattach stores failed result of the execution which pods can't be found anymore
+func (c *JobExecutor) failReattach(ctx context.Context, l *zap.SugaredLogger, execution *testkube.Execution, err error) (*testkube.ExecutionResult, error) {
+	l.Errorw("reattaching to execution error", "error", err)
+	if execution.ExecutionResult == nil {
+		execution.ExecutionResult = testkube.NewRunningExecutionResult()
+	}
+
+	if serr := c.stopExecution(ctx, l, execution, execution.ExecutionResult, false, err); serr != nil {
+		l.Errorw("error stopping execution after reattaching error", "error", serr)
+	}
+
+	return execution.ExecutionResult, err
+}
+
 func (c *JobExecutor) MonitorJobForTimeout(ctx context.Context, jobName string) {
 	ticker := time.NewTicker(pollJobStatus)
 	l := c.Log.With("jobName", jobName)
@@ -287,20 +352,35 @@ func (c *JobExecutor) MonitorJobForTimeout(ctx context.Context, jobName string)
 // CreateJob creates new Kubernetes job based on execution and execute options
 func (c *JobExecutor) CreateJob(ctx context.Context, execution testkube.Execution, options ExecuteOptions) error {
 	jobs := c.ClientSet.BatchV1().Jobs(c.Namespace)
-	jobOptions, err := NewJobOptions(c.Log, c.templatesClient, c.images.Init, c.jobTemplate, c.serviceAccountName, c.registry,
-		c.clusterID, execution, options)
+	jobSpec, err := c.newJobSpec(execution, options)
 	if err != nil {
 		return err
 	}
 
-	c.Log.Debug("creating job with options", "options", jobOptions)
-	jobSpec, err := NewJobSpec(c.Log, jobOptions)
+	_, err = jobs.Create(ctx, jobSpec, metav1.CreateOptions{})
+	return err
+}
+
+// DryRun renders Kubernetes job which would be created for the execution without creating it
+func (c *JobExecutor) DryRun(ctx context.Context, execution *testkube.Execution, options ExecuteOptions) ([]runtime.Object, error) {
+	jobSpec, err := c.newJobSpec(*execution, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = jobs.Create(ctx, jobSpec, metav1.CreateOptions{})
-	return err
+	return []runtime.Object{jobSpec}, nil
+}
+
+// newJobSpec renders Kubernetes job spec based on execution and execute options
+func (c *JobExecutor) newJobSpec(execution testkube.Execution, options ExecuteOptions) (*batchv1.Job, error) {
+	jobOptions, err := NewJobOptions(c.Log, c.templatesClient, c.images.Init, c.jobTemplate, c.serviceAccountName, c.registry,
+		c.clusterID, execution, options)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Log.Debug("creating job with options", "options", jobOptions)
+	return NewJobSpec(c.Log, jobOptions)
 }
 
 // updateResultsFromPod watches logs and stores results if execution is finished
@@ -314,6 +394,50 @@ func (c *JobExecutor) updateResultsFromPod(ctx context.Context, pod corev1.Pod,
 		}
 	}()
 
+	execution.ExecutionResult, err = c.getResultFromPod(ctx, pod, l, execution.ExecutionResult)
+	// saving result in the defer function
+	return execution.ExecutionResult, err
+}
+
+// updateResultsFromShardPods watches logs of all shard pods and stores merged results when all shards are finished
+func (c *JobExecutor) updateResultsFromShardPods(ctx context.Context, pods []corev1.Pod, l *zap.SugaredLogger, execution *testkube.Execution, isNegativeTest bool) (*testkube.ExecutionResult, error) {
+	// save stop time and final state
+	defer func() {
+		if err := c.stopExecution(ctx, l, execution, execution.ExecutionResult, isNegativeTest, nil); err != nil {
+			l.Errorw("error stopping execution after updating results from shard pods", "error", err)
+		}
+	}()
+
+	results := make([]testkube.ExecutionResult, len(pods))
+	var wg sync.WaitGroup
+	for i := range pods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			shardLog := l.With("shard", i, "pod", pods[i].Name)
+			result, err := c.getResultFromPod(ctx, pods[i], shardLog, testkube.NewRunningExecutionResult())
+			if err != nil {
+				shardLog.Errorw("getting shard result error", "error", err)
+				result.Err(err)
+			}
+
+			results[i] = *result
+		}(i)
+	}
+
+	wg.Wait()
+
+	result := testkube.MergeShardResults(results)
+	execution.ExecutionResult = &result
+	// saving result in the defer function
+	return execution.ExecutionResult, nil
+}
+
+// getResultFromPod waits for the pod to finish and parses execution result from its logs
+func (c *JobExecutor) getResultFromPod(ctx context.Context, pod corev1.Pod, l *zap.SugaredLogger, result *testkube.ExecutionResult) (*testkube.ExecutionResult, error) {
+	var err error
+
 	// wait for pod to be loggable
 	if err = wait.PollUntilContextTimeout(ctx, pollInterval, c.podStartTimeout, true, executor.IsPodLoggable(c.ClientSet, pod.Name, c.Namespace)); err != nil {
 		l.Errorw("waiting for pod started error", "error", err)
@@ -326,7 +450,7 @@ func (c *JobExecutor) updateResultsFromPod(ctx context.Context, pod corev1.Pod,
 		l.Errorw("waiting for pod complete error", "error", err)
 	}
 	if err != nil {
-		execution.ExecutionResult.Err(err)
+		result.Err(err)
 	}
 	l.Debug("poll immediate end")
 
@@ -334,27 +458,26 @@ func (c *JobExecutor) updateResultsFromPod(ctx context.Context, pod corev1.Pod,
 	logs, err = executor.GetPodLogs(ctx, c.ClientSet, c.Namespace, pod)
 	if err != nil {
 		l.Errorw("get pod logs error", "error", err)
-		return execution.ExecutionResult, err
+		return result, err
 	}
 
 	// parse job output log (JSON stream)
-	execution.ExecutionResult, err = output.ParseRunnerOutput(logs)
+	result, err = output.ParseRunnerOutput(logs)
 	if err != nil {
 		l.Errorw("parse output error", "error", err)
-		return execution.ExecutionResult, err
+		return result, err
 	}
 
-	if execution.ExecutionResult.IsFailed() {
-		errorMessage := execution.ExecutionResult.ErrorMessage
+	if result.IsFailed() {
+		errorMessage := result.ErrorMessage
 		if errorMessage == "" {
 			errorMessage = executor.GetPodErrorMessage(ctx, c.ClientSet, &pod)
 		}
 
-		execution.ExecutionResult.ErrorMessage = errorMessage
+		result.ErrorMessage = errorMessage
 	}
 
-	// saving result in the defer function
-	return execution.ExecutionResult, nil
+	return result, nil
 }
 
 func (c *JobExecutor) stopExecution(ctx context.Context, l *zap.SugaredLogger, execution *testkube.Execution, result *testkube.ExecutionResult, isNegativeTest bool, passedErr error) error {
@@ -519,6 +642,7 @@ func NewJobOptionsFromExecutionOptions(options ExecuteOptions) JobOptions {
 		EnvConfigMaps:         options.Request.EnvConfigMaps,
 		EnvSecrets:            options.Request.EnvSecrets,
 		Labels:                labels,
+		Shards:                options.Request.Shards,
 	}
 }
 
@@ -765,6 +889,8 @@ func NewJobSpec(log *zap.SugaredLogger, options JobOptions) (*batchv1.Job, error
 		}
 	}
 
+	executor.ShardJob(&job, options.Shards)
+
 	return &job, nil
 }
 
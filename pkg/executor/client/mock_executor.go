@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	testkube "github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	output "github.com/kubeshop/testkube/pkg/executor/output"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // MockExecutor is a mock of Executor interface.
@@ -51,6 +52,21 @@ func (mr *MockExecutorMockRecorder) Abort(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockExecutor)(nil).Abort), arg0, arg1)
 }
 
+// DryRun mocks base method.
+func (m *MockExecutor) DryRun(arg0 context.Context, arg1 *testkube.Execution, arg2 ExecuteOptions) ([]runtime.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRun", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]runtime.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DryRun indicates an expected call of DryRun.
+func (mr *MockExecutorMockRecorder) DryRun(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRun", reflect.TypeOf((*MockExecutor)(nil).DryRun), arg0, arg1, arg2)
+}
+
 // Execute mocks base method.
 func (m *MockExecutor) Execute(arg0 context.Context, arg1 *testkube.Execution, arg2 ExecuteOptions) (*testkube.ExecutionResult, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +96,18 @@ func (mr *MockExecutorMockRecorder) Logs(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockExecutor)(nil).Logs), arg0, arg1)
 }
+
+// Reattach mocks base method.
+func (m *MockExecutor) Reattach(arg0 context.Context, arg1 *testkube.Execution, arg2 ExecuteOptions) (*testkube.ExecutionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reattach", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*testkube.ExecutionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reattach indicates an expected call of Reattach.
+func (mr *MockExecutorMockRecorder) Reattach(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reattach", reflect.TypeOf((*MockExecutor)(nil).Reattach), arg0, arg1, arg2)
+}
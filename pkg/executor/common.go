@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	tcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	executorv1 "github.com/kubeshop/testkube-operator/api/executor/v1"
@@ -37,6 +41,12 @@ const (
 	GitTokenSecretName = "git-token"
 	// SlavesConfigsEnv is slave configs for creating slaves in executor
 	SlavesConfigsEnv = "RUNNER_SLAVES_CONFIGS"
+	// ShardIndexEnv is index of the shard run by the pod, starting from 0
+	ShardIndexEnv = "SHARD_INDEX"
+	// ShardTotalEnv is total number of the execution shards
+	ShardTotalEnv = "SHARD_TOTAL"
+	// jobCompletionIndexAnnotation is indexed job pod annotation keeping pod completion index
+	jobCompletionIndexAnnotation = "batch.kubernetes.io/job-completion-index"
 )
 
 var RunnerEnvVars = []corev1.EnvVar{
@@ -252,6 +262,106 @@ func GetJobPods(ctx context.Context, podsClient tcorev1.PodInterface, jobName st
 	return pods, nil
 }
 
+// ShardJob turns the job into indexed job running one pod per shard in parallel,
+// shard index and total are passed to all pod containers as env variables
+func ShardJob(job *batchv1.Job, shards int32) {
+	if shards <= 1 {
+		return
+	}
+
+	completionMode := batchv1.IndexedCompletion
+	job.Spec.CompletionMode = &completionMode
+	job.Spec.Completions = &shards
+	job.Spec.Parallelism = &shards
+
+	envs := []corev1.EnvVar{
+		{
+			Name: ShardIndexEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: fmt.Sprintf("metadata.annotations['%s']", jobCompletionIndexAnnotation),
+				},
+			},
+		},
+		{
+			Name:  ShardTotalEnv,
+			Value: strconv.Itoa(int(shards)),
+		},
+	}
+
+	for i := range job.Spec.Template.Spec.InitContainers {
+		job.Spec.Template.Spec.InitContainers[i].Env = append(job.Spec.Template.Spec.InitContainers[i].Env, envs...)
+	}
+
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, envs...)
+	}
+}
+
+// EncodeManifests encodes Kubernetes objects as multi document YAML with their api versions and kinds set
+func EncodeManifests(objects ...runtime.Object) (string, error) {
+	info, ok := runtime.SerializerInfoForMediaType(scheme.Codecs.SupportedMediaTypes(), runtime.ContentTypeYAML)
+	if !ok {
+		return "", errors.New("yaml serializer is not supported")
+	}
+
+	var buffer bytes.Buffer
+	for i, object := range objects {
+		kinds, _, err := scheme.Scheme.ObjectKinds(object)
+		if err != nil {
+			return "", errors.Wrap(err, "getting object kind")
+		}
+
+		if i != 0 {
+			buffer.WriteString("---\n")
+		}
+
+		encoder := scheme.Codecs.EncoderForVersion(info.Serializer, kinds[0].GroupVersion())
+		if err = encoder.Encode(object, &buffer); err != nil {
+			return "", errors.Wrap(err, "encoding manifest")
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+// GetShardPods waits for pods of all job shards and returns the latest pod of each shard, ordered by shard index
+func GetShardPods(ctx context.Context, podsClient tcorev1.PodInterface, jobName string, shards int32, retryCount int) ([]corev1.Pod, error) {
+	for retryNr := 1; retryNr < retryCount; retryNr++ {
+		pods, err := podsClient.List(ctx, metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+		if err != nil {
+			return nil, err
+		}
+
+		shardPods := make(map[int]corev1.Pod, shards)
+		for _, pod := range pods.Items {
+			index, err := strconv.Atoi(pod.Annotations[jobCompletionIndexAnnotation])
+			if err != nil || index < 0 || index >= int(shards) {
+				continue
+			}
+
+			if current, ok := shardPods[index]; ok && pod.CreationTimestamp.Before(&current.CreationTimestamp) {
+				continue
+			}
+
+			shardPods[index] = pod
+		}
+
+		if len(shardPods) == int(shards) {
+			result := make([]corev1.Pod, shards)
+			for index, pod := range shardPods {
+				result[index] = pod
+			}
+
+			return result, nil
+		}
+
+		time.Sleep(time.Duration(retryNr * 500 * int(time.Millisecond))) // increase backoff timeout
+	}
+
+	return nil, fmt.Errorf("retry count exceeeded, there are no pods for all %d shards of job with given id=%s", shards, jobName)
+}
+
 // GetPodLogs returns pod logs bytes
 func GetPodLogs(ctx context.Context, c kubernetes.Interface, namespace string, pod corev1.Pod, logLinesCount ...int64) (logs []byte, err error) {
 	var count int64 = defaultLogLinesCount
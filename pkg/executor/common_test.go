@@ -3,10 +3,13 @@ package executor
 import (
 	"context"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"k8s.io/client-go/kubernetes/fake"
@@ -130,3 +133,89 @@ func TestGetPodLogs(t *testing.T) {
 		})
 	}
 }
+
+func TestShardJob(t *testing.T) {
+	t.Run("turns job into indexed job with shard envs", func(t *testing.T) {
+		// given
+		job := &batchv1.Job{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "main"}},
+		}}}}
+
+		// when
+		ShardJob(job, 3)
+
+		// then
+		assert.Equal(t, batchv1.IndexedCompletion, *job.Spec.CompletionMode)
+		assert.Equal(t, int32(3), *job.Spec.Completions)
+		assert.Equal(t, int32(3), *job.Spec.Parallelism)
+		for _, container := range append(job.Spec.Template.Spec.InitContainers, job.Spec.Template.Spec.Containers...) {
+			assert.Len(t, container.Env, 2)
+			assert.Equal(t, ShardIndexEnv, container.Env[0].Name)
+			assert.Equal(t, "metadata.annotations['batch.kubernetes.io/job-completion-index']", container.Env[0].ValueFrom.FieldRef.FieldPath)
+			assert.Equal(t, corev1.EnvVar{Name: ShardTotalEnv, Value: "3"}, container.Env[1])
+		}
+	})
+
+	t.Run("keeps not sharded job unchanged", func(t *testing.T) {
+		// given
+		job := &batchv1.Job{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main"}},
+		}}}}
+
+		// when
+		ShardJob(job, 1)
+
+		// then
+		assert.Nil(t, job.Spec.CompletionMode)
+		assert.Empty(t, job.Spec.Template.Spec.Containers[0].Env)
+	})
+}
+
+func TestGetShardPods(t *testing.T) {
+	// given
+	shardPod := func(name, index string, created int64) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "testkube",
+			Labels:            map[string]string{"job-name": "execution"},
+			Annotations:       map[string]string{jobCompletionIndexAnnotation: index},
+			CreationTimestamp: metav1.Unix(created, 0),
+		}}
+	}
+	client := fake.NewSimpleClientset(
+		shardPod("execution-1-old", "1", 1),
+		shardPod("execution-0", "0", 1),
+		shardPod("execution-1-new", "1", 2),
+	)
+
+	// when
+	pods, err := GetShardPods(context.Background(), client.CoreV1().Pods("testkube"), "execution", 2, 2)
+
+	// then
+	assert.NoError(t, err)
+	assert.Len(t, pods, 2)
+	assert.Equal(t, "execution-0", pods[0].Name)
+	assert.Equal(t, "execution-1-new", pods[1].Name)
+}
+
+func TestEncodeManifests(t *testing.T) {
+	// given
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "execution-1", Namespace: "testkube"}}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "execution-1-vars", Namespace: "testkube"},
+		StringData: map[string]string{"token": "secret"}}
+
+	// when
+	manifests, err := EncodeManifests(job, secret)
+
+	// then
+	assert.NoError(t, err)
+	documents := strings.Split(manifests, "---\n")
+	assert.Len(t, documents, 2)
+	assert.Contains(t, documents[0], "apiVersion: batch/v1\n")
+	assert.Contains(t, documents[0], "kind: Job\n")
+	assert.Contains(t, documents[0], "name: execution-1\n")
+	assert.Contains(t, documents[1], "apiVersion: v1\n")
+	assert.Contains(t, documents[1], "kind: Secret\n")
+	assert.Contains(t, documents[1], "token: secret\n")
+}
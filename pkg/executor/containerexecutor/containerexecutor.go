@@ -2,8 +2,11 @@ package containerexecutor
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/kubeshop/testkube/pkg/repository/config"
@@ -16,6 +19,7 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 
@@ -157,6 +161,7 @@ type JobOptions struct {
 	Labels                    map[string]string
 	Registry                  string
 	ClusterID                 string
+	Shards                    int32
 }
 
 // Logs returns job logs stream channel using kubernetes api
@@ -226,14 +231,35 @@ func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Exe
 	}
 
 	podsClient := c.clientSet.CoreV1().Pods(c.namespace)
+	l := c.log.With("executionID", execution.Id, "sync", options.Sync)
+	if jobOptions.Shards > 1 {
+		shardPods, err := executor.GetShardPods(ctx, podsClient, execution.Id, jobOptions.Shards, 10)
+		if err != nil {
+			executionResult.Err(err)
+			return executionResult, err
+		}
+
+		if options.Sync {
+			return c.updateResultsFromShardPods(ctx, shardPods, l, execution)
+		}
+
+		// async wait for complete status or error
+		go func() {
+			_, err := c.updateResultsFromShardPods(ctx, shardPods, l, execution)
+			if err != nil {
+				l.Errorw("update results from jobs shard pods error", "error", err)
+			}
+		}()
+
+		return executionResult, nil
+	}
+
 	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
 	if err != nil {
 		executionResult.Err(err)
 		return executionResult, err
 	}
 
-	l := c.log.With("executionID", execution.Id, "sync", options.Sync)
-
 	for _, pod := range pods.Items {
 		if pod.Status.Phase != corev1.PodRunning && pod.Labels["job-name"] == execution.Id {
 			if options.Sync {
@@ -257,6 +283,55 @@ func (c *ContainerExecutor) Execute(ctx context.Context, execution *testkube.Exe
 	return execution.ExecutionResult, nil
 }
 
+// Reattach watches pods of the job started before api server restart and stores execution results when it's finished
+func (c *ContainerExecutor) Reattach(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) (*testkube.ExecutionResult, error) {
+	if execution.ExecutionResult == nil {
+		execution.ExecutionResult = testkube.NewRunningExecutionResult()
+	}
+
+	l := c.log.With("executionID", execution.Id, "type", "reattach")
+	jobOptions, err := NewJobOptions(c.log, c.templatesClient, c.images, c.templates, c.serviceAccountName,
+		c.registry, c.clusterID, *execution, options)
+	if err != nil {
+		return c.failReattach(ctx, l, execution, err)
+	}
+
+	podsClient := c.clientSet.CoreV1().Pods(c.namespace)
+	if execution.Shards > 1 {
+		shardPods, err := executor.GetShardPods(ctx, podsClient, execution.Id, execution.Shards, 10)
+		if err != nil {
+			return c.failReattach(ctx, l, execution, err)
+		}
+
+		return c.updateResultsFromShardPods(ctx, shardPods, l, execution)
+	}
+
+	pods, err := executor.GetJobPods(ctx, podsClient, execution.Id, 1, 10)
+	if err != nil {
+		return c.failReattach(ctx, l, execution, err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Labels["job-name"] == execution.Id {
+			return c.updateResultsFromPod(ctx, pod, l, execution, jobOptions)
+		}
+	}
+
+	return c.failReattach(ctx, l, execution, fmt.Errorf("no pods found for job %s", execution.Id))
+}
+
+// failReattach stores failed result of the execution which pods can't be found anymore
+func (c *ContainerExecutor) failReattach(ctx context.Context, l *zap.SugaredLogger, execution *testkube.Execution, err error) (*testkube.ExecutionResult, error) {
+	l.Errorw("reattaching to execution error", "error", err)
+	execution.ExecutionResult.Err(err)
+	if uerr := c.repository.UpdateResult(ctx, execution.Id, *execution); uerr != nil {
+		l.Errorw("Update execution result error", "error", uerr)
+	}
+
+	c.stopExecution(ctx, execution, execution.ExecutionResult)
+	return execution.ExecutionResult, err
+}
+
 // createJob creates new Kubernetes job based on execution and execute options
 func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Execution, options client.ExecuteOptions) (*JobOptions, error) {
 	jobsClient := c.clientSet.BatchV1().Jobs(c.namespace)
@@ -269,6 +344,10 @@ func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Ex
 
 	if jobOptions.ArtifactRequest != nil &&
 		jobOptions.ArtifactRequest.StorageClassName != "" {
+		if jobOptions.Shards > 1 {
+			return nil, errors.New("sharded executions don't support artifacts scraped from persistent volume claim")
+		}
+
 		c.log.Debug("creating persistent volume claim with options", "options", jobOptions)
 		pvcsClient := c.clientSet.CoreV1().PersistentVolumeClaims(c.namespace)
 		pvcSpec, err := NewPersistentVolumeClaimSpec(c.log, jobOptions)
@@ -292,6 +371,48 @@ func (c *ContainerExecutor) createJob(ctx context.Context, execution testkube.Ex
 	return jobOptions, err
 }
 
+// DryRun renders Kubernetes objects which would be created for the execution without creating them, persistent
+// volume claim and scraper job are rendered only for artifacts scraped from persistent volume claim
+func (c *ContainerExecutor) DryRun(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) ([]runtime.Object, error) {
+	jobOptions, err := NewJobOptions(c.log, c.templatesClient, c.images, c.templates, c.serviceAccountName,
+		c.registry, c.clusterID, *execution, options)
+	if err != nil {
+		return nil, err
+	}
+
+	scraped := jobOptions.ArtifactRequest != nil && jobOptions.ArtifactRequest.StorageClassName != ""
+	if scraped && jobOptions.Shards > 1 {
+		return nil, errors.New("sharded executions don't support artifacts scraped from persistent volume claim")
+	}
+
+	var objects []runtime.Object
+	if scraped {
+		pvcSpec, err := NewPersistentVolumeClaimSpec(c.log, jobOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, pvcSpec)
+	}
+
+	jobSpec, err := NewExecutorJobSpec(c.log, jobOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	objects = append(objects, jobSpec)
+	if scraped {
+		scraperSpec, err := NewScraperJobSpec(c.log, jobOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, scraperSpec)
+	}
+
+	return objects, nil
+}
+
 // updateResultsFromPod watches logs and stores results if execution is finished
 func (c *ContainerExecutor) updateResultsFromPod(
 	ctx context.Context,
@@ -409,6 +530,12 @@ func (c *ContainerExecutor) updateResultsFromPod(
 
 	executorLogs = append(executorLogs, scraperLogs...)
 
+	// container executors can print named outputs without printing the result
+	outputs, err := output.ParseOutputs(executorLogs)
+	if err != nil {
+		l.Errorw("parse outputs error", "error", err)
+	}
+
 	// parse container output log (mixed JSON and plain text stream)
 	executionResult, output, err := output.ParseContainerOutput(executorLogs)
 	if err != nil {
@@ -426,6 +553,7 @@ func (c *ContainerExecutor) updateResultsFromPod(
 		execution.ExecutionResult = executionResult
 	}
 	execution.ExecutionResult.Output = output
+	execution.ExecutionResult.AddOutputs(outputs)
 
 	if execution.ExecutionResult.IsFailed() {
 		errorMessage := execution.ExecutionResult.ErrorMessage
@@ -444,6 +572,100 @@ func (c *ContainerExecutor) updateResultsFromPod(
 	return execution.ExecutionResult, nil
 }
 
+// updateResultsFromShardPods watches logs of all shard pods and stores merged results when all shards are finished
+func (c *ContainerExecutor) updateResultsFromShardPods(
+	ctx context.Context,
+	executorPods []corev1.Pod,
+	l *zap.SugaredLogger,
+	execution *testkube.Execution,
+) (*testkube.ExecutionResult, error) {
+	// save stop time and final state
+	defer func() {
+		c.stopExecution(ctx, execution, execution.ExecutionResult)
+	}()
+
+	results := make([]testkube.ExecutionResult, len(executorPods))
+	var wg sync.WaitGroup
+	for i := range executorPods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = *c.getResultFromShardPod(ctx, executorPods[i], l.With("shard", i, "pod", executorPods[i].Name))
+		}(i)
+	}
+
+	wg.Wait()
+
+	result := testkube.MergeShardResults(results)
+	execution.ExecutionResult = &result
+
+	l.Infow("container sharded execution completed saving result", "executionId", execution.Id, "status", execution.ExecutionResult.Status)
+	if err := c.repository.UpdateResult(ctx, execution.Id, *execution); err != nil {
+		l.Errorw("Update execution result error", "error", err)
+	}
+	return execution.ExecutionResult, nil
+}
+
+// getResultFromShardPod waits for the shard executor pod to finish and parses execution result from its logs
+func (c *ContainerExecutor) getResultFromShardPod(ctx context.Context, executorPod corev1.Pod, l *zap.SugaredLogger) *testkube.ExecutionResult {
+	result := testkube.NewRunningExecutionResult()
+	l.Debug("poll immediate waiting for executor shard pod")
+	if err := wait.PollUntilContextTimeout(ctx, pollInterval, c.podStartTimeout, true, executor.IsPodLoggable(c.clientSet, executorPod.Name, c.namespace)); err != nil {
+		l.Errorw("waiting for executor shard pod started error", "error", err)
+		result.Err(err)
+	} else if err = wait.PollUntilContextTimeout(ctx, pollInterval, pollTimeout, true, executor.IsPodReady(c.clientSet, executorPod.Name, c.namespace)); err != nil {
+		// continue on poll err and try to get logs later
+		l.Errorw("waiting for executor shard pod complete error", "error", err)
+		result.Err(err)
+	}
+
+	latestExecutorPod, err := c.clientSet.CoreV1().Pods(c.namespace).Get(context.Background(), executorPod.Name, metav1.GetOptions{})
+	if err != nil {
+		return result.Err(err)
+	}
+
+	if !result.IsFailed() {
+		switch latestExecutorPod.Status.Phase {
+		case corev1.PodSucceeded:
+			result.Success()
+		case corev1.PodFailed:
+			result.Error()
+		}
+	}
+
+	executorLogs, err := executor.GetPodLogs(ctx, c.clientSet, c.namespace, *latestExecutorPod)
+	if err != nil {
+		l.Errorw("get executor shard pod logs error", "error", err)
+		return result.Err(err)
+	}
+
+	// container executors can print named outputs without printing the result
+	outputs, err := output.ParseOutputs(executorLogs)
+	if err != nil {
+		l.Errorw("parse outputs error", "error", err)
+	}
+
+	// parse container output log (mixed JSON and plain text stream)
+	executionResult, out, err := output.ParseContainerOutput(executorLogs)
+	if err != nil {
+		l.Errorw("parse output error", "error", err)
+		result.Output = out
+		return result.Err(err)
+	}
+
+	if executionResult != nil {
+		result = executionResult
+	}
+	result.Output = out
+	result.AddOutputs(outputs)
+
+	if result.IsFailed() && result.ErrorMessage == "" {
+		result.ErrorMessage = executor.GetPodErrorMessage(ctx, c.clientSet, latestExecutorPod)
+	}
+
+	return result
+}
+
 func (c *ContainerExecutor) stopExecution(ctx context.Context, execution *testkube.Execution, result *testkube.ExecutionResult) {
 	c.log.Debug("stopping execution")
 	execution.Stop()
@@ -648,6 +870,7 @@ func NewJobOptionsFromExecutionOptions(options client.ExecuteOptions) *JobOption
 		EnvConfigMaps:             options.Request.EnvConfigMaps,
 		EnvSecrets:                options.Request.EnvSecrets,
 		Labels:                    labels,
+		Shards:                    options.Request.Shards,
 	}
 }
 
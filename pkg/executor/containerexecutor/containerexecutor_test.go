@@ -420,6 +420,36 @@ func (r FakeResultRepository) DeleteForAllTestSuites(ctx context.Context) (err e
 	panic("implement me")
 }
 
+func (r FakeResultRepository) DeleteByIds(ctx context.Context, ids []string) (err error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (r FakeResultRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (r FakeResultRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (r FakeResultRepository) AdmitExecution(ctx context.Context, id string) (bool, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (r FakeResultRepository) AbortQueuedExecution(ctx context.Context, id string) (bool, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (r FakeResultRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (r FakeResultRepository) GetTestMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
 	//TODO implement me
 	panic("implement me")
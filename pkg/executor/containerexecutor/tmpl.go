@@ -133,6 +133,8 @@ func NewExecutorJobSpec(log *zap.SugaredLogger, options *JobOptions) (*batchv1.J
 		}
 	}
 
+	executor.ShardJob(&job, options.Shards)
+
 	return &job, nil
 }
 
@@ -15,6 +15,7 @@ const (
 	TypeError        = "error"
 	TypeParsingError = "parsing-error"
 	TypeResult       = "result"
+	TypeOutput       = "output"
 	TypeUnknown      = "unknown"
 )
 
@@ -54,6 +55,16 @@ func NewOutputResult(result testkube.ExecutionResult) Output {
 	}
 }
 
+// NewOutputValue returns new Output struct of type output with named value passed to later test suite steps
+func NewOutputValue(name, value string) Output {
+	return Output{
+		Type_:   TypeOutput,
+		Name:    name,
+		Content: value,
+		Time:    time.Now(),
+	}
+}
+
 // Output generic json based output data structure
 type Output testkube.ExecutorOutput
 
@@ -65,6 +76,8 @@ func (out Output) String() string {
 	case TypeResult:
 		b, _ := json.Marshal(out.Result)
 		return string(b)
+	case TypeOutput:
+		return fmt.Sprintf("%s=%s", out.Name, out.Content)
 	}
 
 	return ""
@@ -95,6 +108,12 @@ func PrintResult(result testkube.ExecutionResult) {
 	fmt.Printf("%s\n", out)
 }
 
+// PrintOutput - prints named output value as output json
+func PrintOutput(name, value string) {
+	out, _ := json.Marshal(NewOutputValue(name, value))
+	fmt.Printf("%s\n", out)
+}
+
 // PrintEvent - prints event as output json
 func PrintEvent(message string, obj ...interface{}) {
 	out, _ := json.Marshal(NewOutputEvent(fmt.Sprintf("%s %v", message, obj)))
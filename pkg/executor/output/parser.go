@@ -69,6 +69,7 @@ func ParseRunnerOutput(b []byte) (*testkube.ExecutionResult, error) {
 	default:
 		result.Err(fmt.Errorf("wrong log type was found as last log: %v", log))
 	}
+	result.AddOutputs(getOutputs(logs))
 	result.Output = sanitizeLogs(logs)
 
 	return result, nil
@@ -108,15 +109,47 @@ func ParseContainerOutput(b []byte) (*testkube.ExecutionResult, string, error) {
 	return result, output, nil
 }
 
+// ParseOutputs returns named outputs from the raw logs in b, the last value wins for repeated names
+func ParseOutputs(b []byte) (map[string]string, error) {
+	logs, err := parseContainerLogs(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return getOutputs(logs), nil
+}
+
 // sanitizeLogs creates a human-readable string from a list of Outputs
 func sanitizeLogs(logs []Output) string {
 	var sb strings.Builder
 	for _, l := range logs {
+		if l.Type_ == TypeOutput {
+			continue
+		}
+
 		sb.WriteString(fmt.Sprintf("%s\n", l.Content))
 	}
 	return sb.String()
 }
 
+// getOutputs collects named outputs from a list of Outputs
+func getOutputs(logs []Output) map[string]string {
+	var outputs map[string]string
+	for _, l := range logs {
+		if l.Type_ != TypeOutput || l.Name == "" {
+			continue
+		}
+
+		if outputs == nil {
+			outputs = map[string]string{}
+		}
+
+		outputs[l.Name] = l.Content
+	}
+
+	return outputs
+}
+
 // parseLogs gets a list of Outputs from raw logs
 func parseLogs(b []byte) ([]Output, error) {
 	logs := []Output{}
@@ -161,6 +194,10 @@ func parseLogs(b []byte) ([]Output, error) {
 			})
 			continue
 		}
+		if log.Type_ == TypeOutput {
+			logs = append(logs, log)
+			continue
+		}
 		// skip appending log entry if log content is empty
 		// this can happen due to scraper logging progress or other libraries having internal logs
 		// and GetLogEntry returns an empty Output
@@ -234,6 +271,10 @@ func getDecidingLogLine(logs []Output) *Output {
 	}
 
 	for _, log := range logs {
+		if log.Type_ == TypeOutput {
+			continue
+		}
+
 		if log.Type_ == TypeResult && log.Result.IsRunning() {
 			// this is the result of the init-container on success, let's ignore it
 			continue
@@ -272,6 +313,10 @@ func getDecidingContainerLogLine(logs []Output) *Output {
 	}
 
 	for _, log := range logs {
+		if log.Type_ == TypeOutput {
+			continue
+		}
+
 		if log.Type_ == TypeResult &&
 			(log.Result == nil || log.Result.Status == nil || log.Result.IsRunning()) {
 			// this is the result of the init-container or scraper pod on success, let's ignore it
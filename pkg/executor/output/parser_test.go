@@ -400,6 +400,22 @@ running test [63ca8c8988564860327a16b5]
 		assert.Equal(t, "can't find branch or commit in params, repo:&{Type_:git-file Uri:https://github.com/kubeshop/testkube.git Branch: Commit: Path:test/cypress/executor-smoke/cypress-11 Username: Token: UsernameSecret:<nil> TokenSecret:<nil> WorkingDir:}", result.ErrorMessage)
 
 	})
+
+	t.Run("Output with named outputs", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`{"type":"line","content":"creating tenant","time":"2023-01-17T15:29:17.921466388Z"}
+{"type":"output","name":"tenantId","content":"t-1","time":"2023-01-17T15:29:17.921466388Z"}
+{"type":"output","name":"region","content":"eu","time":"2023-01-17T15:29:17.921466388Z"}
+{"type":"result","result":{"status":"passed","outputs":{"region":"us"}},"time":"2023-01-17T15:29:18.921466388Z"}
+`)
+		result, err := ParseRunnerOutput(output)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testkube.ExecutionStatusPassed, result.Status)
+		assert.Equal(t, map[string]string{"tenantId": "t-1", "region": "us"}, result.Outputs)
+		assert.Equal(t, "creating tenant\n\n", result.Output)
+	})
 }
 
 func TestParseContainerOutput(t *testing.T) {
@@ -631,3 +647,17 @@ libva error: vaGetDriverNameByIndex() failed with unknown libva error, driver_na
 		assert.Nil(t, result)
 	})
 }
+
+func TestParseOutputs(t *testing.T) {
+	t.Parallel()
+
+	output := []byte(`plain text line
+{"type":"output","name":"tenantId","content":"t-1"}
+{"type":"output","name":"tenantId","content":"t-2"}
+{"type":"output","content":"no name"}
+`)
+	outputs, err := ParseOutputs(output)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"tenantId": "t-2"}, outputs)
+}
@@ -91,7 +91,13 @@ func GetScraper(ctx context.Context, params envs.Params, extractorType Extractor
 		}
 	}
 
-	return scraper.NewExtractLoadScraper(extractor, loader, cdeventsClient, params.ClusterID, params.DashboardURI), nil
+	s := scraper.NewExtractLoadScraper(extractor, loader, cdeventsClient, params.ClusterID, params.DashboardURI)
+	if params.ShardTotal > 1 {
+		// shards upload artifacts to separate folders of the same execution
+		s.WithFolder(fmt.Sprintf("shard-%d", params.ShardIndex+1))
+	}
+
+	return s, nil
 }
 
 func getCloudLoader(ctx context.Context, params envs.Params) (uploader *cloudscraper.CloudUploader, err error) {
@@ -2,6 +2,7 @@ package scraper
 
 import (
 	"context"
+	"path"
 
 	coreminio "github.com/minio/minio-go/v7"
 
@@ -58,7 +59,7 @@ func (l *MinIOUploader) Upload(ctx context.Context, object *Object, execution te
 		opts.ContentType = "application/gzip"
 		opts.UserMetadata = map[string]string{
 			"X-Amz-Meta-Snowball-Auto-Extract": "true",
-			"X-Amz-Meta-Minio-Snowball-Prefix": folder,
+			"X-Amz-Meta-Minio-Snowball-Prefix": getSnowballPrefix(folder, object.Name),
 		}
 	}
 
@@ -69,6 +70,15 @@ func (l *MinIOUploader) Upload(ctx context.Context, object *Object, execution te
 	return nil
 }
 
+// getSnowballPrefix returns folder the tarball is extracted to, tarball located in a subfolder is extracted there
+func getSnowballPrefix(folder, name string) string {
+	if dir := path.Dir(name); dir != "." {
+		return path.Join(folder, dir)
+	}
+
+	return folder
+}
+
 func (l *MinIOUploader) Close() error {
 	return nil
 }
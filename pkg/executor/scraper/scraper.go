@@ -3,6 +3,7 @@ package scraper
 import (
 	"context"
 	"fmt"
+	"path"
 
 	cdevents "github.com/cdevents/sdk-go/pkg/api"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -28,6 +29,7 @@ type ExtractLoadScraper struct {
 	cdeventsClient cloudevents.Client
 	clusterID      string
 	dashboardURI   string
+	folder         string
 }
 
 func NewExtractLoadScraper(extractor Extractor, loader Uploader, cdeventsClient cloudevents.Client,
@@ -41,11 +43,21 @@ func NewExtractLoadScraper(extractor Extractor, loader Uploader, cdeventsClient
 	}
 }
 
+// WithFolder makes the scraper upload all artifacts into the folder inside the execution folder
+func (s *ExtractLoadScraper) WithFolder(folder string) *ExtractLoadScraper {
+	s.folder = folder
+	return s
+}
+
 func (s *ExtractLoadScraper) Scrape(ctx context.Context, paths []string, execution testkube.Execution) error {
 	return s.
 		extractor.
 		Extract(ctx, paths,
 			func(ctx context.Context, object *Object) error {
+				if s.folder != "" {
+					object.Name = path.Join(s.folder, object.Name)
+				}
+
 				return s.loader.Upload(ctx, object, execution)
 			},
 			func(ctx context.Context, path string) error {
@@ -0,0 +1,231 @@
+package flakiness
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+// testCasesPageSize is number of test cases read at once, test has usually many test cases per execution
+const testCasesPageSize = 1000
+
+// NewAnalyzer returns flakiness analyzer, test cases repository is optional, test cases are not analyzed when it's nil
+func NewAnalyzer(resultsRepository result.Repository, testCasesRepository testcase.Repository) *Analyzer {
+	return &Analyzer{
+		resultsRepository:   resultsRepository,
+		testCasesRepository: testCasesRepository,
+	}
+}
+
+// Analyzer computes flakiness of tests and their test cases from the execution history
+type Analyzer struct {
+	resultsRepository   result.Repository
+	testCasesRepository testcase.Repository
+}
+
+// GetTestFlakiness computes flakiness score of the test from the latest executions defined by the quarantine policy,
+// the policy is optional, default threshold and window are used when it's nil
+func (a *Analyzer) GetTestFlakiness(ctx context.Context, testName string, policy *testkube.QuarantinePolicy) (
+	flakiness testkube.TestFlakiness, err error) {
+	flakiness.TestName = testName
+	flakiness.Threshold = policy.GetThreshold()
+
+	filter := result.NewExecutionsFilter().WithTestName(testName).WithPageSize(policy.GetWindow())
+	executions, err := a.resultsRepository.GetExecutions(ctx, filter)
+	if err != nil {
+		return flakiness, fmt.Errorf("getting executions of test %s: %w", testName, err)
+	}
+
+	// executions are returned from the latest one, flips are counted in the order they happened
+	var runs []run
+	keys := make(map[string]string, len(executions))
+	for i := len(executions) - 1; i >= 0; i-- {
+		passed, finished := executionPassed(executions[i])
+		if !finished {
+			continue
+		}
+
+		key := Inputs(executions[i])
+		keys[executions[i].Id] = key
+		runs = append(runs, run{key: key, passed: passed})
+	}
+
+	flips, pairs := countFlips(runs)
+	flakiness.Executions = int32(len(runs))
+	flakiness.Flips = int32(flips)
+	flakiness.Score = score(flips, pairs)
+	flakiness.Flaky = flips > 0 && flakiness.Score >= flakiness.Threshold
+	flakiness.Quarantined = flakiness.Flaky && policy.IsEnabled()
+
+	if a.testCasesRepository == nil || len(runs) == 0 {
+		return flakiness, nil
+	}
+
+	flakiness.TestCases, err = a.getTestCasesFlakiness(ctx, testName, keys, flakiness.Threshold)
+	if err != nil {
+		return flakiness, err
+	}
+
+	return flakiness, nil
+}
+
+// getTestCasesFlakiness computes flakiness of test cases in given executions and returns the flaky ones
+func (a *Analyzer) getTestCasesFlakiness(ctx context.Context, testName string, keys map[string]string, threshold float64) (
+	[]testkube.TestCaseFlakiness, error) {
+	var testCases []testkube.TestCaseResult
+	for page := 0; ; page++ {
+		filter := testcase.NewTestCasesFilter().WithTestName(testName).WithPage(page).WithPageSize(testCasesPageSize)
+		results, err := a.testCasesRepository.GetTestCases(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("getting test cases of test %s: %w", testName, err)
+		}
+
+		found := false
+		for _, result := range results {
+			if _, ok := keys[result.ExecutionId]; ok {
+				testCases = append(testCases, result)
+				found = true
+			}
+		}
+
+		// test cases are sorted from the latest execution, so the rest is out of the window
+		if len(results) < testCasesPageSize || (!found && len(testCases) != 0) {
+			break
+		}
+	}
+
+	type testCaseKey struct {
+		classname string
+		name      string
+	}
+
+	var order []testCaseKey
+	runs := make(map[testCaseKey][]run)
+	for i := len(testCases) - 1; i >= 0; i-- {
+		passed, finished := testCasePassed(testCases[i])
+		if !finished {
+			continue
+		}
+
+		key := testCaseKey{classname: testCases[i].Classname, name: testCases[i].Name}
+		if _, ok := runs[key]; !ok {
+			order = append(order, key)
+		}
+		runs[key] = append(runs[key], run{key: keys[testCases[i].ExecutionId], passed: passed})
+	}
+
+	var result []testkube.TestCaseFlakiness
+	for _, key := range order {
+		flips, pairs := countFlips(runs[key])
+		if flips == 0 || score(flips, pairs) < threshold {
+			continue
+		}
+
+		result = append(result, testkube.TestCaseFlakiness{
+			Classname: key.classname,
+			Name:      key.name,
+			Score:     score(flips, pairs),
+			Runs:      int32(len(runs[key])),
+			Flips:     int32(flips),
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Score > result[j].Score
+	})
+
+	return result, nil
+}
+
+// Inputs returns key of the execution inputs, executions with the same inputs are expected to have the same status
+func Inputs(execution testkube.Execution) string {
+	var inputs struct {
+		Content   *testkube.TestContent `json:"content,omitempty"`
+		Variables map[string]string     `json:"variables,omitempty"`
+		Args      []string              `json:"args,omitempty"`
+	}
+
+	inputs.Content = execution.Content
+	inputs.Args = execution.Args
+	for name, variable := range execution.Variables {
+		if inputs.Variables == nil {
+			inputs.Variables = make(map[string]string, len(execution.Variables))
+		}
+
+		// secret values are not stored, secret references are compared instead
+		value := variable.Value
+		if variable.SecretRef != nil {
+			value = fmt.Sprintf("secret:%s/%s/%s", variable.SecretRef.Namespace, variable.SecretRef.Name, variable.SecretRef.Key)
+		}
+		if variable.ConfigMapRef != nil {
+			value = fmt.Sprintf("configmap:%s/%s/%s", variable.ConfigMapRef.Namespace, variable.ConfigMapRef.Name, variable.ConfigMapRef.Key)
+		}
+		inputs.Variables[name] = value
+	}
+
+	// json encoding sorts map keys, so the same inputs always give the same key
+	data, _ := json.Marshal(inputs)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// run is a single finished run of a test or a test case
+type run struct {
+	key    string
+	passed bool
+}
+
+// countFlips counts status changes between consecutive runs with the same inputs and the number of compared pairs
+func countFlips(runs []run) (flips, pairs int) {
+	last := make(map[string]bool)
+	for _, r := range runs {
+		if passed, ok := last[r.key]; ok {
+			pairs++
+			if passed != r.passed {
+				flips++
+			}
+		}
+		last[r.key] = r.passed
+	}
+
+	return flips, pairs
+}
+
+func score(flips, pairs int) float64 {
+	if pairs == 0 {
+		return 0
+	}
+
+	return float64(flips) / float64(pairs)
+}
+
+// executionPassed returns status of finished execution, aborted and unfinished executions are not taken into account
+func executionPassed(execution testkube.Execution) (passed, finished bool) {
+	switch {
+	case execution.IsPassed():
+		return true, true
+	case execution.IsFailed(), execution.IsTimeout():
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// testCasePassed returns status of the test case, skipped test cases are not taken into account
+func testCasePassed(testCase testkube.TestCaseResult) (passed, finished bool) {
+	switch testCase.Status {
+	case testkube.PASSED_TestCaseStatus:
+		return true, true
+	case testkube.FAILED_TestCaseStatus, testkube.ERROR_TestCaseStatus:
+		return false, true
+	default:
+		return false, false
+	}
+}
@@ -0,0 +1,139 @@
+package flakiness
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+)
+
+func TestAnalyzer_GetTestFlakiness(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	resultsRepository := result.NewSQLRepository(db)
+	testCasesRepository := testcase.NewSQLRepository(db)
+	analyzer := NewAnalyzer(resultsRepository, testCasesRepository)
+
+	now := time.Now()
+	insertExecution := func(i int, commit string, status testkube.ExecutionStatus, testCases map[string]string) {
+		id := fmt.Sprintf("execution-%d", i)
+		execution := testkube.Execution{
+			Id:              id,
+			Name:            id,
+			TestName:        "test",
+			StartTime:       now.Add(time.Duration(i) * time.Minute),
+			Content:         &testkube.TestContent{Type_: "git", Repository: &testkube.Repository{Uri: "https://github.com/kubeshop/testkube", Commit: commit}},
+			ExecutionResult: &testkube.ExecutionResult{Status: &status},
+		}
+		require.NoError(t, resultsRepository.Insert(ctx, execution))
+
+		var results []testkube.TestCaseResult
+		for name, status := range testCases {
+			results = append(results, testkube.TestCaseResult{
+				ExecutionId: id,
+				TestName:    "test",
+				Classname:   "Suite",
+				Name:        name,
+				Status:      status,
+				StartTime:   execution.StartTime,
+			})
+		}
+		require.NoError(t, testCasesRepository.Replace(ctx, id, results))
+	}
+
+	// commit a flips twice in 3 pairs, commit b fails consistently, so only one test case is flaky
+	insertExecution(1, "a", testkube.PASSED_ExecutionStatus, map[string]string{"stable": "passed", "flaky": "passed"})
+	insertExecution(2, "b", testkube.FAILED_ExecutionStatus, map[string]string{"stable": "passed", "flaky": "failed"})
+	insertExecution(3, "a", testkube.FAILED_ExecutionStatus, map[string]string{"stable": "passed", "flaky": "error"})
+	insertExecution(4, "b", testkube.FAILED_ExecutionStatus, map[string]string{"stable": "passed", "flaky": "failed"})
+	insertExecution(5, "a", testkube.PASSED_ExecutionStatus, map[string]string{"stable": "passed", "flaky": "passed"})
+	insertExecution(6, "a", testkube.RUNNING_ExecutionStatus, nil)
+
+	t.Run("flaky test", func(t *testing.T) {
+		flakiness, err := analyzer.GetTestFlakiness(ctx, "test", &testkube.QuarantinePolicy{Enabled: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test", flakiness.TestName)
+		assert.Equal(t, int32(5), flakiness.Executions)
+		assert.Equal(t, int32(2), flakiness.Flips)
+		assert.InDelta(t, 2.0/3.0, flakiness.Score, 0.001)
+		assert.True(t, flakiness.Flaky)
+		assert.True(t, flakiness.Quarantined)
+		assert.Equal(t, []testkube.TestCaseFlakiness{{Classname: "Suite", Name: "flaky", Score: 2.0 / 3.0, Runs: 5, Flips: 2}}, flakiness.TestCases)
+	})
+
+	t.Run("threshold not reached", func(t *testing.T) {
+		flakiness, err := analyzer.GetTestFlakiness(ctx, "test", &testkube.QuarantinePolicy{Enabled: true, Threshold: 0.9})
+
+		assert.NoError(t, err)
+		assert.False(t, flakiness.Flaky)
+		assert.False(t, flakiness.Quarantined)
+		assert.Empty(t, flakiness.TestCases)
+	})
+
+	t.Run("quarantine disabled", func(t *testing.T) {
+		flakiness, err := analyzer.GetTestFlakiness(ctx, "test", nil)
+
+		assert.NoError(t, err)
+		assert.True(t, flakiness.Flaky)
+		assert.False(t, flakiness.Quarantined)
+		assert.Equal(t, testkube.DefaultFlakinessThreshold, flakiness.Threshold)
+	})
+
+	t.Run("window", func(t *testing.T) {
+		flakiness, err := NewAnalyzer(resultsRepository, nil).GetTestFlakiness(ctx, "test", &testkube.QuarantinePolicy{Window: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), flakiness.Executions)
+		assert.Equal(t, int32(0), flakiness.Flips)
+		assert.False(t, flakiness.Flaky)
+		assert.Empty(t, flakiness.TestCases)
+	})
+
+	t.Run("unknown test", func(t *testing.T) {
+		flakiness, err := analyzer.GetTestFlakiness(ctx, "unknown", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(0), flakiness.Executions)
+		assert.Equal(t, 0.0, flakiness.Score)
+	})
+}
+
+func TestInputs(t *testing.T) {
+	execution := testkube.Execution{
+		Content: &testkube.TestContent{Type_: "git", Repository: &testkube.Repository{Uri: "https://github.com/kubeshop/testkube", Commit: "a"}},
+		Variables: map[string]testkube.Variable{
+			"one":    {Name: "one", Value: "1"},
+			"secret": {Name: "secret", SecretRef: &testkube.SecretRef{Name: "secret", Key: "key"}},
+		},
+		Args: []string{"--verbose"},
+	}
+
+	same := execution
+	same.Id = "other"
+	same.Variables = map[string]testkube.Variable{
+		"secret": {Name: "secret", SecretRef: &testkube.SecretRef{Name: "secret", Key: "key"}},
+		"one":    {Name: "one", Value: "1"},
+	}
+	assert.Equal(t, Inputs(execution), Inputs(same))
+
+	otherCommit := execution
+	otherCommit.Content = &testkube.TestContent{Type_: "git", Repository: &testkube.Repository{Uri: "https://github.com/kubeshop/testkube", Commit: "b"}}
+	assert.NotEqual(t, Inputs(execution), Inputs(otherCommit))
+
+	otherVariables := execution
+	otherVariables.Variables = map[string]testkube.Variable{"one": {Name: "one", Value: "2"}}
+	assert.NotEqual(t, Inputs(execution), Inputs(otherVariables))
+}
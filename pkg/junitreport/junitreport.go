@@ -0,0 +1,132 @@
+package junitreport
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/joshdk/go-junit"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// ReadFiles returns JUnit reports stored in the files concatenated into a single report, missing files are skipped
+func ReadFiles(paths ...string) (string, error) {
+	var report bytes.Buffer
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return "", err
+		}
+
+		report.Write(bytes.TrimSpace(data))
+		report.WriteString("\n")
+	}
+
+	return report.String(), nil
+}
+
+// ReadDir returns JUnit reports stored in XML files in the directory and its subdirectories concatenated into a single report
+func ReadDir(dir string) (string, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipDir
+			}
+			return err
+		}
+
+		if !entry.IsDir() && filepath.Ext(path) == ".xml" {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return ReadFiles(paths...)
+}
+
+// Attach stores non empty JUnit report in the execution result
+func Attach(result *testkube.ExecutionResult, report string) {
+	if strings.TrimSpace(report) == "" {
+		return
+	}
+
+	result.Reports = &testkube.ExecutionResultReports{Junit: report}
+}
+
+// Parse returns test cases from the JUnit report of the execution, the report can contain multiple XML documents
+func Parse(execution testkube.Execution) (testCases []testkube.TestCaseResult, err error) {
+	if execution.ExecutionResult == nil || execution.ExecutionResult.Reports == nil ||
+		strings.TrimSpace(execution.ExecutionResult.Reports.Junit) == "" {
+		return nil, nil
+	}
+
+	suites, err := junit.Ingest([]byte(execution.ExecutionResult.Reports.Junit))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, suite := range suites {
+		testCases = append(testCases, parseSuite(execution, suite)...)
+	}
+
+	return testCases, nil
+}
+
+func parseSuite(execution testkube.Execution, suite junit.Suite) (testCases []testkube.TestCaseResult) {
+	for _, test := range suite.Tests {
+		testCases = append(testCases, testkube.TestCaseResult{
+			ExecutionId:   execution.Id,
+			ExecutionName: execution.Name,
+			TestName:      execution.TestName,
+			TestSuiteName: execution.TestSuiteName,
+			Suite:         suite.Name,
+			Name:          test.Name,
+			Classname:     test.Classname,
+			DurationMs:    int32(test.Duration.Milliseconds()),
+			Status:        mapStatus(test.Status),
+			Message:       message(test),
+			StartTime:     execution.StartTime,
+		})
+	}
+
+	for _, nested := range suite.Suites {
+		testCases = append(testCases, parseSuite(execution, nested)...)
+	}
+
+	return testCases
+}
+
+func mapStatus(status junit.Status) string {
+	switch status {
+	case junit.StatusFailed:
+		return testkube.FAILED_TestCaseStatus
+	case junit.StatusError:
+		return testkube.ERROR_TestCaseStatus
+	case junit.StatusSkipped:
+		return testkube.SKIPPED_TestCaseStatus
+	default:
+		return testkube.PASSED_TestCaseStatus
+	}
+}
+
+// message returns message attribute of the result, or the first line of the failure body when it's missing
+func message(test junit.Test) string {
+	if test.Message != "" || test.Error == nil {
+		return test.Message
+	}
+
+	body, _, _ := strings.Cut(strings.TrimSpace(test.Error.Error()), "\n")
+	return body
+}
@@ -0,0 +1,98 @@
+package junitreport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const loginReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="Login" tests="3">
+    <testcase name="should_accept_valid_password" classname="LoginSpec" time="0.25"/>
+    <testcase name="should_reject_bad_password" classname="LoginSpec" time="1.5">
+      <failure message="expected 401" type="AssertionError">expected 401 but got 200</failure>
+    </testcase>
+    <testcase name="should_lock_account" classname="LoginSpec">
+      <skipped/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+const logoutReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="Logout" tests="1">
+  <testcase name="should_clear_session" classname="LogoutSpec" time="0.1">
+    <error type="TypeError">session is undefined
+    at logout.js:10</error>
+  </testcase>
+</testsuite>`
+
+func TestReadDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "nested"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "login.xml"), []byte(loginReport), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "nested", "logout.xml"), []byte(logoutReport), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "output.txt"), []byte("ignored"), 0644))
+
+	report, err := ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Contains(t, report, "should_reject_bad_password")
+	assert.Contains(t, report, "should_clear_session")
+	assert.NotContains(t, report, "ignored")
+
+	report, err = ReadDir(filepath.Join(dir, "missing"))
+	assert.NoError(t, err)
+	assert.Empty(t, report)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	execution := testkube.Execution{
+		Id:        "execution-1",
+		Name:      "login-1",
+		TestName:  "login",
+		StartTime: startTime,
+		ExecutionResult: &testkube.ExecutionResult{
+			Reports: &testkube.ExecutionResultReports{Junit: loginReport + "\n" + logoutReport},
+		},
+	}
+
+	testCases, err := Parse(execution)
+
+	assert.NoError(t, err)
+	assert.Len(t, testCases, 4)
+	assert.Equal(t, testkube.TestCaseResult{
+		ExecutionId:   "execution-1",
+		ExecutionName: "login-1",
+		TestName:      "login",
+		Suite:         "Login",
+		Name:          "should_reject_bad_password",
+		Classname:     "LoginSpec",
+		DurationMs:    1500,
+		Status:        testkube.FAILED_TestCaseStatus,
+		Message:       "expected 401",
+		StartTime:     startTime,
+	}, testCases[1])
+	assert.Equal(t, testkube.PASSED_TestCaseStatus, testCases[0].Status)
+	assert.Equal(t, testkube.SKIPPED_TestCaseStatus, testCases[2].Status)
+	assert.Equal(t, testkube.ERROR_TestCaseStatus, testCases[3].Status)
+	assert.Equal(t, "session is undefined", testCases[3].Message)
+}
+
+func TestParse_NoReport(t *testing.T) {
+	t.Parallel()
+
+	testCases, err := Parse(testkube.Execution{ExecutionResult: &testkube.ExecutionResult{}})
+
+	assert.NoError(t, err)
+	assert.Empty(t, testCases)
+}
@@ -1,6 +1,9 @@
 package triggers
 
-import "github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
+import (
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
 
 type KeyMap struct {
 	Resources           []string            `json:"resources"`
@@ -14,7 +17,7 @@ type KeyMap struct {
 func NewKeyMap() *KeyMap {
 	return &KeyMap{
 		Resources:           testtrigger.GetSupportedResources(),
-		Actions:             testtrigger.GetSupportedActions(),
+		Actions:             getSupportedActions(),
 		Executions:          testtrigger.GetSupportedExecutions(),
 		Events:              getSupportedEvents(),
 		Conditions:          testtrigger.GetSupportedConditions(),
@@ -22,6 +25,16 @@ func NewKeyMap() *KeyMap {
 	}
 }
 
+// getSupportedActions returns actions supported by the TestTrigger CRD and actions stored in TestTrigger annotations
+func getSupportedActions() []string {
+	return append(
+		testtrigger.GetSupportedActions(),
+		string(testkube.ABORT_TestTriggerActions),
+		string(testkube.NOTIFY_TestTriggerActions),
+		string(testkube.WEBHOOK_TestTriggerActions),
+	)
+}
+
 func getSupportedEvents() map[string][]string {
 	m := make(map[string][]string, len(testtrigger.GetSupportedResources()))
 	m[testtrigger.ResourcePod] = []string{string(testtrigger.EventCreated), string(testtrigger.EventModified), string(testtrigger.EventDeleted)}
@@ -0,0 +1,67 @@
+package customresources
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+)
+
+// MapCRDConditionsToAPI maps generic status conditions of custom resource to OpenAPI spec TestTriggerConditions,
+// conditions without type or status are skipped
+func MapCRDConditionsToAPI(object *unstructured.Unstructured, currentTime time.Time) []testtriggersv1.TestTriggerCondition {
+	conditions, _, err := unstructured.NestedSlice(object.Object, "status", "conditions")
+	if err != nil {
+		return nil
+	}
+
+	var results []testtriggersv1.TestTriggerCondition
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		if conditionType == "" || status == "" {
+			continue
+		}
+
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		latestTime := getTime(condition, "lastTransitionTime")
+		if lastUpdateTime := getTime(condition, "lastUpdateTime"); lastUpdateTime.After(latestTime) {
+			latestTime = lastUpdateTime
+		}
+
+		var ttl int32
+		if !latestTime.IsZero() {
+			ttl = int32(currentTime.Sub(latestTime) / time.Second)
+		}
+
+		conditionStatus := testtriggersv1.TestTriggerConditionStatuses(status)
+		results = append(results, testtriggersv1.TestTriggerCondition{
+			Type_:  conditionType,
+			Status: &conditionStatus,
+			Reason: reason,
+			Ttl:    ttl,
+		})
+	}
+
+	return results
+}
+
+func getTime(condition map[string]interface{}, field string) time.Time {
+	value, _, _ := unstructured.NestedString(condition, field)
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
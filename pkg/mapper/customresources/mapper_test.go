@@ -0,0 +1,47 @@
+package customresources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+)
+
+func TestMapCRDConditionsToAPI(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Date(2023, 10, 20, 12, 0, 0, 0, time.UTC)
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":               "Available",
+					"status":             "True",
+					"reason":             "RolloutCompleted",
+					"lastTransitionTime": "2023-10-20T11:59:00Z",
+					"lastUpdateTime":     "2023-10-20T11:59:30Z",
+				},
+				map[string]interface{}{
+					"type":   "Progressing",
+					"status": "False",
+				},
+				map[string]interface{}{
+					"reason": "Missing type and status",
+				},
+				"invalid",
+			},
+		},
+	}}
+
+	trueStatus := testtriggersv1.TRUE_TestTriggerConditionStatuses
+	falseStatus := testtriggersv1.FALSE_TestTriggerConditionStatuses
+	assert.Equal(t, []testtriggersv1.TestTriggerCondition{
+		{Type_: "Available", Status: &trueStatus, Reason: "RolloutCompleted", Ttl: 30},
+		{Type_: "Progressing", Status: &falseStatus},
+	}, MapCRDConditionsToAPI(object, currentTime))
+
+	assert.Nil(t, MapCRDConditionsToAPI(&unstructured.Unstructured{Object: map[string]interface{}{}}, currentTime))
+}
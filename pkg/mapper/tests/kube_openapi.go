@@ -32,6 +32,13 @@ func MapTestCRToAPI(crTest testsv3.Test) (test testkube.Test) {
 	test.ExecutionRequest = MapExecutionRequestFromSpec(crTest.Spec.ExecutionRequest)
 	test.Uploads = crTest.Spec.Uploads
 	test.Status = MapStatusFromSpec(crTest.Status)
+	if shards := getShards(crTest); shards != 0 {
+		if test.ExecutionRequest == nil {
+			test.ExecutionRequest = &testkube.ExecutionRequest{}
+		}
+
+		test.ExecutionRequest.Shards = shards
+	}
 	return
 }
 
@@ -263,6 +270,15 @@ func MapSpecToUpdate(test *testsv3.Test) (request testkube.TestUpdateRequest) {
 		request.ExecutionRequest = &executionRequest
 	}
 
+	if shards := getShards(*test); shards != 0 {
+		if request.ExecutionRequest == nil {
+			executionRequest := &testkube.ExecutionUpdateRequest{}
+			request.ExecutionRequest = &executionRequest
+		}
+
+		(*request.ExecutionRequest).Shards = &shards
+	}
+
 	request.Labels = &test.Labels
 
 	request.Uploads = &test.Spec.Uploads
@@ -29,6 +29,10 @@ func MapUpsertToSpec(request testkube.TestUpsertRequest) *testsv3.Test {
 		},
 	}
 
+	if request.ExecutionRequest != nil {
+		setShards(test, request.ExecutionRequest.Shards)
+	}
+
 	return test
 
 }
@@ -253,6 +257,9 @@ func MapUpdateToSpec(request testkube.TestUpdateRequest, test *testsv3.Test) *te
 
 	if request.ExecutionRequest != nil {
 		test.Spec.ExecutionRequest = MapExecutionUpdateRequestToSpecExecutionRequest(*request.ExecutionRequest, test.Spec.ExecutionRequest)
+		if *request.ExecutionRequest != nil && (*request.ExecutionRequest).Shards != nil {
+			setShards(test, *(*request.ExecutionRequest).Shards)
+		}
 	}
 
 	if request.Labels != nil {
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"strconv"
+
+	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
+)
+
+// ShardsAnnotation is a Test annotation keeping the number of shards
+const ShardsAnnotation = "tests.testkube.io/shards"
+
+// getShards returns the number of shards stored in Test annotation, 0 when it's missing or invalid
+func getShards(cr testsv3.Test) int32 {
+	value, ok := cr.Annotations[ShardsAnnotation]
+	if !ok {
+		return 0
+	}
+
+	shards, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || shards < 0 {
+		return 0
+	}
+
+	return int32(shards)
+}
+
+// setShards stores the number of shards in Test annotation, annotation is removed when test isn't sharded
+func setShards(cr *testsv3.Test, shards int32) {
+	if shards <= 1 {
+		delete(cr.Annotations, ShardsAnnotation)
+		return
+	}
+
+	if cr.Annotations == nil {
+		cr.Annotations = map[string]string{}
+	}
+
+	cr.Annotations[ShardsAnnotation] = strconv.Itoa(int(shards))
+}
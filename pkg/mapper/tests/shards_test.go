@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestShards(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps shards in test annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := MapUpsertToSpec(testkube.TestUpsertRequest{
+			Name:             "e2e",
+			ExecutionRequest: &testkube.ExecutionRequest{Shards: 4},
+		})
+
+		assert.Equal(t, "4", cr.Annotations[ShardsAnnotation])
+		assert.Equal(t, int32(4), MapTestCRToAPI(*cr).ExecutionRequest.Shards)
+	})
+
+	t.Run("updates shards in test annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := MapUpsertToSpec(testkube.TestUpsertRequest{
+			Name:             "e2e",
+			ExecutionRequest: &testkube.ExecutionRequest{Shards: 4},
+		})
+		shards := int32(1)
+		executionRequest := &testkube.ExecutionUpdateRequest{Shards: &shards}
+		cr = MapUpdateToSpec(testkube.TestUpdateRequest{ExecutionRequest: &executionRequest}, cr)
+
+		assert.NotContains(t, cr.Annotations, ShardsAnnotation)
+	})
+
+	t.Run("ignores invalid annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := MapUpsertToSpec(testkube.TestUpsertRequest{Name: "e2e"})
+		cr.Annotations = map[string]string{ShardsAnnotation: "many"}
+
+		assert.Nil(t, MapTestCRToAPI(*cr).ExecutionRequest)
+	})
+}
@@ -54,6 +54,11 @@ func MapCRToAPI(cr testsuitesv3.TestSuite) (test testkube.TestSuite) {
 		}
 	}
 
+	options := getStepOptions(cr)
+	applyBatchOptions(test.Before, options.Before)
+	applyBatchOptions(test.Steps, options.Steps)
+	applyBatchOptions(test.After, options.After)
+
 	test.Description = cr.Spec.Description
 	test.Repeats = int32(cr.Spec.Repeats)
 	test.Labels = cr.Labels
@@ -228,13 +233,17 @@ func MapTestSuiteTestCRDToUpdateRequest(testSuite *testsuitesv3.TestSuite) (requ
 		*field.destination = field.source
 	}
 
+	options := getStepOptions(*testSuite)
 	before := mapCRDToTestBatchSteps(testSuite.Spec.Before)
+	applyBatchOptions(before, options.Before)
 	request.Before = &before
 
 	steps := mapCRDToTestBatchSteps(testSuite.Spec.Steps)
+	applyBatchOptions(steps, options.Steps)
 	request.Steps = &steps
 
 	after := mapCRDToTestBatchSteps(testSuite.Spec.After)
+	applyBatchOptions(after, options.After)
 	request.After = &after
 
 	request.Labels = &testSuite.Labels
@@ -7,6 +7,7 @@ import (
 
 	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/stepcondition"
 	"github.com/kubeshop/testkube/pkg/types"
 )
 
@@ -137,7 +138,7 @@ func MapTestSuiteUpsertRequestToTestCRD(request testkube.TestSuiteUpsertRequest)
 		return testsuite, err
 	}
 
-	return testsuitesv3.TestSuite{
+	testsuite = testsuitesv3.TestSuite{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      request.Name,
 			Namespace: request.Namespace,
@@ -152,11 +153,23 @@ func MapTestSuiteUpsertRequestToTestCRD(request testkube.TestSuiteUpsertRequest)
 			Schedule:         request.Schedule,
 			ExecutionRequest: MapExecutionRequestToSpecExecutionRequest(request.ExecutionRequest),
 		},
-	}, nil
+	}
+
+	err = setStepOptions(&testsuite, testSuiteStepOptions{
+		Before: mapBatchOptionsFromAPI(request.Before),
+		Steps:  mapBatchOptionsFromAPI(request.Steps),
+		After:  mapBatchOptionsFromAPI(request.After),
+	})
+
+	return testsuite, err
 }
 
 func mapTestBatchStepsToCRD(batches []testkube.TestSuiteBatchStep) (out []testsuitesv3.TestSuiteBatchStep, err error) {
 	for _, batch := range batches {
+		if err = stepcondition.Validate(batch.Condition); err != nil {
+			return nil, err
+		}
+
 		steps := make([]testsuitesv3.TestSuiteStepSpec, len(batch.Execute))
 		for i := range batch.Execute {
 			steps[i], err = mapTestStepToCRD(batch.Execute[i])
@@ -175,6 +188,16 @@ func mapTestBatchStepsToCRD(batches []testkube.TestSuiteBatchStep) (out []testsu
 }
 
 func mapTestStepToCRD(step testkube.TestSuiteStep) (stepSpec testsuitesv3.TestSuiteStepSpec, err error) {
+	if err = stepcondition.Validate(step.Condition); err != nil {
+		return stepSpec, err
+	}
+
+	if step.RetryPolicy != nil {
+		if err = step.RetryPolicy.Validate(); err != nil {
+			return stepSpec, err
+		}
+	}
+
 	switch step.Type() {
 
 	case testkube.TestSuiteStepTypeDelay:
@@ -253,11 +276,14 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 	}
 
 	var err error
+	options := getStepOptions(*testSuite)
 	if request.Before != nil {
 		testSuite.Spec.Before, err = mapTestBatchStepsToCRD(*request.Before)
 		if err != nil {
 			return nil, err
 		}
+
+		options.Before = mapBatchOptionsFromAPI(*request.Before)
 	}
 
 	if request.Steps != nil {
@@ -265,6 +291,8 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 		if err != nil {
 			return nil, err
 		}
+
+		options.Steps = mapBatchOptionsFromAPI(*request.Steps)
 	}
 
 	if request.After != nil {
@@ -272,6 +300,12 @@ func MapTestSuiteUpdateRequestToTestCRD(request testkube.TestSuiteUpdateRequest,
 		if err != nil {
 			return nil, err
 		}
+
+		options.After = mapBatchOptionsFromAPI(*request.After)
+	}
+
+	if err = setStepOptions(testSuite, options); err != nil {
+		return nil, err
 	}
 
 	if request.Labels != nil {
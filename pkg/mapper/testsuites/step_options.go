@@ -0,0 +1,131 @@
+package testsuites
+
+import (
+	"encoding/json"
+
+	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// StepOptionsAnnotation is a TestSuite annotation keeping options of test suite steps
+const StepOptionsAnnotation = "testsuites.testkube.io/step-options"
+
+// stepOptions are options of a single step
+type stepOptions struct {
+	Name        string                `json:"name,omitempty"`
+	DependsOn   []string              `json:"dependsOn,omitempty"`
+	Condition   string                `json:"condition,omitempty"`
+	RetryPolicy *testkube.RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// batchOptions are options of a batch and its steps
+type batchOptions struct {
+	Condition string        `json:"condition,omitempty"`
+	Execute   []stepOptions `json:"execute,omitempty"`
+}
+
+// testSuiteStepOptions are options of all test suite batches, indexed the same way as CRD batches
+type testSuiteStepOptions struct {
+	Before []batchOptions `json:"before,omitempty"`
+	Steps  []batchOptions `json:"steps,omitempty"`
+	After  []batchOptions `json:"after,omitempty"`
+}
+
+func (o stepOptions) isEmpty() bool {
+	return o.Name == "" && len(o.DependsOn) == 0 && o.Condition == "" && o.RetryPolicy == nil
+}
+
+func (o batchOptions) isEmpty() bool {
+	for _, step := range o.Execute {
+		if !step.isEmpty() {
+			return false
+		}
+	}
+
+	return o.Condition == ""
+}
+
+func (o testSuiteStepOptions) isEmpty() bool {
+	for _, batches := range [][]batchOptions{o.Before, o.Steps, o.After} {
+		for _, batch := range batches {
+			if !batch.isEmpty() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// getStepOptions returns step options stored in TestSuite annotation, no options when it's missing or invalid
+func getStepOptions(cr testsuitesv3.TestSuite) (options testSuiteStepOptions) {
+	if value, ok := cr.Annotations[StepOptionsAnnotation]; ok {
+		_ = json.Unmarshal([]byte(value), &options)
+	}
+
+	return options
+}
+
+// setStepOptions stores step options in TestSuite annotation, annotation is removed when there are no options
+func setStepOptions(cr *testsuitesv3.TestSuite, options testSuiteStepOptions) error {
+	if options.isEmpty() {
+		delete(cr.Annotations, StepOptionsAnnotation)
+		return nil
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	if cr.Annotations == nil {
+		cr.Annotations = map[string]string{}
+	}
+
+	cr.Annotations[StepOptionsAnnotation] = string(data)
+	return nil
+}
+
+// mapBatchOptionsFromAPI extracts options of OpenAPI spec batches
+func mapBatchOptionsFromAPI(batches []testkube.TestSuiteBatchStep) (options []batchOptions) {
+	for _, batch := range batches {
+		option := batchOptions{
+			Condition: batch.Condition,
+			Execute:   make([]stepOptions, len(batch.Execute)),
+		}
+
+		for i, step := range batch.Execute {
+			option.Execute[i] = stepOptions{
+				Name:        step.Name,
+				DependsOn:   step.DependsOn,
+				Condition:   step.Condition,
+				RetryPolicy: step.RetryPolicy,
+			}
+		}
+
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// applyBatchOptions sets options on OpenAPI spec batches
+func applyBatchOptions(batches []testkube.TestSuiteBatchStep, options []batchOptions) {
+	for i := range batches {
+		if i >= len(options) {
+			return
+		}
+
+		batches[i].Condition = options[i].Condition
+		for j := range batches[i].Execute {
+			if j >= len(options[i].Execute) {
+				break
+			}
+
+			batches[i].Execute[j].Name = options[i].Execute[j].Name
+			batches[i].Execute[j].DependsOn = options[i].Execute[j].DependsOn
+			batches[i].Execute[j].Condition = options[i].Execute[j].Condition
+			batches[i].Execute[j].RetryPolicy = options[i].Execute[j].RetryPolicy
+		}
+	}
+}
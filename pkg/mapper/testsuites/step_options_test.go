@@ -0,0 +1,84 @@
+package testsuites
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestStepOptions(t *testing.T) {
+	t.Parallel()
+
+	request := testkube.TestSuiteUpsertRequest{
+		Name: "suite",
+		Steps: []testkube.TestSuiteBatchStep{
+			{Execute: []testkube.TestSuiteStep{{Test: "api"}, {Test: "ui", RetryPolicy: &testkube.RetryPolicy{MaxAttempts: 3, Backoff: "10s"}}}},
+			{Condition: "onFailure", Execute: []testkube.TestSuiteStep{{Test: "diagnostics", Condition: `steps["api"] == "failed"`}}},
+			{Execute: []testkube.TestSuiteStep{{Name: "wait", Delay: "1s", DependsOn: []string{"api", "ui"}}}},
+		},
+		After: []testkube.TestSuiteBatchStep{
+			{Condition: "always", Execute: []testkube.TestSuiteStep{{Test: "cleanup"}}},
+		},
+	}
+
+	t.Run("conditions are kept in annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := MapTestSuiteUpsertRequestToTestCRD(request)
+		assert.NoError(t, err)
+		assert.Contains(t, cr.Annotations, StepOptionsAnnotation)
+
+		testSuite := MapCRToAPI(cr)
+		assert.Equal(t, request.Steps, testSuite.Steps)
+		assert.Equal(t, request.After, testSuite.After)
+	})
+
+	t.Run("update replaces only given batches", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := MapTestSuiteUpsertRequestToTestCRD(request)
+		assert.NoError(t, err)
+
+		steps := []testkube.TestSuiteBatchStep{{Execute: []testkube.TestSuiteStep{{Test: "api"}}}}
+		updated, err := MapTestSuiteUpdateRequestToTestCRD(testkube.TestSuiteUpdateRequest{Steps: &steps}, &cr)
+		assert.NoError(t, err)
+
+		testSuite := MapCRToAPI(*updated)
+		assert.Equal(t, steps, testSuite.Steps)
+		assert.Equal(t, request.After, testSuite.After)
+	})
+
+	t.Run("annotation is removed without conditions", func(t *testing.T) {
+		t.Parallel()
+
+		cr, err := MapTestSuiteUpsertRequestToTestCRD(request)
+		assert.NoError(t, err)
+
+		var empty []testkube.TestSuiteBatchStep
+		updated, err := MapTestSuiteUpdateRequestToTestCRD(testkube.TestSuiteUpdateRequest{Steps: &empty, After: &empty}, &cr)
+		assert.NoError(t, err)
+		assert.NotContains(t, updated.Annotations, StepOptionsAnnotation)
+	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := MapTestSuiteUpsertRequestToTestCRD(testkube.TestSuiteUpsertRequest{
+			Name:  "suite",
+			Steps: []testkube.TestSuiteBatchStep{{Condition: `steps[`, Execute: []testkube.TestSuiteStep{{Test: "api"}}}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid retry policy", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := MapTestSuiteUpsertRequestToTestCRD(testkube.TestSuiteUpsertRequest{
+			Name:  "suite",
+			Steps: []testkube.TestSuiteBatchStep{{Execute: []testkube.TestSuiteStep{{Test: "api", RetryPolicy: &testkube.RetryPolicy{Backoff: "soon"}}}}},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,47 @@
+package testtriggers
+
+import (
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// ActionAnnotation is a TestTrigger annotation keeping actions which are not supported by the TestTrigger CRD,
+	// spec action is ignored when the annotation is set
+	ActionAnnotation = "testtriggers.testkube.io/action"
+	// WebhookURLAnnotation is a TestTrigger annotation keeping url called by the webhook action
+	WebhookURLAnnotation = "testtriggers.testkube.io/webhook-url"
+	// actionPlaceholder is stored in spec action required by the TestTrigger CRD
+	actionPlaceholder = testsv1.TestTriggerActionRun
+)
+
+// GetAction returns test trigger action, actions not supported by the TestTrigger CRD are read from annotation
+func GetAction(cr *testsv1.TestTrigger) testkube.TestTriggerActions {
+	if action, ok := cr.Annotations[ActionAnnotation]; ok {
+		return testkube.TestTriggerActions(action)
+	}
+
+	return testkube.TestTriggerActions(cr.Spec.Action)
+}
+
+// SetAction stores test trigger action, actions not supported by the TestTrigger CRD are kept in annotation
+func SetAction(cr *testsv1.TestTrigger, action testkube.TestTriggerActions) {
+	if !action.IsAnnotated() {
+		delete(cr.Annotations, ActionAnnotation)
+		cr.Spec.Action = testsv1.TestTriggerAction(action)
+		return
+	}
+
+	setAnnotation(cr, ActionAnnotation, string(action))
+	cr.Spec.Action = actionPlaceholder
+}
+
+// GetWebhookURL returns url called by the webhook action stored in TestTrigger annotation
+func GetWebhookURL(cr *testsv1.TestTrigger) string {
+	return cr.Annotations[WebhookURLAnnotation]
+}
+
+// SetWebhookURL stores url called by the webhook action in TestTrigger annotation
+func SetWebhookURL(cr *testsv1.TestTrigger, url string) {
+	setAnnotation(cr, WebhookURLAnnotation, url)
+}
@@ -0,0 +1,44 @@
+package testtriggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestAction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps run action in test trigger spec", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		cr.Annotations = map[string]string{ActionAnnotation: "abort"}
+		SetAction(cr, testkube.RUN_TestTriggerActions)
+
+		assert.Equal(t, testsv1.TestTriggerActionRun, cr.Spec.Action)
+		assert.Empty(t, cr.Annotations)
+		assert.Equal(t, testkube.RUN_TestTriggerActions, GetAction(cr))
+	})
+
+	t.Run("keeps other actions in test trigger annotations", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		SetAction(cr, testkube.WEBHOOK_TestTriggerActions)
+		SetWebhookURL(cr, "https://example.com/hook")
+
+		assert.Equal(t, testsv1.TestTriggerActionRun, cr.Spec.Action)
+		assert.Equal(t, map[string]string{
+			ActionAnnotation:     "webhook",
+			WebhookURLAnnotation: "https://example.com/hook",
+		}, cr.Annotations)
+
+		trigger := MapCRDToAPI(cr)
+		assert.Equal(t, testkube.WEBHOOK_TestTriggerActions, *trigger.Action)
+		assert.Equal(t, "https://example.com/hook", trigger.WebhookUrl)
+	})
+}
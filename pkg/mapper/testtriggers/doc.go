@@ -0,0 +1,12 @@
+// Package testtriggers maps TestTrigger CRDs to OpenAPI spec and back.
+//
+// TestTrigger fields added by the API server, which are not part of the TestTrigger CRD of the operator,
+// are kept in annotations of the CRD: action, webhook url, expression, throttling and resource kind.
+// The annotations are set together with the spec on create and update, so they're stored and versioned
+// as the rest of the test trigger. Spec fields required by the CRD are set to a placeholder when an annotation
+// replaces them. Test and TestSuite mappers keep shards and step options the same way.
+//
+// Annotations are read leniently, like unknown CRD fields: missing or invalid values are ignored, except
+// for resource kind, which decides what the test trigger watches, so the test trigger service skips test
+// triggers with an invalid resource kind and logs the error.
+package testtriggers
@@ -0,0 +1,18 @@
+package testtriggers
+
+import (
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+)
+
+// ExpressionAnnotation is a TestTrigger annotation keeping expression matched against the watched resource
+const ExpressionAnnotation = "testtriggers.testkube.io/expression"
+
+// GetExpression returns expression stored in TestTrigger annotation
+func GetExpression(cr *testsv1.TestTrigger) string {
+	return cr.Annotations[ExpressionAnnotation]
+}
+
+// SetExpression stores expression in TestTrigger annotation, annotation is removed for empty expression
+func SetExpression(cr *testsv1.TestTrigger, expression string) {
+	setAnnotation(cr, ExpressionAnnotation, expression)
+}
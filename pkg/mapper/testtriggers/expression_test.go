@@ -0,0 +1,47 @@
+package testtriggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestExpression(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps expression in test trigger annotation", func(t *testing.T) {
+		t.Parallel()
+
+		resource := testkube.CONFIGMAP_TestTriggerResources
+		action := testkube.RUN_TestTriggerActions
+		execution := testkube.TEST_TestTriggerExecutions
+		expression := `object.data["feature.flags"] != oldObject.data["feature.flags"]`
+		cr := MapTestTriggerUpsertRequestToTestTriggerCRD(testkube.TestTriggerUpsertRequest{
+			Name:             "features",
+			Resource:         &resource,
+			ResourceSelector: &testkube.TestTriggerSelector{},
+			Event:            "modified",
+			Expression:       expression,
+			Action:           &action,
+			Execution:        &execution,
+			TestSelector:     &testkube.TestTriggerSelector{},
+		})
+
+		assert.Equal(t, expression, cr.Annotations[ExpressionAnnotation])
+		assert.Equal(t, expression, MapCRDToAPI(&cr).Expression)
+		assert.Equal(t, expression, MapTestTriggerCRDToTestTriggerUpsertRequest(cr).Expression)
+	})
+
+	t.Run("removes expression from test trigger annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		cr.Annotations = map[string]string{ExpressionAnnotation: `event == "modified"`}
+		SetExpression(cr, "")
+
+		assert.NotContains(t, cr.Annotations, ExpressionAnnotation)
+	})
+}
@@ -20,23 +20,29 @@ func MapTestTriggerListKubeToAPI(crd *testsv1.TestTriggerList) (testTriggers []t
 // MapCRDToAPI maps TestTrigger CRD to OpenAPI spec TestTrigger
 func MapCRDToAPI(crd *testsv1.TestTrigger) testkube.TestTrigger {
 	resource := testkube.TestTriggerResources(crd.Spec.Resource)
-	action := testkube.TestTriggerActions(crd.Spec.Action)
+	action := GetAction(crd)
 	execution := testkube.TestTriggerExecutions(crd.Spec.Execution)
 	concurrencyPolicy := testkube.TestTriggerConcurrencyPolicies(crd.Spec.ConcurrencyPolicy)
+	// invalid resource kind is reported by the test trigger service, which skips the test trigger
+	resourceKind, _ := GetResourceKind(crd)
 
 	return testkube.TestTrigger{
 		Name:              crd.Name,
 		Namespace:         crd.Namespace,
 		Labels:            crd.Labels,
 		Resource:          &resource,
+		ResourceKind:      resourceKind,
 		ResourceSelector:  mapSelectorFromCRD(crd.Spec.ResourceSelector),
 		Event:             string(crd.Spec.Event),
+		Expression:        GetExpression(crd),
 		ConditionSpec:     mapConditionSpecFromCRD(crd.Spec.ConditionSpec),
 		ProbeSpec:         mapProbeSpecFromCRD(crd.Spec.ProbeSpec),
 		Action:            &action,
+		WebhookUrl:        GetWebhookURL(crd),
 		Execution:         &execution,
 		TestSelector:      mapSelectorFromCRD(crd.Spec.TestSelector),
 		ConcurrencyPolicy: &concurrencyPolicy,
+		Throttling:        GetThrottling(crd),
 	}
 }
 
@@ -93,19 +99,26 @@ func mapConditionSpecFromCRD(conditionSpec *testsv1.TestTriggerConditionSpec) *t
 }
 
 func MapTestTriggerCRDToTestTriggerUpsertRequest(request testsv1.TestTrigger) testkube.TestTriggerUpsertRequest {
+	action := GetAction(&request)
+	// invalid resource kind is reported by the test trigger service, which skips the test trigger
+	resourceKind, _ := GetResourceKind(&request)
 	return testkube.TestTriggerUpsertRequest{
 		Name:              request.Name,
 		Namespace:         request.Namespace,
 		Labels:            request.Labels,
 		Resource:          (*testkube.TestTriggerResources)(&request.Spec.Resource),
+		ResourceKind:      resourceKind,
 		ResourceSelector:  mapSelectorFromCRD(request.Spec.ResourceSelector),
 		Event:             string(request.Spec.Event),
+		Expression:        GetExpression(&request),
 		ConditionSpec:     mapConditionSpecFromCRD(request.Spec.ConditionSpec),
 		ProbeSpec:         mapProbeSpecFromCRD(request.Spec.ProbeSpec),
-		Action:            (*testkube.TestTriggerActions)(&request.Spec.Action),
+		Action:            &action,
+		WebhookUrl:        GetWebhookURL(&request),
 		Execution:         (*testkube.TestTriggerExecutions)(&request.Spec.Execution),
 		TestSelector:      mapSelectorFromCRD(request.Spec.TestSelector),
 		ConcurrencyPolicy: (*testkube.TestTriggerConcurrencyPolicies)(&request.Spec.ConcurrencyPolicy),
+		Throttling:        GetThrottling(&request),
 	}
 }
 
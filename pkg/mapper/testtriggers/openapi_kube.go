@@ -13,24 +13,35 @@ func MapTestTriggerUpsertRequestToTestTriggerCRD(request testkube.TestTriggerUps
 		concurrencyPolicy = testsv1.TestTriggerConcurrencyPolicy(*request.ConcurrencyPolicy)
 	}
 
-	return testsv1.TestTrigger{
+	var resource testsv1.TestTriggerResource
+	if request.Resource != nil {
+		resource = testsv1.TestTriggerResource(*request.Resource)
+	}
+
+	testTrigger := testsv1.TestTrigger{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      request.Name,
 			Namespace: request.Namespace,
 			Labels:    request.Labels,
 		},
 		Spec: testsv1.TestTriggerSpec{
-			Resource:          testsv1.TestTriggerResource(*request.Resource),
+			Resource:          resource,
 			ResourceSelector:  mapSelectorToCRD(request.ResourceSelector),
 			Event:             testsv1.TestTriggerEvent(request.Event),
 			ConditionSpec:     mapConditionSpecCRD(request.ConditionSpec),
 			ProbeSpec:         mapProbeSpecCRD(request.ProbeSpec),
-			Action:            testsv1.TestTriggerAction(*request.Action),
 			Execution:         testsv1.TestTriggerExecution(*request.Execution),
 			TestSelector:      mapSelectorToCRD(request.TestSelector),
 			ConcurrencyPolicy: concurrencyPolicy,
 		},
 	}
+	SetResourceKind(&testTrigger, request.ResourceKind)
+	SetAction(&testTrigger, *request.Action)
+	SetWebhookURL(&testTrigger, request.WebhookUrl)
+	SetExpression(&testTrigger, request.Expression)
+	SetThrottling(&testTrigger, request.Throttling)
+
+	return testTrigger
 }
 
 func mapSelectorToCRD(selector *testkube.TestTriggerSelector) testsv1.TestTriggerSelector {
@@ -0,0 +1,48 @@
+package testtriggers
+
+import (
+	"fmt"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// ResourceKindAnnotation is a TestTrigger annotation keeping group/version/kind of the watched resource,
+	// spec resource is ignored when the annotation is set
+	ResourceKindAnnotation = "testtriggers.testkube.io/resource-kind"
+	// resourceKindPlaceholder is stored in spec resource required by the TestTrigger CRD
+	resourceKindPlaceholder = testsv1.TestTriggerResource("pod")
+)
+
+// GetResourceKind returns resource kind stored in TestTrigger annotation, nil for built-in resources
+func GetResourceKind(cr *testsv1.TestTrigger) (*testkube.TestTriggerResourceKind, error) {
+	value, ok := cr.Annotations[ResourceKindAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	kind, err := testkube.ParseTestTriggerResourceKind(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", ResourceKindAnnotation, err)
+	}
+
+	return kind, nil
+}
+
+// SetResourceKind stores resource kind in TestTrigger annotation, annotation is removed for built-in resources
+func SetResourceKind(cr *testsv1.TestTrigger, kind *testkube.TestTriggerResourceKind) {
+	if kind == nil {
+		delete(cr.Annotations, ResourceKindAnnotation)
+		return
+	}
+
+	if cr.Annotations == nil {
+		cr.Annotations = map[string]string{}
+	}
+
+	cr.Annotations[ResourceKindAnnotation] = kind.String()
+	if cr.Spec.Resource == "" {
+		cr.Spec.Resource = resourceKindPlaceholder
+	}
+}
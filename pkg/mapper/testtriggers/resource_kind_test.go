@@ -0,0 +1,60 @@
+package testtriggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestResourceKind(t *testing.T) {
+	t.Parallel()
+
+	action := testkube.RUN_TestTriggerActions
+	execution := testkube.TEST_TestTriggerExecutions
+
+	t.Run("keeps resource kind in test trigger annotation", func(t *testing.T) {
+		t.Parallel()
+
+		kind := &testkube.TestTriggerResourceKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+		cr := MapTestTriggerUpsertRequestToTestTriggerCRD(testkube.TestTriggerUpsertRequest{
+			Name:             "rollout",
+			ResourceKind:     kind,
+			ResourceSelector: &testkube.TestTriggerSelector{},
+			Event:            "modified",
+			Action:           &action,
+			Execution:        &execution,
+			TestSelector:     &testkube.TestTriggerSelector{},
+		})
+
+		assert.Equal(t, "argoproj.io/v1alpha1/Rollout", cr.Annotations[ResourceKindAnnotation])
+		assert.Equal(t, resourceKindPlaceholder, cr.Spec.Resource)
+		assert.Equal(t, kind, MapCRDToAPI(&cr).ResourceKind)
+	})
+
+	t.Run("removes resource kind from test trigger annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		cr.Annotations = map[string]string{ResourceKindAnnotation: "v1/Secret"}
+		SetResourceKind(cr, nil)
+
+		assert.NotContains(t, cr.Annotations, ResourceKindAnnotation)
+		kind, err := GetResourceKind(cr)
+		assert.NoError(t, err)
+		assert.Nil(t, kind)
+	})
+
+	t.Run("returns error for invalid annotation", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		cr.Annotations = map[string]string{ResourceKindAnnotation: "Secret"}
+
+		kind, err := GetResourceKind(cr)
+		assert.Error(t, err)
+		assert.Nil(t, kind)
+	})
+}
@@ -0,0 +1,60 @@
+package testtriggers
+
+import (
+	"strings"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const (
+	// DebounceAnnotation is a TestTrigger annotation keeping debounce duration
+	DebounceAnnotation = "testtriggers.testkube.io/debounce"
+	// CooldownAnnotation is a TestTrigger annotation keeping cooldown duration
+	CooldownAnnotation = "testtriggers.testkube.io/cooldown"
+	// DedupKeyAnnotation is a TestTrigger annotation keeping comma separated dedup key variables
+	DedupKeyAnnotation = "testtriggers.testkube.io/dedup-key"
+)
+
+// GetThrottling returns throttling stored in TestTrigger annotations, nil when the test trigger isn't throttled
+func GetThrottling(cr *testsv1.TestTrigger) *testkube.TestTriggerThrottling {
+	throttling := testkube.TestTriggerThrottling{
+		Debounce: cr.Annotations[DebounceAnnotation],
+		Cooldown: cr.Annotations[CooldownAnnotation],
+	}
+	for _, variable := range strings.Split(cr.Annotations[DedupKeyAnnotation], ",") {
+		if variable = strings.TrimSpace(variable); variable != "" {
+			throttling.DedupKey = append(throttling.DedupKey, variable)
+		}
+	}
+
+	if throttling.Debounce == "" && throttling.Cooldown == "" && len(throttling.DedupKey) == 0 {
+		return nil
+	}
+
+	return &throttling
+}
+
+// SetThrottling stores throttling in TestTrigger annotations, annotations are removed for unset fields
+func SetThrottling(cr *testsv1.TestTrigger, throttling *testkube.TestTriggerThrottling) {
+	if throttling == nil {
+		throttling = &testkube.TestTriggerThrottling{}
+	}
+
+	setAnnotation(cr, DebounceAnnotation, throttling.Debounce)
+	setAnnotation(cr, CooldownAnnotation, throttling.Cooldown)
+	setAnnotation(cr, DedupKeyAnnotation, strings.Join(throttling.DedupKey, ","))
+}
+
+func setAnnotation(cr *testsv1.TestTrigger, name, value string) {
+	if value == "" {
+		delete(cr.Annotations, name)
+		return
+	}
+
+	if cr.Annotations == nil {
+		cr.Annotations = map[string]string{}
+	}
+
+	cr.Annotations[name] = value
+}
@@ -0,0 +1,47 @@
+package testtriggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	testsv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestThrottling(t *testing.T) {
+	t.Parallel()
+
+	t.Run("keeps throttling in test trigger annotations", func(t *testing.T) {
+		t.Parallel()
+
+		throttling := &testkube.TestTriggerThrottling{
+			Debounce: "30s",
+			Cooldown: "5m",
+			DedupKey: []string{"TRIGGER_RESOURCE_NAME", "TRIGGER_IMAGE_TAG"},
+		}
+		cr := &testsv1.TestTrigger{}
+		SetThrottling(cr, throttling)
+
+		assert.Equal(t, map[string]string{
+			DebounceAnnotation: "30s",
+			CooldownAnnotation: "5m",
+			DedupKeyAnnotation: "TRIGGER_RESOURCE_NAME,TRIGGER_IMAGE_TAG",
+		}, cr.Annotations)
+		assert.Equal(t, throttling, GetThrottling(cr))
+		assert.Equal(t, throttling, MapCRDToAPI(cr).Throttling)
+	})
+
+	t.Run("removes throttling from test trigger annotations", func(t *testing.T) {
+		t.Parallel()
+
+		cr := &testsv1.TestTrigger{}
+		cr.Annotations = map[string]string{DebounceAnnotation: "30s", DedupKeyAnnotation: "TRIGGER_IMAGE_TAG", "other": "value"}
+		SetThrottling(cr, &testkube.TestTriggerThrottling{Cooldown: "1m"})
+
+		assert.Equal(t, map[string]string{CooldownAnnotation: "1m", "other": "value"}, cr.Annotations)
+
+		SetThrottling(cr, nil)
+		assert.Nil(t, GetThrottling(cr))
+	})
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor is returned for cursors not created by EncodeCursor
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points to the last execution of the page, executions are ordered by start time and id descending,
+// so the next page starts right after it even when new executions are added meanwhile
+type Cursor struct {
+	StartTime time.Time
+	Id        string
+}
+
+type cursorData struct {
+	StartTime int64  `json:"t"`
+	Id        string `json:"id"`
+}
+
+// EncodeCursor returns opaque cursor token pointing after the execution, start time is stored in milliseconds
+// as it's the precision of all the storages
+func EncodeCursor(startTime time.Time, id string) string {
+	data, _ := json.Marshal(cursorData{StartTime: ToUnixMilli(startTime), Id: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes cursor token created by EncodeCursor
+func DecodeCursor(token string) (cursor Cursor, err error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, ErrInvalidCursor
+	}
+
+	var value cursorData
+	if err = json.Unmarshal(data, &value); err != nil || value.Id == "" {
+		return cursor, ErrInvalidCursor
+	}
+
+	return Cursor{StartTime: FromUnixMilli(value.StartTime), Id: value.Id}, nil
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("round trip keeps milliseconds", func(t *testing.T) {
+		startTime := time.Date(2023, 5, 1, 10, 0, 0, 123456789, time.UTC)
+		cursor, err := DecodeCursor(EncodeCursor(startTime, "64a1"))
+		require.NoError(t, err)
+		assert.Equal(t, Cursor{StartTime: startTime.Truncate(time.Millisecond), Id: "64a1"}, cursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		for _, token := range []string{"", "not base64!", "bm90IGpzb24", "e30"} {
+			_, err := DecodeCursor(token)
+			assert.ErrorIs(t, err, ErrInvalidCursor, token)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"sort"
 	"time"
 
 	"github.com/montanaflynn/stats"
@@ -64,3 +65,62 @@ func calculate(durations []float64, quantile float64) float64 {
 	}
 	return percentile
 }
+
+// CalculateTrend splits executions into time buckets of given granularity, optionally grouped by the value of the label,
+// and calculates metrics of each bucket, buckets are sorted by time and group
+func CalculateTrend(executionsMetrics []testkube.ExecutionsMetricsExecutions, granularity testkube.MetricsGranularity,
+	groupBy string) []testkube.ExecutionsMetricsBucket {
+	type bucketKey struct {
+		startTime time.Time
+		group     string
+	}
+
+	var keys []bucketKey
+	buckets := make(map[bucketKey][]testkube.ExecutionsMetricsExecutions)
+	for _, execution := range executionsMetrics {
+		key := bucketKey{startTime: bucketStart(execution.StartTime, granularity)}
+		if groupBy != "" {
+			key.group = execution.Labels[groupBy]
+		}
+
+		if _, ok := buckets[key]; !ok {
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], execution)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].startTime.Equal(keys[j].startTime) {
+			return keys[i].startTime.Before(keys[j].startTime)
+		}
+		return keys[i].group < keys[j].group
+	})
+
+	trend := make([]testkube.ExecutionsMetricsBucket, len(keys))
+	for i, key := range keys {
+		metrics := CalculateMetrics(buckets[key])
+		trend[i] = testkube.ExecutionsMetricsBucket{
+			StartTime:              key.startTime,
+			Group:                  key.group,
+			PassFailRatio:          metrics.PassFailRatio,
+			TotalExecutions:        metrics.TotalExecutions,
+			FailedExecutions:       metrics.FailedExecutions,
+			ExecutionDurationP50ms: metrics.ExecutionDurationP50ms,
+			ExecutionDurationP90ms: metrics.ExecutionDurationP90ms,
+			ExecutionDurationP95ms: metrics.ExecutionDurationP95ms,
+			ExecutionDurationP99ms: metrics.ExecutionDurationP99ms,
+		}
+	}
+
+	return trend
+}
+
+// bucketStart returns start of the UTC hour or day the time belongs to
+func bucketStart(t time.Time, granularity testkube.MetricsGranularity) time.Time {
+	t = t.UTC()
+	if granularity == testkube.HOUR_MetricsGranularity {
+		return t.Truncate(time.Hour)
+	}
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 )
@@ -45,3 +46,38 @@ func Test_WhenNoDurations_ShouldReturn0FromCalculations(t *testing.T) {
 	assert(t, result.ExecutionDurationP95)
 	assert(t, result.ExecutionDurationP99)
 }
+
+func Test_CalculateTrend_GroupsExecutionsByTimeBucketAndLabel(t *testing.T) {
+	day := time.Date(2023, 5, 10, 0, 0, 0, 0, time.UTC)
+	executions := []testkube.ExecutionsMetricsExecutions{
+		{Duration: "3s", Status: "failed", StartTime: day.Add(26 * time.Hour), Labels: map[string]string{"env": "prod"}},
+		{Duration: "2s", Status: "passed", StartTime: day.Add(10 * time.Hour), Labels: map[string]string{"env": "dev"}},
+		{Duration: "1s", Status: "failed", StartTime: day.Add(9 * time.Hour), Labels: map[string]string{"env": "prod"}},
+		{Duration: "4s", Status: "passed", StartTime: day.Add(9*time.Hour + time.Minute), Labels: map[string]string{"env": "prod"}},
+	}
+
+	trend := CalculateTrend(executions, testkube.DAY_MetricsGranularity, "")
+	if len(trend) != 2 {
+		t.Fatalf("Expected 2 buckets but got %d", len(trend))
+	}
+	if !trend[0].StartTime.Equal(day) || trend[0].TotalExecutions != 3 || trend[0].FailedExecutions != 1 {
+		t.Fatalf("Unexpected first bucket %+v", trend[0])
+	}
+	if trend[0].ExecutionDurationP50ms != 2000 || trend[0].ExecutionDurationP99ms != 4000 {
+		t.Fatalf("Unexpected durations of first bucket %+v", trend[0])
+	}
+	if !trend[1].StartTime.Equal(day.Add(24*time.Hour)) || trend[1].PassFailRatio != 0 {
+		t.Fatalf("Unexpected second bucket %+v", trend[1])
+	}
+
+	trend = CalculateTrend(executions, testkube.HOUR_MetricsGranularity, "env")
+	if len(trend) != 3 {
+		t.Fatalf("Expected 3 buckets but got %d", len(trend))
+	}
+	if !trend[0].StartTime.Equal(day.Add(9*time.Hour)) || trend[0].Group != "prod" || trend[0].PassFailRatio != 50 {
+		t.Fatalf("Unexpected first bucket %+v", trend[0])
+	}
+	if trend[1].Group != "dev" || trend[2].Group != "prod" {
+		t.Fatalf("Unexpected groups %s and %s", trend[1].Group, trend[2].Group)
+	}
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SetExcept builds $set update replacing all fields of the document besides the omitted ones,
+// it's used instead of a replace for fields that are updated separately
+func SetExcept(document interface{}, omitted ...string) (bson.M, error) {
+	data, err := bson.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields bson.M
+	if err = bson.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	delete(fields, "_id")
+	for _, field := range omitted {
+		delete(fields, field)
+	}
+
+	return bson.M{"$set": fields}, nil
+}
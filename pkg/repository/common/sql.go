@@ -0,0 +1,92 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxUpdateAttempts is the number of times a document update is retried when it was modified concurrently
+const MaxUpdateAttempts = 10
+
+// ErrConcurrentUpdate is returned when a document kept being modified concurrently during the update
+var ErrConcurrentUpdate = errors.New("document was modified concurrently")
+
+// SQLQuery collects WHERE clause conditions together with their positional arguments,
+// placeholders are numbered in the order the arguments are added
+type SQLQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+// Arg registers a new positional argument and returns its placeholder
+func (q *SQLQuery) Arg(value interface{}) string {
+	q.args = append(q.args, value)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+// Add adds condition to the query, conditions are joined with AND
+func (q *SQLQuery) Add(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+// AddIn adds "column IN (...)" condition for given values
+func (q *SQLQuery) AddIn(column string, values []string) {
+	placeholders := make([]string, len(values))
+	for i, value := range values {
+		placeholders[i] = q.Arg(value)
+	}
+	q.Add(column + " IN (" + strings.Join(placeholders, ", ") + ")")
+}
+
+// AddSelector adds label selector conditions (e.g. "key1=value1,key2") for JSON column
+func (q *SQLQuery) AddSelector(selector, column string) {
+	items := strings.Split(selector, ",")
+	for _, item := range items {
+		elements := strings.Split(item, "=")
+		if len(elements) == 2 {
+			q.Add(fmt.Sprintf("%s->>CAST(%s AS TEXT) = %s", column, q.Arg(elements[0]), q.Arg(elements[1])))
+		} else if len(elements) == 1 {
+			q.Add(fmt.Sprintf("%s->>CAST(%s AS TEXT) IS NOT NULL", column, q.Arg(elements[0])))
+		}
+	}
+}
+
+// AddCursor adds condition for rows after the cursor, rows have to be ordered by start time and id descending
+func (q *SQLQuery) AddCursor(cursor Cursor) {
+	startTime := q.Arg(ToUnixMilli(cursor.StartTime))
+	q.Add("(start_time < " + startTime + " OR (start_time = " + startTime + " AND id < " + q.Arg(cursor.Id) + "))")
+}
+
+// Where returns WHERE clause or empty string when there are no conditions
+func (q *SQLQuery) Where() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// Args returns positional arguments for the query
+func (q *SQLQuery) Args() []interface{} {
+	return q.args
+}
+
+// ToUnixMilli converts time to milliseconds stored in SQL databases, zero time is stored as 0
+func ToUnixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixMilli()
+}
+
+// FromUnixMilli converts milliseconds stored in SQL databases to time
+func FromUnixMilli(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+
+	return time.UnixMilli(ms).UTC()
+}
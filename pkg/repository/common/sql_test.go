@@ -0,0 +1,43 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLQuery(t *testing.T) {
+	t.Run("no conditions", func(t *testing.T) {
+		query := SQLQuery{}
+		assert.Equal(t, "", query.Where())
+		assert.Empty(t, query.Args())
+	})
+
+	t.Run("placeholders are numbered in order", func(t *testing.T) {
+		query := SQLQuery{}
+		query.Add("test_name = " + query.Arg("test"))
+		query.AddIn("status", []string{"passed", "failed"})
+		query.AddSelector("key1=value1,key2", "labels")
+
+		assert.Equal(t, " WHERE test_name = $1 AND status IN ($2, $3) AND "+
+			"labels->>CAST($4 AS TEXT) = $5 AND labels->>CAST($6 AS TEXT) IS NOT NULL", query.Where())
+		assert.Equal(t, []interface{}{"test", "passed", "failed", "key1", "value1", "key2"}, query.Args())
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		query := SQLQuery{}
+		query.AddCursor(Cursor{StartTime: time.UnixMilli(1000), Id: "id"})
+
+		assert.Equal(t, " WHERE (start_time < $1 OR (start_time = $1 AND id < $2))", query.Where())
+		assert.Equal(t, []interface{}{int64(1000), "id"}, query.Args())
+	})
+}
+
+func TestUnixMilli(t *testing.T) {
+	assert.Equal(t, int64(0), ToUnixMilli(time.Time{}))
+	assert.True(t, FromUnixMilli(0).IsZero())
+
+	now := time.Now().Truncate(time.Millisecond)
+	assert.True(t, now.Equal(FromUnixMilli(ToUnixMilli(now))))
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -67,6 +68,24 @@ func (c *ConfigMapConfig) Get(ctx context.Context) (result testkube.Config, err
 		}
 	}
 
+	if retentionPolicies, ok := data["retentionPolicies"]; ok && retentionPolicies != "" {
+		if err = json.Unmarshal([]byte(retentionPolicies), &result.RetentionPolicies); err != nil {
+			return result, errors.Wrap(err, "parsing retention policies error")
+		}
+	}
+
+	if quarantine, ok := data["quarantine"]; ok && quarantine != "" {
+		if err = json.Unmarshal([]byte(quarantine), &result.Quarantine); err != nil {
+			return result, errors.Wrap(err, "parsing quarantine error")
+		}
+	}
+
+	if queue, ok := data["queue"]; ok && queue != "" {
+		if err = json.Unmarshal([]byte(queue), &result.Queue); err != nil {
+			return result, errors.Wrap(err, "parsing queue error")
+		}
+	}
+
 	return
 }
 
@@ -76,6 +95,27 @@ func (c *ConfigMapConfig) Upsert(ctx context.Context, result testkube.Config) (u
 		"clusterId":       result.ClusterId,
 		"enableTelemetry": fmt.Sprint(result.EnableTelemetry),
 	}
+	if len(result.RetentionPolicies) != 0 {
+		retentionPolicies, err := json.Marshal(result.RetentionPolicies)
+		if err != nil {
+			return result, errors.Wrap(err, "encoding retention policies error")
+		}
+		data["retentionPolicies"] = string(retentionPolicies)
+	}
+	if result.Quarantine != nil {
+		quarantine, err := json.Marshal(result.Quarantine)
+		if err != nil {
+			return result, errors.Wrap(err, "encoding quarantine error")
+		}
+		data["quarantine"] = string(quarantine)
+	}
+	if result.Queue != nil {
+		queue, err := json.Marshal(result.Queue)
+		if err != nil {
+			return result, errors.Wrap(err, "encoding queue error")
+		}
+		data["queue"] = string(queue)
+	}
 	if err = c.client.Apply(ctx, c.name, data); err != nil {
 		return result, errors.Wrap(err, "writing config map error")
 	}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/telemetry"
+)
+
+const TableName = "config"
+
+var _ Repository = (*SQLRepository)(nil)
+
+// NewSQLRepository creates config repository on top of database/sql connection (e.g. PostgreSQL)
+func NewSQLRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+type SQLRepository struct {
+	db *sql.DB
+}
+
+func (r *SQLRepository) GetUniqueClusterId(ctx context.Context) (clusterId string, err error) {
+	config, _ := r.Get(ctx)
+
+	// generate new cluster id and save if there is not already
+	if config.ClusterId == "" {
+		config.ClusterId = fmt.Sprintf("cluster%s", telemetry.GetMachineID())
+		_, err := r.Upsert(ctx, config)
+		return config.ClusterId, err
+	}
+
+	return config.ClusterId, nil
+}
+
+func (r *SQLRepository) GetTelemetryEnabled(ctx context.Context) (ok bool, err error) {
+	config, err := r.Get(ctx)
+	return config.EnableTelemetry, err
+}
+
+func (r *SQLRepository) Get(ctx context.Context) (result testkube.Config, err error) {
+	var document []byte
+	err = r.db.QueryRowContext(ctx, "SELECT document FROM "+TableName+" WHERE id = $1", Id).Scan(&document)
+	if err == sql.ErrNoRows {
+		return result, mongo.ErrNoDocuments
+	}
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(document, &result)
+	return
+}
+
+func (r *SQLRepository) Upsert(ctx context.Context, result testkube.Config) (updated testkube.Config, err error) {
+	result.Id = Id
+	document, err := json.Marshal(result)
+	if err != nil {
+		return result, err
+	}
+
+	_, err = r.db.ExecContext(ctx, "INSERT INTO "+TableName+" (id, document) VALUES ($1, $2) "+
+		"ON CONFLICT (id) DO UPDATE SET document = EXCLUDED.document", Id, string(document))
+	return result, err
+}
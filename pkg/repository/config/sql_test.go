@@ -0,0 +1,40 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+)
+
+func TestSQLRepository(t *testing.T) {
+	assert := require.New(t)
+
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(err)
+	defer db.Close()
+
+	repository := NewSQLRepository(db)
+
+	_, err = repository.Get(context.Background())
+	assert.ErrorIs(err, mongo.ErrNoDocuments)
+
+	_, err = repository.Upsert(context.Background(), testkube.Config{ClusterId: "cluster1", EnableTelemetry: true})
+	assert.NoError(err)
+
+	_, err = repository.Upsert(context.Background(), testkube.Config{ClusterId: "cluster2", EnableTelemetry: true})
+	assert.NoError(err)
+
+	clusterId, err := repository.GetUniqueClusterId(context.Background())
+	assert.NoError(err)
+	assert.Equal("cluster2", clusterId)
+
+	enabled, err := repository.GetTelemetryEnabled(context.Background())
+	assert.NoError(err)
+	assert.True(enabled)
+}
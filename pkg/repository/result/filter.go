@@ -7,16 +7,20 @@ import (
 )
 
 type FilterImpl struct {
-	FTestName   string                     `json:"testName"`
-	FStartDate  *time.Time                 `json:"startDate"`
-	FEndDate    *time.Time                 `json:"endDate"`
-	FLastNDays  int                        `json:"lastNDays"`
-	FStatuses   testkube.ExecutionStatuses `json:"statuses"`
-	FPage       int                        `json:"page"`
-	FPageSize   int                        `json:"pageSize"`
-	FTextSearch string                     `json:"textSearch"`
-	FSelector   string                     `json:"selector"`
-	FObjectType string                     `json:"objectType"`
+	FTestName           string                     `json:"testName"`
+	FStartDate          *time.Time                 `json:"startDate"`
+	FEndDate            *time.Time                 `json:"endDate"`
+	FLastNDays          int                        `json:"lastNDays"`
+	FStatuses           testkube.ExecutionStatuses `json:"statuses"`
+	FPage               int                        `json:"page"`
+	FPageSize           int                        `json:"pageSize"`
+	FTextSearch         string                     `json:"textSearch"`
+	FSelector           string                     `json:"selector"`
+	FObjectType         string                     `json:"objectType"`
+	FCursor             string                     `json:"cursor"`
+	FAnnotationCategory string                     `json:"annotationCategory"`
+	FParentExecutionId  string                     `json:"parentExecutionId"`
+	FRetryPending       bool                       `json:"retryPending"`
 }
 
 func NewExecutionsFilter() *FilterImpl {
@@ -78,6 +82,26 @@ func (f *FilterImpl) WithType(objectType string) *FilterImpl {
 	return f
 }
 
+func (f *FilterImpl) WithCursor(cursor string) *FilterImpl {
+	f.FCursor = cursor
+	return f
+}
+
+func (f *FilterImpl) WithAnnotationCategory(category string) *FilterImpl {
+	f.FAnnotationCategory = category
+	return f
+}
+
+func (f *FilterImpl) WithParentExecutionId(id string) *FilterImpl {
+	f.FParentExecutionId = id
+	return f
+}
+
+func (f *FilterImpl) WithRetryPending(pending bool) *FilterImpl {
+	f.FRetryPending = pending
+	return f
+}
+
 func (f *FilterImpl) TestName() string {
 	return f.FTestName
 }
@@ -145,3 +169,40 @@ func (f *FilterImpl) Type() string {
 func (f *FilterImpl) Selector() string {
 	return f.FSelector
 }
+
+func (f *FilterImpl) CursorDefined() bool {
+	return f.FCursor != ""
+}
+
+func (f *FilterImpl) Cursor() string {
+	return f.FCursor
+}
+
+func (f *FilterImpl) AnnotationCategoryDefined() bool {
+	return f.FAnnotationCategory != ""
+}
+
+func (f *FilterImpl) AnnotationCategory() string {
+	return f.FAnnotationCategory
+}
+
+func (f *FilterImpl) ParentExecutionIdDefined() bool {
+	return f.FParentExecutionId != ""
+}
+
+func (f *FilterImpl) ParentExecutionId() string {
+	return f.FParentExecutionId
+}
+
+func (f *FilterImpl) RetryPending() bool {
+	return f.FRetryPending
+}
+
+// pageOffset returns number of executions to skip, pages follow the cursor when it's defined
+func pageOffset(filter Filter) int {
+	if filter.CursorDefined() {
+		return 0
+	}
+
+	return filter.Page() * filter.PageSize()
+}
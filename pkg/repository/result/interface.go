@@ -28,6 +28,13 @@ type Filter interface {
 	Selector() string
 	TypeDefined() bool
 	Type() string
+	CursorDefined() bool
+	Cursor() string
+	AnnotationCategoryDefined() bool
+	AnnotationCategory() string
+	ParentExecutionIdDefined() bool
+	ParentExecutionId() string
+	RetryPending() bool
 }
 
 //go:generate mockgen -destination=./mock_repository.go -package=result "github.com/kubeshop/testkube/pkg/repository/result" Repository
@@ -55,6 +62,10 @@ type Repository interface {
 	StartExecution(ctx context.Context, id string, startTime time.Time) error
 	// EndExecution updates execution end time
 	EndExecution(ctx context.Context, execution testkube.Execution) error
+	// AdmitExecution changes status of the queued execution to running, false is returned when it isn't queued anymore
+	AdmitExecution(ctx context.Context, id string) (admitted bool, err error)
+	// AbortQueuedExecution changes status of the queued execution to aborted, false is returned when it isn't queued anymore
+	AbortQueuedExecution(ctx context.Context, id string) (aborted bool, err error)
 	// GetLabels get all available labels
 	GetLabels(ctx context.Context) (labels map[string][]string, err error)
 	// DeleteByTest deletes execution results by test
@@ -69,6 +80,15 @@ type Repository interface {
 	DeleteByTestSuites(ctx context.Context, testSuiteNames []string) (err error)
 	// DeleteForAllTestSuites deletes execution results for all test suites
 	DeleteForAllTestSuites(ctx context.Context) (err error)
+	// DeleteByIds deletes execution results and their outputs by ids
+	DeleteByIds(ctx context.Context, ids []string) (err error)
+	// AddAnnotation adds annotation to execution result
+	AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error
+	// DeleteAnnotation deletes annotation from execution result
+	DeleteAnnotation(ctx context.Context, id, annotationID string) error
+	// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+	// is claimed only when its heartbeat is older than staleBefore
+	Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (claimed bool, err error)
 
 	GetTestMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error)
 }
@@ -102,6 +102,15 @@ func WithMongoRepositorySequenceCollection(collection *mongo.Collection) MongoRe
 	}
 }
 
+// EnsureIndexes creates indexes used by cursor pagination of executions, like the results table indexes of SQL repository
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.ResultsColl.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "testname", Value: 1}, {Key: "starttime", Value: -1}, {Key: "id", Value: -1}}},
+	})
+	return err
+}
+
 func (r *MongoRepository) Get(ctx context.Context, id string) (result testkube.Execution, err error) {
 	err = r.ResultsColl.FindOne(ctx, bson.M{"$or": bson.A{bson.M{"id": id}, bson.M{"name": id}}}).Decode(&result)
 	if err != nil {
@@ -263,6 +272,11 @@ func (r *MongoRepository) GetExecutions(ctx context.Context, filter Filter) (res
 		opts.SetAllowDiskUse(r.allowDiskUse)
 	}
 
+	query, err = composeCursorQuery(query, filter)
+	if err != nil {
+		return
+	}
+
 	cursor, err := r.ResultsColl.Find(ctx, query, opts)
 	if err != nil {
 		return
@@ -286,11 +300,18 @@ func (r *MongoRepository) GetExecutionTotals(ctx context.Context, paging bool, f
 		query, _ = composeQueryAndOpts(filter[0])
 	}
 
+	if len(filter) > 0 && paging {
+		query, err = composeCursorQuery(query, filter[0])
+		if err != nil {
+			return totals, err
+		}
+	}
+
 	pipeline := []bson.D{{{Key: "$match", Value: query}}}
 	if len(filter) > 0 {
-		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "starttime", Value: -1}}}})
+		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}}}})
 		if paging {
-			pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(filter[0].Page() * filter[0].PageSize())}})
+			pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(pageOffset(filter[0]))}})
 			pipeline = append(pipeline, bson.D{{Key: "$limit", Value: int64(filter[0].PageSize())}})
 		}
 	}
@@ -386,7 +407,12 @@ func (r *MongoRepository) Update(ctx context.Context, result testkube.Execution)
 	output := result.ExecutionResult.Output
 	result.ExecutionResult.Output = ""
 	result.EscapeDots()
-	_, err = r.ResultsColl.ReplaceOne(ctx, bson.M{"id": result.Id}, result)
+	// annotations and the runner are managed separately, keep the stored ones
+	update, err := common.SetExcept(result, "annotations", "runnerid", "heartbeattime")
+	if err != nil {
+		return
+	}
+	_, err = r.ResultsColl.UpdateOne(ctx, bson.M{"id": result.Id}, update)
 	if err != nil {
 		return
 	}
@@ -437,6 +463,72 @@ func (r *MongoRepository) EndExecution(ctx context.Context, e testkube.Execution
 	return
 }
 
+// AdmitExecution changes status of the queued execution to running, false is returned when it isn't queued anymore
+func (r *MongoRepository) AdmitExecution(ctx context.Context, id string) (bool, error) {
+	result, err := r.ResultsColl.UpdateOne(ctx, bson.M{"id": id, "executionresult.status": testkube.QUEUED_ExecutionStatus},
+		bson.M{"$set": bson.M{"executionresult.status": testkube.RUNNING_ExecutionStatus}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount != 0, nil
+}
+
+// AbortQueuedExecution changes status of the queued execution to aborted, false is returned when it isn't queued anymore
+func (r *MongoRepository) AbortQueuedExecution(ctx context.Context, id string) (bool, error) {
+	result, err := r.ResultsColl.UpdateOne(ctx, bson.M{"id": id, "executionresult.status": testkube.QUEUED_ExecutionStatus},
+		bson.M{"$set": bson.M{"executionresult.status": testkube.ABORTED_ExecutionStatus, "endtime": time.Now()}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount != 0, nil
+}
+
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore
+func (r *MongoRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	result, err := r.ResultsColl.UpdateOne(ctx, bson.M{"id": id, "$or": bson.A{
+		bson.M{"runnerid": runnerID},
+		bson.M{"heartbeattime": bson.M{"$lt": staleBefore}},
+		bson.M{"heartbeattime": bson.M{"$exists": false}},
+	}}, bson.M{"$set": bson.M{"runnerid": runnerID, "heartbeattime": time.Now()}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount != 0, nil
+}
+
+// AddAnnotation adds annotation to execution result
+func (r *MongoRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	result, err := r.ResultsColl.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$push": bson.M{"annotations": annotation}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// DeleteAnnotation deletes annotation from execution result
+func (r *MongoRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	result, err := r.ResultsColl.UpdateOne(ctx, bson.M{"id": id, "annotations.id": annotationID},
+		bson.M{"$pull": bson.M{"annotations": bson.M{"id": annotationID}}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
 func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 	query := bson.M{}
 	conditions := bson.A{}
@@ -492,9 +584,21 @@ func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 		conditions = append(conditions, bson.M{"testtype": filter.Type()})
 	}
 
-	opts.SetSkip(int64(filter.Page() * filter.PageSize()))
+	if filter.AnnotationCategoryDefined() {
+		conditions = append(conditions, bson.M{"annotations.category": filter.AnnotationCategory()})
+	}
+
+	if filter.ParentExecutionIdDefined() {
+		conditions = append(conditions, bson.M{"parentexecutionid": filter.ParentExecutionId()})
+	}
+
+	if filter.RetryPending() {
+		conditions = append(conditions, bson.M{"retrypending": true})
+	}
+
+	opts.SetSkip(int64(pageOffset(filter)))
 	opts.SetLimit(int64(filter.PageSize()))
-	opts.SetSort(bson.D{{Key: "starttime", Value: -1}})
+	opts.SetSort(bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}})
 
 	if len(conditions) > 0 {
 		query = bson.M{"$and": conditions}
@@ -503,6 +607,23 @@ func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 	return query, opts
 }
 
+// composeCursorQuery narrows the query to executions after the cursor of the filter
+func composeCursorQuery(query bson.M, filter Filter) (bson.M, error) {
+	if !filter.CursorDefined() {
+		return query, nil
+	}
+
+	cursor, err := common.DecodeCursor(filter.Cursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.M{"$and": bson.A{query, bson.M{"$or": bson.A{
+		bson.M{"starttime": bson.M{"$lt": cursor.StartTime}},
+		bson.M{"starttime": cursor.StartTime, "id": bson.M{"$lt": cursor.Id}},
+	}}}}, nil
+}
+
 func addSelectorConditions(selector string, tag string, conditions primitive.A) primitive.A {
 	items := strings.Split(selector, ",")
 	for _, item := range items {
@@ -637,6 +758,35 @@ func (r *MongoRepository) DeleteForAllTestSuites(ctx context.Context) (err error
 	return
 }
 
+// DeleteByIds deletes execution results and their outputs by ids
+func (r *MongoRepository) DeleteByIds(ctx context.Context, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"id": bson.M{"$in": ids}}
+	opts := options.Find().SetProjection(bson.M{"id": 1, "testname": 1, "testsuitename": 1})
+	cursor, err := r.ResultsColl.Find(ctx, filter, opts)
+	if err != nil {
+		return
+	}
+
+	var executions []testkube.Execution
+	if err = cursor.All(ctx, &executions); err != nil {
+		return
+	}
+
+	for _, execution := range executions {
+		err = r.OutputRepository.DeleteOutput(ctx, execution.Id, execution.TestName, execution.TestSuiteName)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = r.ResultsColl.DeleteMany(ctx, filter)
+	return
+}
+
 // GetTestMetrics returns test executions metrics limited to number of executions or last N days
 func (r *MongoRepository) GetTestMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
 	query := bson.M{"testname": name}
@@ -655,6 +805,7 @@ func (r *MongoRepository) GetTestMetrics(ctx context.Context, name string, limit
 				{Key: "duration", Value: 1},
 				{Key: "starttime", Value: 1},
 				{Key: "name", Value: 1},
+				{Key: "labels", Value: 1},
 			},
 		},
 	})
@@ -66,6 +66,20 @@ func (r *MongoRepository) GetNextExecutionNumber(ctx context.Context, name strin
 	return int32(execNmbr.Number), nil
 }
 
+// UpdateExecutionNumber moves sequence forward to the number, so next executions don't reuse imported numbers
+func (r *MongoRepository) UpdateExecutionNumber(ctx context.Context, name string, number int32) (err error) {
+	err = r.convertFromOldToNew()
+	if err != nil {
+		return err
+	}
+
+	isTestSuite := strings.HasPrefix(name, "ts-")
+	_, err = r.SequencesColl.UpdateOne(ctx, bson.M{"name": name},
+		bson.M{"$max": bson.M{"number": number}, "$setOnInsert": bson.M{"istestsuite": isTestSuite}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
 func (r *MongoRepository) DeleteExecutionNumber(ctx context.Context, name string) (err error) {
 	err = r.convertFromOldToNew()
 	if err != nil {
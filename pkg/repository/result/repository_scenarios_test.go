@@ -0,0 +1,637 @@
+package result
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/datefilter"
+	"github.com/kubeshop/testkube/pkg/rand"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+func testStorage(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	oneDayAgo := time.Now().Add(-24 * time.Hour)
+	twoDaysAgo := time.Now().Add(-48 * time.Hour)
+	defaultName := "name"
+	err := insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, time.Now(), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, time.Now(), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, time.Now(), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, time.Now(), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.PASSED_ExecutionStatus, time.Now(), map[string]string{"key1": "value1", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.QUEUED_ExecutionStatus, time.Now(), map[string]string{"key1": "value1", "key3": "value3"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.RUNNING_ExecutionStatus, time.Now(), map[string]string{"key5": "value5", "key6": "value6"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, oneDayAgo, map[string]string{"key1": "value1", "key5": "value5"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, oneDayAgo, map[string]string{"key1": "value1", "key6": "value6"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, oneDayAgo, map[string]string{"key2": "value2", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, oneDayAgo, map[string]string{"key2": "value2", "key5": "value5"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.PASSED_ExecutionStatus, oneDayAgo, map[string]string{"key7": "value7", "key8": "value8"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.QUEUED_ExecutionStatus, oneDayAgo, map[string]string{"key7": "value7", "key8": "value8"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.RUNNING_ExecutionStatus, oneDayAgo, map[string]string{"key7": "value7", "key8": "value8"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, twoDaysAgo, map[string]string{"key7": "value7", "key8": "value8"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, twoDaysAgo, map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, twoDaysAgo, map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.FAILED_ExecutionStatus, twoDaysAgo, map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.PASSED_ExecutionStatus, twoDaysAgo, map[string]string{"key3": "value3", "key6": "value6"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.QUEUED_ExecutionStatus, twoDaysAgo, map[string]string{"key3": "value3", "key5": "value5"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, defaultName, testkube.RUNNING_ExecutionStatus, twoDaysAgo, map[string]string{"key4": "value4", "key6": "value6"})
+	assert.NoError(err)
+
+	numberOfLabels := 8
+
+	t.Run("filter with status should return only executions with that status", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithStatus(string(testkube.FAILED_ExecutionStatus)))
+		assert.NoError(err)
+		assert.Len(executions, 12)
+		assert.Equal(*executions[0].ExecutionResult.Status, testkube.FAILED_ExecutionStatus)
+	})
+
+	t.Run("filter with different statuses should return only executions with those statuses", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithStatus(
+			string(testkube.FAILED_ExecutionStatus)+","+string(testkube.PASSED_ExecutionStatus)))
+		assert.NoError(err)
+		assert.Len(executions, 15)
+	})
+
+	t.Run("filter with status should return only totals with that status", func(t *testing.T) {
+		filteredTotals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithStatus(string(testkube.FAILED_ExecutionStatus)))
+
+		assert.NoError(err)
+		assert.Equal(int32(12), filteredTotals.Results)
+		assert.Equal(int32(12), filteredTotals.Failed)
+		assert.Equal(int32(0), filteredTotals.Passed)
+		assert.Equal(int32(0), filteredTotals.Queued)
+		assert.Equal(int32(0), filteredTotals.Running)
+	})
+
+	t.Run("getting totals without filters should return all the executions", func(t *testing.T) {
+		totals, err := repository.GetExecutionTotals(context.Background(), false)
+
+		assert.NoError(err)
+		assert.Equal(int32(21), totals.Results)
+		assert.Equal(int32(12), totals.Failed)
+		assert.Equal(int32(3), totals.Passed)
+		assert.Equal(int32(3), totals.Queued)
+		assert.Equal(int32(3), totals.Running)
+	})
+
+	dateFilter := datefilter.NewDateFilter(oneDayAgo.Format(datefilter.DateFormatISO8601), "")
+	assert.True(dateFilter.IsStartValid)
+
+	t.Run("filter with startDate should return only executions after that day", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithStartDate(dateFilter.Start))
+		assert.NoError(err)
+		assert.Len(executions, 14)
+		assert.True(executions[0].StartTime.After(dateFilter.Start) || executions[0].StartTime.Equal(dateFilter.Start))
+	})
+
+	t.Run("filter with labels should return only filters with given labels", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithSelector("key1=value1,key2=value2"))
+		assert.NoError(err)
+		assert.Len(executions, 5)
+	})
+
+	t.Run("filter with labels should return only filters with existing labels", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithSelector("key1"))
+		assert.NoError(err)
+		assert.Len(executions, 9)
+	})
+
+	t.Run("getting totals with filter by date start date should return only the results after this date", func(t *testing.T) {
+		totals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithStartDate(dateFilter.Start))
+
+		assert.NoError(err)
+		assert.Equal(int32(14), totals.Results)
+		assert.Equal(int32(8), totals.Failed)
+		assert.Equal(int32(2), totals.Passed)
+		assert.Equal(int32(2), totals.Queued)
+		assert.Equal(int32(2), totals.Running)
+	})
+
+	dateFilter = datefilter.NewDateFilter("", oneDayAgo.Format(datefilter.DateFormatISO8601))
+	assert.True(dateFilter.IsEndValid)
+
+	t.Run("filter with endDate should return only executions before that day", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithEndDate(dateFilter.End))
+		assert.NoError(err)
+		assert.Len(executions, 7)
+		assert.True(executions[0].StartTime.Before(dateFilter.End) || executions[0].StartTime.Equal(dateFilter.End))
+	})
+
+	t.Run("getting totals with filter by date start date should return only the results before this date", func(t *testing.T) {
+		totals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithEndDate(dateFilter.End))
+
+		assert.NoError(err)
+		assert.Equal(int32(7), totals.Results)
+		assert.Equal(int32(4), totals.Failed)
+		assert.Equal(int32(1), totals.Passed)
+		assert.Equal(int32(1), totals.Queued)
+		assert.Equal(int32(1), totals.Running)
+	})
+
+	t.Run("filter with test name that doesn't exist should return 0 results", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithTestName("noneExisting"))
+		assert.NoError(err)
+		assert.Empty(executions)
+	})
+
+	t.Run("getting totals with test name that doesn't exist should return 0 results", func(t *testing.T) {
+		totals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithTestName("noneExisting"))
+
+		assert.NoError(err)
+		assert.Equal(int32(0), totals.Results)
+		assert.Equal(int32(0), totals.Failed)
+		assert.Equal(int32(0), totals.Passed)
+		assert.Equal(int32(0), totals.Queued)
+		assert.Equal(int32(0), totals.Running)
+	})
+
+	t.Run("filter with ccombined filter should return corresponding results", func(t *testing.T) {
+		filter := NewExecutionsFilter().
+			WithStatus(string(testkube.PASSED_ExecutionStatus)).
+			WithStartDate(twoDaysAgo).
+			WithEndDate(oneDayAgo).
+			WithTestName(defaultName)
+
+		executions, err := repository.GetExecutions(context.Background(), filter)
+
+		assert.NoError(err)
+		assert.Len(executions, 2)
+	})
+
+	t.Run("getting totals with ccombined filter should return corresponding results", func(t *testing.T) {
+		filter := NewExecutionsFilter().
+			WithStatus(string(testkube.PASSED_ExecutionStatus)).
+			WithStartDate(twoDaysAgo).
+			WithEndDate(oneDayAgo).
+			WithTestName(defaultName)
+		totals, err := repository.GetExecutionTotals(context.Background(), false, filter)
+
+		assert.NoError(err)
+		assert.Equal(int32(2), totals.Results)
+		assert.Equal(int32(0), totals.Failed)
+		assert.Equal(int32(2), totals.Passed)
+		assert.Equal(int32(0), totals.Queued)
+		assert.Equal(int32(0), totals.Running)
+	})
+
+	name := "someDifferentName"
+	err = insertExecutionResult(repository, name, testkube.RUNNING_ExecutionStatus, twoDaysAgo, nil)
+	assert.NoError(err)
+
+	t.Run("filter with test name should return result only for that test name", func(t *testing.T) {
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithTestName(name))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal(executions[0].TestName, name)
+	})
+
+	t.Run("getting totals with test name should return result only for that test name", func(t *testing.T) {
+		totals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithTestName(name))
+
+		assert.NoError(err)
+		assert.Equal(int32(1), totals.Results)
+		assert.Equal(int32(0), totals.Failed)
+		assert.Equal(int32(0), totals.Passed)
+		assert.Equal(int32(0), totals.Queued)
+		assert.Equal(int32(1), totals.Running)
+	})
+
+	t.Run("test executions should be sorted with most recent first", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter())
+		assert.NoError(err)
+		assert.NotEmpty(executions)
+		assert.True(executions[0].StartTime.After(executions[len(executions)-1].StartTime), "executions are not sorted with the most recent first")
+	})
+
+	t.Run("paging by cursor should return every execution once", func(t *testing.T) {
+		all, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithPageSize(100))
+		assert.NoError(err)
+
+		ids := map[string]struct{}{}
+		count := 0
+		filter := NewExecutionsFilter().WithPageSize(5)
+		for {
+			executions, err := repository.GetExecutions(context.Background(), filter)
+			assert.NoError(err)
+			for _, execution := range executions {
+				ids[execution.Id] = struct{}{}
+			}
+			count += len(executions)
+
+			if len(executions) < 5 {
+				break
+			}
+
+			last := executions[len(executions)-1]
+			filter = NewExecutionsFilter().WithPageSize(5).WithCursor(common.EncodeCursor(last.StartTime, last.Id))
+		}
+
+		assert.Equal(len(all), count)
+		assert.Len(ids, len(all))
+	})
+
+	t.Run("getting filtered totals with cursor should count only the page after it", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithTestName(defaultName))
+		assert.NoError(err)
+
+		last := executions[len(executions)-3]
+		filter := NewExecutionsFilter().WithTestName(defaultName).WithCursor(common.EncodeCursor(last.StartTime, last.Id))
+		totals, err := repository.GetExecutionTotals(context.Background(), true, filter)
+		assert.NoError(err)
+		assert.Equal(int32(2), totals.Results)
+
+		totals, err = repository.GetExecutionTotals(context.Background(), false, filter)
+		assert.NoError(err)
+		assert.Equal(int32(len(executions)), totals.Results)
+	})
+
+	t.Run("invalid cursor should return error", func(t *testing.T) {
+		_, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithCursor("invalid"))
+		assert.ErrorIs(err, common.ErrInvalidCursor)
+	})
+
+	t.Run("getting labels should return all available labels", func(t *testing.T) {
+		labels, err := repository.GetLabels(context.Background())
+		assert.NoError(err)
+		assert.Len(labels, numberOfLabels)
+	})
+
+}
+
+func testLabels(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	t.Run("getting labels when there are no labels should return empty map", func(t *testing.T) {
+		labels, err := repository.GetLabels(context.Background())
+		assert.NoError(err)
+		assert.Len(labels, 0)
+	})
+}
+
+func testTestExecutionsMetrics(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	testName := "example-test"
+
+	err := insertExecutionResult(repository, testName, testkube.FAILED_ExecutionStatus, time.Now().Add(48*-time.Hour), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Hour), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(10*-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(10*-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.FAILED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.FAILED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.FAILED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key1": "value1", "key2": "value2"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.FAILED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+	err = insertExecutionResult(repository, testName, testkube.PASSED_ExecutionStatus, time.Now().Add(-time.Minute), map[string]string{"key3": "value3", "key4": "value4"})
+	assert.NoError(err)
+
+	metrics, err := repository.GetTestMetrics(context.Background(), testName, 100, 100)
+	assert.NoError(err)
+
+	t.Run("getting execution metrics for test data", func(t *testing.T) {
+		assert.NoError(err)
+		assert.Equal(int32(20), metrics.TotalExecutions)
+		assert.Equal(int32(5), metrics.FailedExecutions)
+		assert.Len(metrics.Executions, 20)
+	})
+
+	t.Run("getting pass/fail ratio", func(t *testing.T) {
+		assert.Equal(float64(75), metrics.PassFailRatio)
+	})
+
+	t.Run("getting percentiles of execution duration", func(t *testing.T) {
+		assert.Contains(metrics.ExecutionDurationP50, "1m0")
+		assert.Contains(metrics.ExecutionDurationP90, "10m0")
+		assert.Contains(metrics.ExecutionDurationP99, "48h0m0s")
+	})
+
+	t.Run("limit should limit executions", func(t *testing.T) {
+		metrics, err := repository.GetTestMetrics(context.Background(), testName, 1, 100)
+		assert.NoError(err)
+		assert.Equal(1, len(metrics.Executions))
+	})
+
+	t.Run("filter last n days should limit executions", func(t *testing.T) {
+		metrics, err := repository.GetTestMetrics(context.Background(), testName, 100, 1)
+		assert.NoError(err)
+		assert.Equal(int32(19), metrics.TotalExecutions)
+	})
+}
+
+func testAnnotations(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	status := testkube.FAILED_ExecutionStatus
+	execution := testkube.Execution{
+		Id:              rand.Name(),
+		TestName:        "annotated",
+		Name:            "annotated-1",
+		StartTime:       time.Now(),
+		ExecutionResult: &testkube.ExecutionResult{Status: &status},
+	}
+	assert.NoError(repository.Insert(context.Background(), execution))
+	assert.NoError(insertExecutionResult(repository, "annotated", testkube.FAILED_ExecutionStatus, time.Now(), nil))
+
+	infra := testkube.NewExecutionAnnotation(testkube.ExecutionAnnotation{Text: "node was evicted", Author: "on-call", Category: "infra"})
+	bug := testkube.NewExecutionAnnotation(testkube.ExecutionAnnotation{Text: "login is broken", Category: "product-bug",
+		IssueUrl: "https://github.com/kubeshop/testkube/issues/1"})
+
+	t.Run("adding annotations should store them in the execution", func(t *testing.T) {
+		assert.NoError(repository.AddAnnotation(context.Background(), execution.Id, infra))
+		assert.NoError(repository.AddAnnotation(context.Background(), execution.Id, bug))
+
+		result, err := repository.Get(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.Len(result.Annotations, 2)
+		assert.Equal(infra.Text, result.Annotations[0].Text)
+		assert.Equal(bug.IssueUrl, result.Annotations[1].IssueUrl)
+	})
+
+	t.Run("updating execution should keep annotations added in the meantime", func(t *testing.T) {
+		passed := testkube.PASSED_ExecutionStatus
+		updated := execution
+		updated.ExecutionResult = &testkube.ExecutionResult{Status: &passed}
+		assert.NoError(repository.Update(context.Background(), updated))
+
+		result, err := repository.Get(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.Len(result.Annotations, 2)
+		assert.Equal(passed, *result.ExecutionResult.Status)
+	})
+
+	t.Run("concurrently added annotations should all be stored", func(t *testing.T) {
+		var wg sync.WaitGroup
+		added := make([]testkube.ExecutionAnnotation, 5)
+		errs := make([]error, len(added))
+		for i := range added {
+			added[i] = testkube.NewExecutionAnnotation(testkube.ExecutionAnnotation{Text: "flaky", Category: "flaky"})
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				errs[i] = repository.AddAnnotation(context.Background(), execution.Id, added[i])
+			}(i)
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			assert.NoError(err)
+		}
+
+		result, err := repository.Get(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.Len(result.Annotations, 2+len(added))
+
+		for _, annotation := range added {
+			assert.NoError(repository.DeleteAnnotation(context.Background(), execution.Id, annotation.Id))
+		}
+	})
+
+	t.Run("filter with annotation category should return annotated executions", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithAnnotationCategory("infra"))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal(execution.Id, executions[0].Id)
+
+		totals, err := repository.GetExecutionTotals(context.Background(), false, NewExecutionsFilter().WithAnnotationCategory("flaky"))
+		assert.NoError(err)
+		assert.Equal(int32(0), totals.Results)
+	})
+
+	t.Run("deleting annotation should remove it from the execution", func(t *testing.T) {
+		assert.NoError(repository.DeleteAnnotation(context.Background(), execution.Id, infra.Id))
+
+		result, err := repository.Get(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.Len(result.Annotations, 1)
+		assert.Equal(bug.Id, result.Annotations[0].Id)
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithAnnotationCategory("infra"))
+		assert.NoError(err)
+		assert.Len(executions, 0)
+	})
+
+	t.Run("deleting unknown annotation should return not found", func(t *testing.T) {
+		assert.ErrorIs(repository.DeleteAnnotation(context.Background(), execution.Id, infra.Id), mongo.ErrNoDocuments)
+	})
+
+	t.Run("annotating unknown execution should return not found", func(t *testing.T) {
+		assert.ErrorIs(repository.AddAnnotation(context.Background(), "unknown", infra), mongo.ErrNoDocuments)
+	})
+}
+
+func insertExecutionResult(r Repository, testName string, execStatus testkube.ExecutionStatus, startTime time.Time, labels map[string]string) error {
+	return r.Insert(context.Background(),
+		testkube.Execution{
+			Id:              rand.Name(),
+			TestName:        testName,
+			Name:            "dummyName",
+			TestType:        "test/curl",
+			StartTime:       startTime,
+			EndTime:         time.Now(),
+			Duration:        time.Since(startTime).String(),
+			ExecutionResult: &testkube.ExecutionResult{Status: &execStatus},
+			Labels:          labels,
+		})
+}
+
+func testParentExecutions(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	status := testkube.PASSED_ExecutionStatus
+	for _, region := range []string{"eu", "us"} {
+		assert.NoError(repository.Insert(context.Background(), testkube.Execution{
+			Id:                rand.Name(),
+			TestName:          "matrix",
+			Name:              "matrix-" + region,
+			StartTime:         time.Now(),
+			ParentExecutionId: "parent-1",
+			Matrix:            map[string]string{"region": region},
+			ExecutionResult:   &testkube.ExecutionResult{Status: &status},
+		}))
+	}
+	assert.NoError(insertExecutionResult(repository, "matrix", testkube.FAILED_ExecutionStatus, time.Now(), nil))
+
+	t.Run("filtering by parent execution should return matrix executions", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithParentExecutionId("parent-1"))
+		assert.NoError(err)
+		assert.Len(executions, 2)
+		for _, execution := range executions {
+			assert.Equal("parent-1", execution.ParentExecutionId)
+			assert.NotEmpty(execution.Matrix["region"])
+		}
+	})
+}
+
+func testRetryPending(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	status := testkube.FAILED_ExecutionStatus
+	execution := testkube.Execution{
+		Id:              rand.Name(),
+		TestName:        "retried",
+		Name:            "retried-1",
+		StartTime:       time.Now(),
+		Attempt:         1,
+		RetryPending:    true,
+		ExecutionResult: &testkube.ExecutionResult{Status: &status},
+	}
+	assert.NoError(repository.Insert(context.Background(), execution))
+	assert.NoError(insertExecutionResult(repository, "retried", testkube.FAILED_ExecutionStatus, time.Now(), nil))
+
+	t.Run("filtering by pending retry should return executions to retry", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithRetryPending(true))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal(execution.Id, executions[0].Id)
+	})
+
+	t.Run("retry should be claimed by another runner only when stale", func(t *testing.T) {
+		claimed, err := repository.Claim(context.Background(), execution.Id, "runner-1", time.Now().Add(-time.Minute))
+		assert.NoError(err)
+		assert.True(claimed)
+
+		claimed, err = repository.Claim(context.Background(), execution.Id, "runner-2", time.Now().Add(-time.Minute))
+		assert.NoError(err)
+		assert.False(claimed)
+
+		claimed, err = repository.Claim(context.Background(), execution.Id, "runner-2", time.Now().Add(time.Minute))
+		assert.NoError(err)
+		assert.True(claimed)
+	})
+
+	t.Run("resolved retry should not be returned", func(t *testing.T) {
+		execution.RetryPending = false
+		assert.NoError(repository.Update(context.Background(), execution))
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithRetryPending(true))
+		assert.NoError(err)
+		assert.Len(executions, 0)
+	})
+}
+
+func testAdmitExecution(t *testing.T, repository Repository) {
+	assert := require.New(t)
+
+	execution := testkube.Execution{
+		Id:              rand.Name(),
+		TestName:        "queued",
+		Name:            "queued-1",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued},
+	}
+	assert.NoError(repository.Insert(context.Background(), execution))
+
+	t.Run("queued execution should be admitted once", func(t *testing.T) {
+		admitted, err := repository.AdmitExecution(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.True(admitted)
+
+		admitted, err = repository.AdmitExecution(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.False(admitted)
+
+		executions, err := repository.GetExecutions(context.Background(),
+			NewExecutionsFilter().WithStatus(string(testkube.RUNNING_ExecutionStatus)))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal(execution.Id, executions[0].Id)
+	})
+
+	t.Run("missing execution should not be admitted", func(t *testing.T) {
+		admitted, err := repository.AdmitExecution(context.Background(), "missing")
+		assert.NoError(err)
+		assert.False(admitted)
+	})
+
+	t.Run("admitted execution should not be aborted as queued", func(t *testing.T) {
+		aborted, err := repository.AbortQueuedExecution(context.Background(), execution.Id)
+		assert.NoError(err)
+		assert.False(aborted)
+	})
+
+	t.Run("aborted queued execution should not be admitted", func(t *testing.T) {
+		queued := testkube.Execution{
+			Id:              rand.Name(),
+			TestName:        "queued",
+			Name:            "queued-2",
+			ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued},
+		}
+		assert.NoError(repository.Insert(context.Background(), queued))
+
+		aborted, err := repository.AbortQueuedExecution(context.Background(), queued.Id)
+		assert.NoError(err)
+		assert.True(aborted)
+
+		admitted, err := repository.AdmitExecution(context.Background(), queued.Id)
+		assert.NoError(err)
+		assert.False(admitted)
+
+		result, err := repository.Get(context.Background(), queued.Id)
+		assert.NoError(err)
+		assert.Equal(testkube.ExecutionStatusAborted, result.ExecutionResult.Status)
+		assert.False(result.EndTime.IsZero())
+	})
+}
@@ -0,0 +1,746 @@
+package result
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+var _ Repository = (*SQLRepository)(nil)
+
+// errNotClaimed is returned when the execution is run by another runner with a recent heartbeat
+var errNotClaimed = errors.New("execution is run by another runner")
+
+// errNotQueued is returned when the admitted execution isn't queued anymore
+var errNotQueued = errors.New("execution is not queued")
+
+const (
+	TableResults   = "results"
+	TableSequences = "sequences"
+
+	resultColumns = "id, name, number, test_name, test_suite_name, test_type, status, start_time, end_time, labels, document, annotation_categories, parent_execution_id"
+	// updateTimeExpression is the most recent of start and end time, used to find the latest execution
+	updateTimeExpression = "CASE WHEN start_time > end_time THEN start_time ELSE end_time END"
+)
+
+// NewSQLRepository creates execution results repository on top of database/sql connection (e.g. PostgreSQL)
+func NewSQLRepository(db *sql.DB, opts ...SQLRepositoryOpt) *SQLRepository {
+	r := &SQLRepository{
+		db:               db,
+		OutputRepository: NewSQLOutputRepository(db),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type SQLRepository struct {
+	db               *sql.DB
+	OutputRepository OutputRepository
+}
+
+type SQLRepositoryOpt func(*SQLRepository)
+
+func WithSQLRepositoryOutputRepository(outputRepository OutputRepository) SQLRepositoryOpt {
+	return func(r *SQLRepository) {
+		r.OutputRepository = outputRepository
+	}
+}
+
+func (r *SQLRepository) Get(ctx context.Context, id string) (result testkube.Execution, err error) {
+	result, err = r.findOne(ctx, "SELECT document FROM "+TableResults+" WHERE id = $1 OR name = $2 LIMIT 1", id, id)
+	if err != nil {
+		return
+	}
+
+	return r.withOutput(ctx, result, result.TestSuiteName)
+}
+
+func (r *SQLRepository) GetByNameAndTest(ctx context.Context, name, testName string) (result testkube.Execution, err error) {
+	result, err = r.findOne(ctx, "SELECT document FROM "+TableResults+" WHERE name = $1 AND test_name = $2 LIMIT 1", name, testName)
+	if err != nil {
+		return
+	}
+
+	return r.withOutput(ctx, result, result.TestSuiteName)
+}
+
+func (r *SQLRepository) GetLatestByTest(ctx context.Context, testName string) (result testkube.Execution, err error) {
+	result, err = r.findOne(ctx, "SELECT document FROM "+TableResults+" WHERE test_name = $1 ORDER BY "+updateTimeExpression+" DESC LIMIT 1", testName)
+	if err != nil {
+		return
+	}
+
+	return r.withOutput(ctx, result, "")
+}
+
+func (r *SQLRepository) GetLatestByTests(ctx context.Context, testNames []string) (executions []testkube.Execution, err error) {
+	if len(testNames) == 0 {
+		return executions, nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_name", testNames)
+	return r.find(ctx, "SELECT document FROM ("+
+		"SELECT document, "+updateTimeExpression+" AS update_time, "+
+		"ROW_NUMBER() OVER (PARTITION BY test_name ORDER BY "+updateTimeExpression+" DESC) AS latest_rank "+
+		"FROM "+TableResults+query.Where()+") latest WHERE latest_rank = 1 ORDER BY update_time DESC", query.Args()...)
+}
+
+func (r *SQLRepository) GetExecutions(ctx context.Context, filter Filter) (result []testkube.Execution, err error) {
+	query := composeSQLQuery(filter)
+	if err = addCursor(&query, filter); err != nil {
+		return nil, err
+	}
+
+	limit := query.Arg(filter.PageSize())
+	offset := query.Arg(pageOffset(filter))
+	result, err = r.find(ctx, "SELECT document FROM "+TableResults+query.Where()+
+		" ORDER BY start_time DESC, id DESC LIMIT "+limit+" OFFSET "+offset, query.Args()...)
+	if result == nil {
+		result = make([]testkube.Execution, 0)
+	}
+	return
+}
+
+func (r *SQLRepository) GetExecutionTotals(ctx context.Context, paging bool, filter ...Filter) (totals testkube.ExecutionsTotals, err error) {
+	query := common.SQLQuery{}
+	if len(filter) > 0 {
+		query = composeSQLQuery(filter[0])
+	}
+
+	source := TableResults + query.Where()
+	if len(filter) > 0 && paging {
+		if err = addCursor(&query, filter[0]); err != nil {
+			return totals, err
+		}
+
+		limit := query.Arg(filter[0].PageSize())
+		offset := query.Arg(pageOffset(filter[0]))
+		source = "(SELECT status FROM " + TableResults + query.Where() + " ORDER BY start_time DESC, id DESC LIMIT " + limit + " OFFSET " + offset + ") paged"
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM "+source+" GROUP BY status", query.Args()...)
+	if err != nil {
+		return totals, err
+	}
+	defer rows.Close()
+
+	var sum int32
+	for rows.Next() {
+		var status string
+		var count int32
+		if err = rows.Scan(&status, &count); err != nil {
+			return totals, err
+		}
+
+		sum += count
+		switch testkube.ExecutionStatus(status) {
+		case testkube.QUEUED_ExecutionStatus:
+			totals.Queued = count
+		case testkube.RUNNING_ExecutionStatus:
+			totals.Running = count
+		case testkube.PASSED_ExecutionStatus:
+			totals.Passed = count
+		case testkube.FAILED_ExecutionStatus:
+			totals.Failed = count
+		}
+	}
+	totals.Results = sum
+
+	return totals, rows.Err()
+}
+
+func (r *SQLRepository) GetLabels(ctx context.Context) (labels map[string][]string, err error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT DISTINCT labels FROM "+TableResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels = map[string][]string{}
+	for rows.Next() {
+		var data []byte
+		if err = rows.Scan(&data); err != nil {
+			return nil, err
+		}
+
+		var item map[string]string
+		if err = json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+
+		for key, value := range item {
+			if !containsString(labels[key], value) {
+				labels[key] = append(labels[key], value)
+			}
+		}
+	}
+
+	return labels, rows.Err()
+}
+
+func (r *SQLRepository) Insert(ctx context.Context, result testkube.Execution) (err error) {
+	output := ""
+	if result.ExecutionResult != nil {
+		output = result.ExecutionResult.Output
+		result.ExecutionResult = result.ExecutionResult.GetDeepCopy()
+		result.ExecutionResult.Output = ""
+	}
+
+	args, err := resultArgs(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, "INSERT INTO "+TableResults+" ("+resultColumns+") "+
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)", args...)
+	if err != nil {
+		return
+	}
+
+	return r.OutputRepository.InsertOutput(ctx, result.Id, result.TestName, result.TestSuiteName, output)
+}
+
+func (r *SQLRepository) Update(ctx context.Context, result testkube.Execution) (err error) {
+	output := ""
+	if result.ExecutionResult != nil {
+		output = result.ExecutionResult.Output
+		result.ExecutionResult = result.ExecutionResult.GetDeepCopy()
+		result.ExecutionResult.Output = ""
+	}
+
+	// annotations and the runner are managed separately, keep the stored ones
+	err = r.update(ctx, result.Id, func(execution *testkube.Execution) error {
+		annotations, runnerID, heartbeatTime := execution.Annotations, execution.RunnerId, execution.HeartbeatTime
+		*execution = result
+		execution.Annotations, execution.RunnerId, execution.HeartbeatTime = annotations, runnerID, heartbeatTime
+		return nil
+	})
+	if err != nil && err != mongo.ErrNoDocuments {
+		return
+	}
+
+	return r.OutputRepository.UpdateOutput(ctx, result.Id, result.TestName, result.TestSuiteName, output)
+}
+
+func (r *SQLRepository) UpdateResult(ctx context.Context, id string, result testkube.Execution) (err error) {
+	output := result.ExecutionResult.Output
+	executionResult := result.ExecutionResult.GetDeepCopy()
+	executionResult.Output = ""
+	executionResult.Steps = cleanSteps(executionResult.Steps)
+
+	execution, err := r.findOne(ctx, "SELECT document FROM "+TableResults+" WHERE id = $1 OR name = $2 LIMIT 1", id, id)
+	if err != nil {
+		return err
+	}
+
+	errorMessage := ""
+	if execution.ExecutionResult != nil {
+		errorMessage = execution.ExecutionResult.ErrorMessage
+	}
+
+	if errorMessage != "" && executionResult.ErrorMessage != "" {
+		errorMessage += "\n"
+	}
+
+	executionResult.ErrorMessage = errorMessage + executionResult.ErrorMessage
+	err = r.update(ctx, execution.Id, func(execution *testkube.Execution) error {
+		execution.ExecutionResult = executionResult
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.OutputRepository.UpdateOutput(ctx, id, result.TestName, result.TestSuiteName, cleanOutput(output))
+}
+
+// StartExecution updates execution start time
+func (r *SQLRepository) StartExecution(ctx context.Context, id string, startTime time.Time) (err error) {
+	err = r.update(ctx, id, func(execution *testkube.Execution) error {
+		execution.StartTime = startTime
+		return nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// EndExecution updates execution end time
+func (r *SQLRepository) EndExecution(ctx context.Context, e testkube.Execution) (err error) {
+	err = r.update(ctx, e.Id, func(execution *testkube.Execution) error {
+		execution.EndTime = e.EndTime
+		execution.Duration = e.Duration
+		execution.DurationMs = e.DurationMs
+		return nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// AdmitExecution changes status of the queued execution to running, false is returned when it isn't queued anymore
+func (r *SQLRepository) AdmitExecution(ctx context.Context, id string) (bool, error) {
+	err := r.update(ctx, id, func(execution *testkube.Execution) error {
+		if !execution.IsQueued() {
+			return errNotQueued
+		}
+
+		execution.ExecutionResult.Status = testkube.ExecutionStatusRunning
+		return nil
+	})
+	if err == errNotQueued || err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// AbortQueuedExecution changes status of the queued execution to aborted, false is returned when it isn't queued anymore
+func (r *SQLRepository) AbortQueuedExecution(ctx context.Context, id string) (bool, error) {
+	err := r.update(ctx, id, func(execution *testkube.Execution) error {
+		if execution.ExecutionResult == nil || !execution.ExecutionResult.IsQueued() {
+			return errNotQueued
+		}
+
+		execution.ExecutionResult.Abort()
+		execution.EndTime = time.Now()
+		return nil
+	})
+	if err == errNotQueued || err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore
+func (r *SQLRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	err := r.update(ctx, id, func(execution *testkube.Execution) error {
+		if execution.RunnerId != runnerID && !execution.HeartbeatTime.Before(staleBefore) {
+			return errNotClaimed
+		}
+
+		execution.RunnerId = runnerID
+		execution.HeartbeatTime = time.Now()
+		return nil
+	})
+	if err == errNotClaimed || err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// AddAnnotation adds annotation to execution result
+func (r *SQLRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	return r.update(ctx, id, func(execution *testkube.Execution) error {
+		execution.Annotations = append(execution.Annotations, annotation)
+		return nil
+	})
+}
+
+// DeleteAnnotation deletes annotation from execution result
+func (r *SQLRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	return r.update(ctx, id, func(execution *testkube.Execution) error {
+		for i, annotation := range execution.Annotations {
+			if annotation.Id == annotationID {
+				execution.Annotations = append(execution.Annotations[:i], execution.Annotations[i+1:]...)
+				return nil
+			}
+		}
+
+		return mongo.ErrNoDocuments
+	})
+}
+
+// DeleteByTest deletes execution results by test
+func (r *SQLRepository) DeleteByTest(ctx context.Context, testName string) (err error) {
+	if err = r.OutputRepository.DeleteOutputByTest(ctx, testName); err != nil {
+		return
+	}
+	if err = r.DeleteExecutionNumber(ctx, testName); err != nil {
+		return
+	}
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+" WHERE test_name = $1", testName)
+	return
+}
+
+// DeleteByTestSuite deletes execution results by test suite
+func (r *SQLRepository) DeleteByTestSuite(ctx context.Context, testSuiteName string) (err error) {
+	if err = r.OutputRepository.DeleteOutputByTestSuite(ctx, testSuiteName); err != nil {
+		return
+	}
+	if err = r.DeleteExecutionNumber(ctx, testSuiteName); err != nil {
+		return
+	}
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+" WHERE test_suite_name = $1", testSuiteName)
+	return
+}
+
+// DeleteAll deletes all execution results
+func (r *SQLRepository) DeleteAll(ctx context.Context) (err error) {
+	if err = r.OutputRepository.DeleteAllOutput(ctx); err != nil {
+		return
+	}
+	if err = r.DeleteAllExecutionNumbers(ctx, false); err != nil {
+		return
+	}
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults)
+	return
+}
+
+// DeleteByTests deletes execution results by tests
+func (r *SQLRepository) DeleteByTests(ctx context.Context, testNames []string) (err error) {
+	if len(testNames) == 0 {
+		return nil
+	}
+
+	if err = r.OutputRepository.DeleteOutputForTests(ctx, testNames); err != nil {
+		return
+	}
+	if err = r.DeleteExecutionNumbers(ctx, testNames); err != nil {
+		return
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_name", testNames)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+query.Where(), query.Args()...)
+	return
+}
+
+// DeleteByTestSuites deletes execution results by test suites
+func (r *SQLRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames []string) (err error) {
+	if len(testSuiteNames) == 0 {
+		return nil
+	}
+
+	if err = r.OutputRepository.DeleteOutputForTestSuites(ctx, testSuiteNames); err != nil {
+		return
+	}
+	if err = r.DeleteExecutionNumbers(ctx, testSuiteNames); err != nil {
+		return
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_suite_name", testSuiteNames)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+query.Where(), query.Args()...)
+	return
+}
+
+// DeleteForAllTestSuites deletes execution results for all test suites
+func (r *SQLRepository) DeleteForAllTestSuites(ctx context.Context) (err error) {
+	if err = r.OutputRepository.DeleteOutputForAllTestSuite(ctx); err != nil {
+		return
+	}
+	if err = r.DeleteAllExecutionNumbers(ctx, true); err != nil {
+		return
+	}
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+" WHERE test_suite_name <> ''")
+	return
+}
+
+// DeleteByIds deletes execution results and their outputs by ids
+func (r *SQLRepository) DeleteByIds(ctx context.Context, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("id", ids)
+	rows, err := r.db.QueryContext(ctx, "SELECT id, test_name, test_suite_name FROM "+TableResults+query.Where(), query.Args()...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var executions []testkube.Execution
+	for rows.Next() {
+		var execution testkube.Execution
+		if err = rows.Scan(&execution.Id, &execution.TestName, &execution.TestSuiteName); err != nil {
+			return
+		}
+		executions = append(executions, execution)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	for _, execution := range executions {
+		if err = r.OutputRepository.DeleteOutput(ctx, execution.Id, execution.TestName, execution.TestSuiteName); err != nil {
+			return
+		}
+	}
+
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableResults+query.Where(), query.Args()...)
+	return
+}
+
+// GetTestMetrics returns test executions metrics limited to number of executions or last N days
+func (r *SQLRepository) GetTestMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
+	query := common.SQLQuery{}
+	query.Add("test_name = " + query.Arg(name))
+	if last > 0 {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(time.Now().Add(-time.Duration(last)*24*time.Hour))))
+	}
+
+	executions, err := r.find(ctx, "SELECT document FROM "+TableResults+query.Where()+" ORDER BY start_time DESC", query.Args()...)
+	if err != nil {
+		return metrics, err
+	}
+
+	metricsExecutions := make([]testkube.ExecutionsMetricsExecutions, len(executions))
+	for i, execution := range executions {
+		metricsExecutions[i] = testkube.ExecutionsMetricsExecutions{
+			ExecutionId: execution.Id,
+			Duration:    execution.Duration,
+			Name:        execution.Name,
+			StartTime:   execution.StartTime,
+			Labels:      execution.Labels,
+		}
+		if execution.ExecutionResult != nil && execution.ExecutionResult.Status != nil {
+			metricsExecutions[i].Status = string(*execution.ExecutionResult.Status)
+		}
+	}
+
+	metrics = common.CalculateMetrics(metricsExecutions)
+	if limit > 0 && limit < len(metrics.Executions) {
+		metrics.Executions = metrics.Executions[:limit]
+	}
+
+	return metrics, nil
+}
+
+// update applies the change to the stored execution and writes it back only when the document
+// was not modified in the meantime, so concurrent updates don't overwrite each other
+func (r *SQLRepository) update(ctx context.Context, id string, change func(execution *testkube.Execution) error) error {
+	for attempt := 0; attempt < common.MaxUpdateAttempts; attempt++ {
+		var document []byte
+		err := r.db.QueryRowContext(ctx, "SELECT document FROM "+TableResults+" WHERE id = $1", id).Scan(&document)
+		if err == sql.ErrNoRows {
+			return mongo.ErrNoDocuments
+		}
+		if err != nil {
+			return err
+		}
+
+		var execution testkube.Execution
+		if err = json.Unmarshal(document, &execution); err != nil {
+			return err
+		}
+
+		if err = change(&execution); err != nil {
+			return err
+		}
+
+		args, err := resultArgs(execution)
+		if err != nil {
+			return err
+		}
+
+		result, err := r.db.ExecContext(ctx, "UPDATE "+TableResults+" SET name = $2, number = $3, test_name = $4, test_suite_name = $5, "+
+			"test_type = $6, status = $7, start_time = $8, end_time = $9, labels = $10, document = $11, annotation_categories = $12, "+
+			"parent_execution_id = $13 WHERE id = $1 AND document = $14", append(args, string(document))...)
+		if err != nil {
+			return err
+		}
+
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if updated != 0 {
+			return nil
+		}
+	}
+
+	return common.ErrConcurrentUpdate
+}
+
+func (r *SQLRepository) withOutput(ctx context.Context, result testkube.Execution, testSuiteName string) (testkube.Execution, error) {
+	if result.ExecutionResult == nil || len(result.ExecutionResult.Output) != 0 {
+		return result, nil
+	}
+
+	output, err := r.OutputRepository.GetOutput(ctx, result.Id, result.TestName, testSuiteName)
+	if err == mongo.ErrNoDocuments {
+		err = nil
+	}
+	result.ExecutionResult.Output = output
+	return result, err
+}
+
+func (r *SQLRepository) findOne(ctx context.Context, query string, args ...interface{}) (result testkube.Execution, err error) {
+	var document []byte
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&document)
+	if err == sql.ErrNoRows {
+		return result, mongo.ErrNoDocuments
+	}
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(document, &result)
+	return
+}
+
+func (r *SQLRepository) find(ctx context.Context, query string, args ...interface{}) (result []testkube.Execution, err error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var document []byte
+		if err = rows.Scan(&document); err != nil {
+			return nil, err
+		}
+
+		var execution testkube.Execution
+		if err = json.Unmarshal(document, &execution); err != nil {
+			return nil, err
+		}
+		result = append(result, execution)
+	}
+
+	return result, rows.Err()
+}
+
+func composeSQLQuery(filter Filter) common.SQLQuery {
+	query := common.SQLQuery{}
+
+	if filter.TextSearchDefined() {
+		search := "%" + strings.ToLower(filter.TextSearch()) + "%"
+		query.Add("(LOWER(test_name) LIKE " + query.Arg(search) + " OR LOWER(name) LIKE " + query.Arg(search) + ")")
+	}
+
+	if filter.TestNameDefined() {
+		query.Add("test_name = " + query.Arg(filter.TestName()))
+	}
+
+	if filter.LastNDaysDefined() && !filter.StartDateDefined() {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(time.Now().Add(-time.Duration(filter.LastNDays())*24*time.Hour))))
+	}
+
+	if filter.StartDateDefined() {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(filter.StartDate())))
+	}
+
+	if filter.EndDateDefined() {
+		query.Add("start_time <= " + query.Arg(common.ToUnixMilli(filter.EndDate())))
+	}
+
+	if filter.StatusesDefined() {
+		statuses := make([]string, len(filter.Statuses()))
+		for i, status := range filter.Statuses() {
+			statuses[i] = string(status)
+		}
+		query.AddIn("status", statuses)
+	}
+
+	if filter.Selector() != "" {
+		query.AddSelector(filter.Selector(), "labels")
+	}
+
+	if filter.TypeDefined() {
+		query.Add("test_type = " + query.Arg(filter.Type()))
+	}
+
+	if filter.AnnotationCategoryDefined() {
+		query.Add("annotation_categories->>CAST(" + query.Arg(filter.AnnotationCategory()) + " AS TEXT) IS NOT NULL")
+	}
+
+	if filter.ParentExecutionIdDefined() {
+		query.Add("parent_execution_id = " + query.Arg(filter.ParentExecutionId()))
+	}
+
+	if filter.RetryPending() {
+		query.Add("document->>'retryPending' IS NOT NULL")
+	}
+
+	return query
+}
+
+func resultArgs(result testkube.Execution) ([]interface{}, error) {
+	document, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := result.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsData, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesData, err := json.Marshal(testkube.AnnotationCategories(result.Annotations))
+	if err != nil {
+		return nil, err
+	}
+
+	status := ""
+	if result.ExecutionResult != nil && result.ExecutionResult.Status != nil {
+		status = string(*result.ExecutionResult.Status)
+	}
+
+	return []interface{}{
+		result.Id,
+		result.Name,
+		result.Number,
+		result.TestName,
+		result.TestSuiteName,
+		result.TestType,
+		status,
+		common.ToUnixMilli(result.StartTime),
+		common.ToUnixMilli(result.EndTime),
+		string(labelsData),
+		string(document),
+		string(categoriesData),
+		result.ParentExecutionId,
+	}, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addCursor narrows the query to executions after the cursor of the filter
+func addCursor(query *common.SQLQuery, filter Filter) error {
+	if !filter.CursorDefined() {
+		return nil
+	}
+
+	cursor, err := common.DecodeCursor(filter.Cursor())
+	if err != nil {
+		return err
+	}
+
+	query.AddCursor(cursor)
+	return nil
+}
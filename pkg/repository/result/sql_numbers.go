@@ -0,0 +1,51 @@
+package result
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+func (r *SQLRepository) GetNextExecutionNumber(ctx context.Context, name string) (number int32, err error) {
+	// TODO: modify this when we decide to update the interfaces for OSS and cloud
+	isTestSuite := strings.HasPrefix(name, "ts-")
+
+	err = r.db.QueryRowContext(ctx, "INSERT INTO "+TableSequences+" (name, number, is_test_suite) VALUES ($1, 1, $2) "+
+		"ON CONFLICT (name) DO UPDATE SET number = "+TableSequences+".number + 1 RETURNING number", name, isTestSuite).Scan(&number)
+	if err != nil {
+		return 1, err
+	}
+
+	return number, nil
+}
+
+// UpdateExecutionNumber moves sequence forward to the number, so next executions don't reuse imported numbers
+func (r *SQLRepository) UpdateExecutionNumber(ctx context.Context, name string, number int32) (err error) {
+	isTestSuite := strings.HasPrefix(name, "ts-")
+	_, err = r.db.ExecContext(ctx, "INSERT INTO "+TableSequences+" (name, number, is_test_suite) VALUES ($1, $2, $3) "+
+		"ON CONFLICT (name) DO UPDATE SET number = CASE WHEN "+TableSequences+".number < excluded.number "+
+		"THEN excluded.number ELSE "+TableSequences+".number END", name, number, isTestSuite)
+	return err
+}
+
+func (r *SQLRepository) DeleteExecutionNumber(ctx context.Context, name string) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableSequences+" WHERE name = $1", name)
+	return err
+}
+
+func (r *SQLRepository) DeleteExecutionNumbers(ctx context.Context, names []string) (err error) {
+	if len(names) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("name", names)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableSequences+query.Where(), query.Args()...)
+	return err
+}
+
+func (r *SQLRepository) DeleteAllExecutionNumbers(ctx context.Context, isTestSuite bool) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableSequences+" WHERE is_test_suite = $1", isTestSuite)
+	return err
+}
@@ -0,0 +1,100 @@
+package result
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+const TableOutput = "output"
+
+type SQLOutputRepository struct {
+	db *sql.DB
+}
+
+var _ OutputRepository = (*SQLOutputRepository)(nil)
+
+func NewSQLOutputRepository(db *sql.DB) *SQLOutputRepository {
+	return &SQLOutputRepository{db: db}
+}
+
+func (r *SQLOutputRepository) GetOutput(ctx context.Context, id, testName, testSuiteName string) (output string, err error) {
+	err = r.db.QueryRowContext(ctx, "SELECT output FROM "+TableOutput+" WHERE id = $1 OR name = $2 LIMIT 1", id, id).Scan(&output)
+	if err == sql.ErrNoRows {
+		return "", mongo.ErrNoDocuments
+	}
+	return output, err
+}
+
+func (r *SQLOutputRepository) InsertOutput(ctx context.Context, id, testName, testSuiteName, output string) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO "+TableOutput+" (id, name, test_name, test_suite_name, output) VALUES ($1, $2, $3, $4, $5)",
+		id, id, testName, testSuiteName, output)
+	return err
+}
+
+func (r *SQLOutputRepository) UpdateOutput(ctx context.Context, id, testName, testSuiteName, output string) error {
+	_, err := r.db.ExecContext(ctx, "UPDATE "+TableOutput+" SET output = $1 WHERE id = $2 OR name = $3", output, id, id)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutput(ctx context.Context, id, testName, testSuiteName string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+" WHERE id = $1 OR name = $2", id, id)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutputByTest(ctx context.Context, testName string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+" WHERE test_name = $1", testName)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutputForTests(ctx context.Context, testNames []string) error {
+	if len(testNames) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_name", testNames)
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+query.Where(), query.Args()...)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutputByTestSuite(ctx context.Context, testSuiteName string) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+" WHERE test_suite_name = $1", testSuiteName)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutputForTestSuites(ctx context.Context, testSuiteNames []string) error {
+	if len(testSuiteNames) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_suite_name", testSuiteNames)
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+query.Where(), query.Args()...)
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteOutputForAllTestSuite(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput+" WHERE test_suite_name <> ''")
+	return err
+}
+
+func (r *SQLOutputRepository) DeleteAllOutput(ctx context.Context) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM "+TableOutput)
+	return err
+}
+
+func (r *SQLOutputRepository) StreamOutput(ctx context.Context, executionID, testName, testSuiteName string) (reader io.Reader, err error) {
+	output, err := r.GetOutput(ctx, executionID, testName, testSuiteName)
+	return strings.NewReader(output), err
+}
+
+func (r *SQLOutputRepository) GetOutputSize(ctx context.Context, executionID, testName, testSuiteName string) (size int, err error) {
+	output, err := r.GetOutput(ctx, executionID, testName, testSuiteName)
+	return len(output), err
+}
@@ -0,0 +1,122 @@
+package result
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+)
+
+func getSQLiteRepository(t *testing.T) *SQLRepository {
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return NewSQLRepository(db)
+}
+
+func TestSQLRepository_Storage(t *testing.T) {
+	testStorage(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_Labels(t *testing.T) {
+	testLabels(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_TestExecutionsMetrics(t *testing.T) {
+	testTestExecutionsMetrics(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_Annotations(t *testing.T) {
+	testAnnotations(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_ParentExecutions(t *testing.T) {
+	testParentExecutions(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_RetryPending(t *testing.T) {
+	testRetryPending(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_AdmitExecution(t *testing.T) {
+	testAdmitExecution(t, getSQLiteRepository(t))
+}
+
+func TestSQLRepository_Output(t *testing.T) {
+	assert := require.New(t)
+	repository := getSQLiteRepository(t)
+	status := testkube.RUNNING_ExecutionStatus
+
+	err := repository.Insert(context.Background(), testkube.Execution{
+		Id:              "execution-1",
+		Name:            "test-1",
+		TestName:        "test",
+		ExecutionResult: &testkube.ExecutionResult{Status: &status, Output: "first"},
+	})
+	assert.NoError(err)
+
+	execution, err := repository.Get(context.Background(), "test-1")
+	assert.NoError(err)
+	assert.Equal("first", execution.ExecutionResult.Output)
+
+	status = testkube.FAILED_ExecutionStatus
+	err = repository.UpdateResult(context.Background(), "execution-1", testkube.Execution{
+		TestName:        "test",
+		ExecutionResult: &testkube.ExecutionResult{Status: &status, Output: "second", ErrorMessage: "error"},
+	})
+	assert.NoError(err)
+
+	execution, err = repository.Get(context.Background(), "execution-1")
+	assert.NoError(err)
+	assert.Equal("second", execution.ExecutionResult.Output)
+	assert.Equal(testkube.FAILED_ExecutionStatus, *execution.ExecutionResult.Status)
+	assert.Equal("error", execution.ExecutionResult.ErrorMessage)
+
+	latest, err := repository.GetLatestByTests(context.Background(), []string{"test"})
+	assert.NoError(err)
+	assert.Len(latest, 1)
+
+	assert.NoError(repository.DeleteByTest(context.Background(), "test"))
+	_, err = repository.Get(context.Background(), "execution-1")
+	assert.ErrorIs(err, mongo.ErrNoDocuments)
+}
+
+func TestSQLRepository_ExecutionNumbers(t *testing.T) {
+	assert := require.New(t)
+	repository := getSQLiteRepository(t)
+
+	number, err := repository.GetNextExecutionNumber(context.Background(), "example-test")
+	assert.NoError(err)
+	assert.Equal(int32(1), number)
+
+	number, err = repository.GetNextExecutionNumber(context.Background(), "example-test")
+	assert.NoError(err)
+	assert.Equal(int32(2), number)
+
+	assert.NoError(repository.DeleteExecutionNumber(context.Background(), "example-test"))
+	number, err = repository.GetNextExecutionNumber(context.Background(), "example-test")
+	assert.NoError(err)
+	assert.Equal(int32(1), number)
+}
+
+func TestSQLRepository_UpdateExecutionNumber(t *testing.T) {
+	assert := require.New(t)
+	repository := getSQLiteRepository(t)
+
+	assert.NoError(repository.UpdateExecutionNumber(context.Background(), "example-test", 10))
+	number, err := repository.GetNextExecutionNumber(context.Background(), "example-test")
+	assert.NoError(err)
+	assert.Equal(int32(11), number)
+
+	// sequence is never moved back
+	assert.NoError(repository.UpdateExecutionNumber(context.Background(), "example-test", 5))
+	number, err = repository.GetNextExecutionNumber(context.Background(), "example-test")
+	assert.NoError(err)
+	assert.Equal(int32(12), number)
+}
@@ -0,0 +1,63 @@
+CREATE TABLE IF NOT EXISTS results (
+    id              TEXT PRIMARY KEY,
+    name            TEXT NOT NULL DEFAULT '',
+    number          INTEGER NOT NULL DEFAULT 0,
+    test_name       TEXT NOT NULL DEFAULT '',
+    test_suite_name TEXT NOT NULL DEFAULT '',
+    test_type       TEXT NOT NULL DEFAULT '',
+    status          TEXT NOT NULL DEFAULT '',
+    start_time      BIGINT NOT NULL DEFAULT 0,
+    end_time        BIGINT NOT NULL DEFAULT 0,
+    labels          JSONB NOT NULL DEFAULT '{}',
+    document        JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS results_name_idx ON results (name);
+CREATE INDEX IF NOT EXISTS results_test_name_start_time_idx ON results (test_name, start_time);
+CREATE INDEX IF NOT EXISTS results_test_suite_name_idx ON results (test_suite_name);
+CREATE INDEX IF NOT EXISTS results_start_time_idx ON results (start_time);
+
+CREATE TABLE IF NOT EXISTS output (
+    id              TEXT PRIMARY KEY,
+    name            TEXT NOT NULL DEFAULT '',
+    test_name       TEXT NOT NULL DEFAULT '',
+    test_suite_name TEXT NOT NULL DEFAULT '',
+    output          TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS output_test_name_idx ON output (test_name);
+CREATE INDEX IF NOT EXISTS output_test_suite_name_idx ON output (test_suite_name);
+
+CREATE TABLE IF NOT EXISTS sequences (
+    name          TEXT PRIMARY KEY,
+    number        INTEGER NOT NULL DEFAULT 0,
+    is_test_suite BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS testresults (
+    id              TEXT PRIMARY KEY,
+    name            TEXT NOT NULL DEFAULT '',
+    test_suite_name TEXT NOT NULL DEFAULT '',
+    status          TEXT NOT NULL DEFAULT '',
+    start_time      BIGINT NOT NULL DEFAULT 0,
+    end_time        BIGINT NOT NULL DEFAULT 0,
+    labels          JSONB NOT NULL DEFAULT '{}',
+    document        JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS testresults_name_idx ON testresults (name);
+CREATE INDEX IF NOT EXISTS testresults_test_suite_name_start_time_idx ON testresults (test_suite_name, start_time);
+CREATE INDEX IF NOT EXISTS testresults_start_time_idx ON testresults (start_time);
+
+CREATE TABLE IF NOT EXISTS config (
+    id       TEXT PRIMARY KEY,
+    document JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS triggers (
+    id          TEXT PRIMARY KEY,
+    identifier  TEXT NOT NULL DEFAULT '',
+    cluster_id  TEXT NOT NULL DEFAULT '',
+    acquired_at BIGINT NOT NULL DEFAULT 0,
+    renewed_at  BIGINT NOT NULL DEFAULT 0
+);
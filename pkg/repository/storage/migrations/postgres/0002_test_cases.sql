@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS test_cases (
+    execution_id    TEXT NOT NULL,
+    position        INTEGER NOT NULL,
+    execution_name  TEXT NOT NULL DEFAULT '',
+    test_name       TEXT NOT NULL DEFAULT '',
+    test_suite_name TEXT NOT NULL DEFAULT '',
+    suite           TEXT NOT NULL DEFAULT '',
+    name            TEXT NOT NULL DEFAULT '',
+    classname       TEXT NOT NULL DEFAULT '',
+    duration_ms     INTEGER NOT NULL DEFAULT 0,
+    status          TEXT NOT NULL DEFAULT '',
+    message         TEXT NOT NULL DEFAULT '',
+    start_time      BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (execution_id, position)
+);
+
+CREATE INDEX IF NOT EXISTS test_cases_classname_name_idx ON test_cases (classname, name);
+CREATE INDEX IF NOT EXISTS test_cases_test_name_start_time_idx ON test_cases (test_name, start_time);
+CREATE INDEX IF NOT EXISTS test_cases_test_suite_name_idx ON test_cases (test_suite_name);
+CREATE INDEX IF NOT EXISTS test_cases_start_time_idx ON test_cases (start_time);
@@ -0,0 +1,2 @@
+ALTER TABLE results ADD COLUMN IF NOT EXISTS annotation_categories JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE testresults ADD COLUMN IF NOT EXISTS annotation_categories JSONB NOT NULL DEFAULT '{}';
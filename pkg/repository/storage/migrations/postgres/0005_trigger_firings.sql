@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS trigger_firings (
+    id         TEXT PRIMARY KEY,
+    fired_at   BIGINT NOT NULL DEFAULT 0,
+    dedup_keys TEXT NOT NULL DEFAULT '[]'
+);
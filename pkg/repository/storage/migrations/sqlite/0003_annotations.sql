@@ -0,0 +1,2 @@
+ALTER TABLE results ADD COLUMN annotation_categories TEXT NOT NULL DEFAULT '{}';
+ALTER TABLE testresults ADD COLUMN annotation_categories TEXT NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+ALTER TABLE results ADD COLUMN parent_execution_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS results_parent_execution_id_idx ON results (parent_execution_id);
@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS results_start_time_id_idx ON results (start_time, id);
+CREATE INDEX IF NOT EXISTS results_test_name_start_time_id_idx ON results (test_name, start_time, id);
+CREATE INDEX IF NOT EXISTS testresults_start_time_id_idx ON testresults (start_time, id);
+CREATE INDEX IF NOT EXISTS testresults_test_suite_name_start_time_id_idx ON testresults (test_suite_name, start_time, id);
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"time"
+
+	// register the PostgreSQL database/sql driver
+	_ "github.com/lib/pq"
+
+	"github.com/kubeshop/testkube/pkg/log"
+)
+
+const (
+	TypePostgres = "postgres"
+	// MigrationsTable keeps track of schema migrations already applied to the SQL database
+	MigrationsTable = "schema_migrations"
+	// migrationsLockID is the PostgreSQL advisory lock key held while migrations are applied
+	migrationsLockID = 7365312
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+// GetPostgresDatabase returns a valid connection to the configured PostgreSQL database with all schema migrations applied
+func GetPostgresDatabase(dsn string) (db *sql.DB, err error) {
+	db, err = sql.Open(TypePostgres, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err = db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("could not connect to postgres: %w", err)
+	}
+
+	if err = RunSQLMigrations(context.Background(), db, TypePostgres); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// RunSQLMigrations applies all not yet applied migrations for the given database type in lexical order
+func RunSQLMigrations(ctx context.Context, db *sql.DB, dbType string) error {
+	dir := path.Join("migrations", dbType)
+	entries, err := fs.ReadDir(migrations, dir)
+	if err != nil {
+		return fmt.Errorf("unsupported database type %s: %w", dbType, err)
+	}
+
+	// migrations run on a single connection holding the PostgreSQL advisory lock, so api server replicas
+	// starting at the same time apply them one after another, SQLite is used by a single api server only
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if dbType == TypePostgres {
+		if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationsLockID); err != nil {
+			return fmt.Errorf("could not lock migrations: %w", err)
+		}
+		defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationsLockID)
+	}
+
+	_, err = conn.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+MigrationsTable+" (version TEXT PRIMARY KEY)")
+	if err != nil {
+		return fmt.Errorf("could not create migrations table: %w", err)
+	}
+
+	applied, err := getAppliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	for _, entry := range entries {
+		if entry.IsDir() || applied[entry.Name()] {
+			continue
+		}
+
+		script, err := migrations.ReadFile(path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		log.DefaultLogger.Infow("applying database migration", "type", dbType, "migration", entry.Name())
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.ExecContext(ctx, string(script)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("could not apply migration %s: %w", entry.Name(), err)
+		}
+		if _, err = tx.ExecContext(ctx, "INSERT INTO "+MigrationsTable+" (version) VALUES ($1)", entry.Name()); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("could not record migration %s: %w", entry.Name(), err)
+		}
+		if err = tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getAppliedMigrations returns versions of migrations already recorded in the migrations table
+func getAppliedMigrations(ctx context.Context, conn *sql.Conn) (map[string]bool, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM "+MigrationsTable)
+	if err != nil {
+		return nil, fmt.Errorf("could not read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[string]bool{}
+	for rows.Next() {
+		var version string
+		if err = rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+
+	return applied, rows.Err()
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	// register the SQLite database/sql driver
+	_ "modernc.org/sqlite"
+)
+
+const TypeSQLite = "sqlite"
+
+// GetSQLiteDatabase returns a connection to the embedded SQLite database stored in the given file with all schema migrations applied
+func GetSQLiteDatabase(path string) (db *sql.DB, err error) {
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("could not create sqlite database directory: %w", err)
+	}
+
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)", path)
+	db, err = sql.Open(TypeSQLite, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite allows only one writer at a time
+	db.SetMaxOpenConns(1)
+
+	if err = RunSQLMigrations(context.Background(), db, TypeSQLite); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
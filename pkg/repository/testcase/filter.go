@@ -0,0 +1,113 @@
+package testcase
+
+type FilterImpl struct {
+	FExecutionId   string   `json:"executionId"`
+	FTestName      string   `json:"testName"`
+	FTestSuiteName string   `json:"testSuiteName"`
+	FName          string   `json:"name"`
+	FClassname     string   `json:"classname"`
+	FStatuses      []string `json:"statuses"`
+	FPage          int      `json:"page"`
+	FPageSize      int      `json:"pageSize"`
+}
+
+func NewTestCasesFilter() *FilterImpl {
+	result := FilterImpl{FPage: 0, FPageSize: PageDefaultLimit}
+	return &result
+}
+
+func (f *FilterImpl) WithExecutionId(executionId string) *FilterImpl {
+	f.FExecutionId = executionId
+	return f
+}
+
+func (f *FilterImpl) WithTestName(testName string) *FilterImpl {
+	f.FTestName = testName
+	return f
+}
+
+func (f *FilterImpl) WithTestSuiteName(testSuiteName string) *FilterImpl {
+	f.FTestSuiteName = testSuiteName
+	return f
+}
+
+func (f *FilterImpl) WithName(name string) *FilterImpl {
+	f.FName = name
+	return f
+}
+
+func (f *FilterImpl) WithClassname(classname string) *FilterImpl {
+	f.FClassname = classname
+	return f
+}
+
+func (f *FilterImpl) WithStatuses(statuses []string) *FilterImpl {
+	f.FStatuses = statuses
+	return f
+}
+
+func (f *FilterImpl) WithPage(page int) *FilterImpl {
+	f.FPage = page
+	return f
+}
+
+func (f *FilterImpl) WithPageSize(pageSize int) *FilterImpl {
+	f.FPageSize = pageSize
+	return f
+}
+
+func (f FilterImpl) ExecutionId() string {
+	return f.FExecutionId
+}
+
+func (f FilterImpl) ExecutionIdDefined() bool {
+	return f.FExecutionId != ""
+}
+
+func (f FilterImpl) TestName() string {
+	return f.FTestName
+}
+
+func (f FilterImpl) TestNameDefined() bool {
+	return f.FTestName != ""
+}
+
+func (f FilterImpl) TestSuiteName() string {
+	return f.FTestSuiteName
+}
+
+func (f FilterImpl) TestSuiteNameDefined() bool {
+	return f.FTestSuiteName != ""
+}
+
+func (f FilterImpl) Name() string {
+	return f.FName
+}
+
+func (f FilterImpl) NameDefined() bool {
+	return f.FName != ""
+}
+
+func (f FilterImpl) Classname() string {
+	return f.FClassname
+}
+
+func (f FilterImpl) ClassnameDefined() bool {
+	return f.FClassname != ""
+}
+
+func (f FilterImpl) Statuses() []string {
+	return f.FStatuses
+}
+
+func (f FilterImpl) StatusesDefined() bool {
+	return len(f.FStatuses) != 0
+}
+
+func (f FilterImpl) Page() int {
+	return f.FPage
+}
+
+func (f FilterImpl) PageSize() int {
+	return f.FPageSize
+}
@@ -0,0 +1,44 @@
+package testcase
+
+import (
+	"context"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const PageDefaultLimit int = 100
+
+type Filter interface {
+	ExecutionId() string
+	ExecutionIdDefined() bool
+	TestName() string
+	TestNameDefined() bool
+	TestSuiteName() string
+	TestSuiteNameDefined() bool
+	Name() string
+	NameDefined() bool
+	Classname() string
+	ClassnameDefined() bool
+	Statuses() []string
+	StatusesDefined() bool
+	Page() int
+	PageSize() int
+}
+
+//go:generate mockgen -destination=./mock_repository.go -package=testcase "github.com/kubeshop/testkube/pkg/repository/testcase" Repository
+type Repository interface {
+	// GetTestCases gets test cases using a filter, test cases of the latest executions are returned first
+	GetTestCases(ctx context.Context, filter Filter) ([]testkube.TestCaseResult, error)
+	// Replace replaces test cases of the execution
+	Replace(ctx context.Context, executionId string, testCases []testkube.TestCaseResult) error
+	// DeleteByExecutionIds deletes test cases of the executions
+	DeleteByExecutionIds(ctx context.Context, ids []string) error
+	// DeleteByTests deletes test cases of the tests
+	DeleteByTests(ctx context.Context, testNames []string) error
+	// DeleteByTestSuites deletes test cases of executions started by the test suites
+	DeleteByTestSuites(ctx context.Context, testSuiteNames []string) error
+	// DeleteForAllTestSuites deletes test cases of executions started by any test suite
+	DeleteForAllTestSuites(ctx context.Context) error
+	// DeleteAll deletes all test cases
+	DeleteAll(ctx context.Context) error
+}
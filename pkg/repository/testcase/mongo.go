@@ -0,0 +1,154 @@
+package testcase
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+var _ Repository = (*MongoRepository)(nil)
+
+const CollectionName = "testcases"
+
+func NewMongoRepository(db *mongo.Database, opts ...MongoRepositoryOpt) *MongoRepository {
+	r := &MongoRepository{
+		Coll: db.Collection(CollectionName),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+type MongoRepository struct {
+	Coll *mongo.Collection
+}
+
+func WithMongoRepositoryCollection(collection *mongo.Collection) MongoRepositoryOpt {
+	return func(r *MongoRepository) {
+		r.Coll = collection
+	}
+}
+
+type MongoRepositoryOpt func(*MongoRepository)
+
+// EnsureIndexes creates indexes used by test case queries, like the test_cases table indexes of SQL repository
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "executionid", Value: 1}}},
+		{Keys: bson.D{{Key: "testname", Value: 1}, {Key: "status", Value: 1}, {Key: "starttime", Value: -1}}},
+		{Keys: bson.D{{Key: "testsuitename", Value: 1}, {Key: "starttime", Value: -1}}},
+		{Keys: bson.D{{Key: "classname", Value: 1}, {Key: "name", Value: 1}}},
+		{Keys: bson.D{{Key: "starttime", Value: -1}, {Key: "_id", Value: 1}}},
+	})
+	return err
+}
+
+func (r *MongoRepository) GetTestCases(ctx context.Context, filter Filter) (testCases []testkube.TestCaseResult, err error) {
+	opts := options.Find()
+	// object ids keep the order of test cases inside the execution
+	opts.SetSort(bson.D{{Key: "starttime", Value: -1}, {Key: "_id", Value: 1}})
+	opts.SetSkip(int64(filter.Page() * filter.PageSize()))
+	opts.SetLimit(int64(filter.PageSize()))
+
+	cursor, err := r.Coll.Find(ctx, composeQuery(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	testCases = make([]testkube.TestCaseResult, 0)
+	err = cursor.All(ctx, &testCases)
+	return testCases, err
+}
+
+func (r *MongoRepository) Replace(ctx context.Context, executionId string, testCases []testkube.TestCaseResult) error {
+	if _, err := r.Coll.DeleteMany(ctx, bson.M{"executionid": executionId}); err != nil {
+		return err
+	}
+
+	if len(testCases) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(testCases))
+	for i := range testCases {
+		testCase := testCases[i]
+		testCase.ExecutionId = executionId
+		documents[i] = testCase
+	}
+
+	_, err := r.Coll.InsertMany(ctx, documents, options.InsertMany().SetOrdered(true))
+	return err
+}
+
+// DeleteByExecutionIds deletes test cases of the executions
+func (r *MongoRepository) DeleteByExecutionIds(ctx context.Context, ids []string) error {
+	return r.deleteIn(ctx, "executionid", ids)
+}
+
+// DeleteByTests deletes test cases of the tests
+func (r *MongoRepository) DeleteByTests(ctx context.Context, testNames []string) error {
+	return r.deleteIn(ctx, "testname", testNames)
+}
+
+// DeleteByTestSuites deletes test cases of executions started by the test suites
+func (r *MongoRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames []string) error {
+	return r.deleteIn(ctx, "testsuitename", testSuiteNames)
+}
+
+// DeleteForAllTestSuites deletes test cases of executions started by any test suite
+func (r *MongoRepository) DeleteForAllTestSuites(ctx context.Context) (err error) {
+	_, err = r.Coll.DeleteMany(ctx, bson.M{"testsuitename": bson.M{"$nin": bson.A{nil, ""}}})
+	return
+}
+
+// DeleteAll deletes all test cases
+func (r *MongoRepository) DeleteAll(ctx context.Context) (err error) {
+	_, err = r.Coll.DeleteMany(ctx, bson.M{})
+	return
+}
+
+func (r *MongoRepository) deleteIn(ctx context.Context, field string, values []string) (err error) {
+	if len(values) == 0 {
+		return nil
+	}
+
+	_, err = r.Coll.DeleteMany(ctx, bson.M{field: bson.M{"$in": values}})
+	return
+}
+
+func composeQuery(filter Filter) bson.M {
+	query := bson.M{}
+
+	if filter.ExecutionIdDefined() {
+		query["executionid"] = filter.ExecutionId()
+	}
+
+	if filter.TestNameDefined() {
+		query["testname"] = filter.TestName()
+	}
+
+	if filter.TestSuiteNameDefined() {
+		query["testsuitename"] = filter.TestSuiteName()
+	}
+
+	if filter.NameDefined() {
+		query["name"] = filter.Name()
+	}
+
+	if filter.ClassnameDefined() {
+		query["classname"] = filter.Classname()
+	}
+
+	if filter.StatusesDefined() {
+		query["status"] = bson.M{"$in": filter.Statuses()}
+	}
+
+	return query
+}
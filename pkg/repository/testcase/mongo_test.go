@@ -0,0 +1,61 @@
+//go:build integration
+
+package testcase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/utils/test"
+)
+
+const (
+	mongoDns    = "mongodb://localhost:27017"
+	mongoDbName = "testkube-test"
+)
+
+func TestMongoRepository(t *testing.T) {
+	test.IntegrationTest(t)
+
+	assert := require.New(t)
+
+	db, err := storage.GetMongoDatabase(mongoDns, mongoDbName, storage.TypeMongoDB, false, nil)
+	assert.NoError(err)
+
+	repository := NewMongoRepository(db)
+	assert.NoError(repository.Coll.Drop(context.TODO()))
+	assert.NoError(repository.EnsureIndexes(context.Background()))
+
+	startTime := time.Now().Truncate(time.Millisecond)
+	err = repository.Replace(context.Background(), "1", []testkube.TestCaseResult{
+		{TestName: "login", Classname: "LoginSpec", Name: "should_accept_valid_password", Status: testkube.PASSED_TestCaseStatus, StartTime: startTime},
+		{TestName: "login", Classname: "LoginSpec", Name: "should_reject_bad_password", Status: testkube.FAILED_TestCaseStatus, StartTime: startTime},
+	})
+	assert.NoError(err)
+	err = repository.Replace(context.Background(), "2", []testkube.TestCaseResult{
+		{TestName: "login", Classname: "LoginSpec", Name: "should_reject_bad_password", Status: testkube.FAILED_TestCaseStatus, StartTime: startTime.Add(-time.Hour)},
+	})
+	assert.NoError(err)
+
+	testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter().
+		WithName("should_reject_bad_password").WithStatuses([]string{testkube.FAILED_TestCaseStatus}))
+	assert.NoError(err)
+	assert.Len(testCases, 2)
+	assert.Equal("1", testCases[0].ExecutionId)
+	assert.Equal("2", testCases[1].ExecutionId)
+
+	testCases, err = repository.GetTestCases(context.Background(), NewTestCasesFilter().WithExecutionId("1"))
+	assert.NoError(err)
+	assert.Len(testCases, 2)
+	assert.Equal("should_accept_valid_password", testCases[0].Name)
+
+	assert.NoError(repository.DeleteByTests(context.Background(), []string{"login"}))
+	testCases, err = repository.GetTestCases(context.Background(), NewTestCasesFilter())
+	assert.NoError(err)
+	assert.Empty(testCases)
+}
@@ -0,0 +1,150 @@
+package testcase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+var _ Repository = (*SQLRepository)(nil)
+
+const (
+	TableName = "test_cases"
+
+	testCaseColumns = "execution_id, position, execution_name, test_name, test_suite_name, suite, name, classname, " +
+		"duration_ms, status, message, start_time"
+)
+
+// NewSQLRepository creates test cases repository on top of database/sql connection (e.g. PostgreSQL)
+func NewSQLRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+type SQLRepository struct {
+	db *sql.DB
+}
+
+func (r *SQLRepository) GetTestCases(ctx context.Context, filter Filter) (testCases []testkube.TestCaseResult, err error) {
+	query := composeSQLQuery(filter)
+	limit := query.Arg(filter.PageSize())
+	offset := query.Arg(filter.Page() * filter.PageSize())
+	rows, err := r.db.QueryContext(ctx, "SELECT "+testCaseColumns+" FROM "+TableName+query.Where()+
+		" ORDER BY start_time DESC, execution_id, position LIMIT "+limit+" OFFSET "+offset, query.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	testCases = make([]testkube.TestCaseResult, 0)
+	for rows.Next() {
+		var testCase testkube.TestCaseResult
+		var position int
+		var startTime int64
+		if err = rows.Scan(&testCase.ExecutionId, &position, &testCase.ExecutionName, &testCase.TestName, &testCase.TestSuiteName,
+			&testCase.Suite, &testCase.Name, &testCase.Classname, &testCase.DurationMs, &testCase.Status, &testCase.Message, &startTime); err != nil {
+			return nil, err
+		}
+
+		testCase.StartTime = common.FromUnixMilli(startTime)
+		testCases = append(testCases, testCase)
+	}
+
+	return testCases, rows.Err()
+}
+
+func (r *SQLRepository) Replace(ctx context.Context, executionId string, testCases []testkube.TestCaseResult) (err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM "+TableName+" WHERE execution_id = $1", executionId); err != nil {
+		return err
+	}
+
+	for i, testCase := range testCases {
+		_, err = tx.ExecContext(ctx, "INSERT INTO "+TableName+" ("+testCaseColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+			executionId, i, testCase.ExecutionName, testCase.TestName, testCase.TestSuiteName, testCase.Suite, testCase.Name,
+			testCase.Classname, testCase.DurationMs, testCase.Status, testCase.Message, common.ToUnixMilli(testCase.StartTime))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteByExecutionIds deletes test cases of the executions
+func (r *SQLRepository) DeleteByExecutionIds(ctx context.Context, ids []string) error {
+	return r.deleteIn(ctx, "execution_id", ids)
+}
+
+// DeleteByTests deletes test cases of the tests
+func (r *SQLRepository) DeleteByTests(ctx context.Context, testNames []string) error {
+	return r.deleteIn(ctx, "test_name", testNames)
+}
+
+// DeleteByTestSuites deletes test cases of executions started by the test suites
+func (r *SQLRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames []string) error {
+	return r.deleteIn(ctx, "test_suite_name", testSuiteNames)
+}
+
+// DeleteForAllTestSuites deletes test cases of executions started by any test suite
+func (r *SQLRepository) DeleteForAllTestSuites(ctx context.Context) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName+" WHERE test_suite_name <> ''")
+	return
+}
+
+// DeleteAll deletes all test cases
+func (r *SQLRepository) DeleteAll(ctx context.Context) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName)
+	return
+}
+
+func (r *SQLRepository) deleteIn(ctx context.Context, column string, values []string) (err error) {
+	if len(values) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn(column, values)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName+query.Where(), query.Args()...)
+	return
+}
+
+func composeSQLQuery(filter Filter) common.SQLQuery {
+	query := common.SQLQuery{}
+
+	if filter.ExecutionIdDefined() {
+		query.Add("execution_id = " + query.Arg(filter.ExecutionId()))
+	}
+
+	if filter.TestNameDefined() {
+		query.Add("test_name = " + query.Arg(filter.TestName()))
+	}
+
+	if filter.TestSuiteNameDefined() {
+		query.Add("test_suite_name = " + query.Arg(filter.TestSuiteName()))
+	}
+
+	if filter.NameDefined() {
+		query.Add("name = " + query.Arg(filter.Name()))
+	}
+
+	if filter.ClassnameDefined() {
+		query.Add("classname = " + query.Arg(filter.Classname()))
+	}
+
+	if filter.StatusesDefined() {
+		query.AddIn("status", filter.Statuses())
+	}
+
+	return query
+}
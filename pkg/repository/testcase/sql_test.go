@@ -0,0 +1,95 @@
+package testcase
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+)
+
+func TestSQLRepository(t *testing.T) {
+	assert := require.New(t)
+
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(err)
+	defer db.Close()
+
+	repository := NewSQLRepository(db)
+	replace := func(executionId, testName, testSuiteName string, startTime time.Time, statuses ...string) {
+		var testCases []testkube.TestCaseResult
+		for _, status := range statuses {
+			testCases = append(testCases, testkube.TestCaseResult{
+				ExecutionName: testName + "-" + executionId,
+				TestName:      testName,
+				TestSuiteName: testSuiteName,
+				Suite:         "Login",
+				Name:          "should_reject_bad_password",
+				Classname:     "LoginSpec",
+				DurationMs:    1500,
+				Status:        status,
+				StartTime:     startTime,
+			})
+		}
+		assert.NoError(repository.Replace(context.Background(), executionId, testCases))
+	}
+
+	replace("1", "login", "", time.Now().Add(-time.Hour), testkube.FAILED_TestCaseStatus)
+	replace("2", "login", "auth", time.Now(), testkube.PASSED_TestCaseStatus)
+	replace("3", "logout", "auth", time.Now().Add(-2*time.Hour), testkube.FAILED_TestCaseStatus, testkube.SKIPPED_TestCaseStatus)
+
+	t.Run("filter failed test cases by name", func(t *testing.T) {
+		testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter().
+			WithClassname("LoginSpec").WithName("should_reject_bad_password").WithStatuses([]string{testkube.FAILED_TestCaseStatus}))
+		assert.NoError(err)
+		assert.Len(testCases, 2)
+		assert.Equal("1", testCases[0].ExecutionId)
+		assert.Equal("login-1", testCases[0].ExecutionName)
+		assert.Equal(int32(1500), testCases[0].DurationMs)
+		assert.Equal("3", testCases[1].ExecutionId)
+	})
+
+	t.Run("filter by test and execution", func(t *testing.T) {
+		testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter().WithTestName("login"))
+		assert.NoError(err)
+		assert.Len(testCases, 2)
+		assert.Equal("2", testCases[0].ExecutionId)
+
+		testCases, err = repository.GetTestCases(context.Background(), NewTestCasesFilter().WithExecutionId("3"))
+		assert.NoError(err)
+		assert.Len(testCases, 2)
+		assert.Equal(testkube.FAILED_TestCaseStatus, testCases[0].Status)
+		assert.Equal(testkube.SKIPPED_TestCaseStatus, testCases[1].Status)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter().WithPage(1).WithPageSize(3))
+		assert.NoError(err)
+		assert.Len(testCases, 1)
+	})
+
+	t.Run("replace test cases of execution", func(t *testing.T) {
+		replace("3", "logout", "auth", time.Now().Add(-2*time.Hour), testkube.PASSED_TestCaseStatus)
+
+		testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter().WithExecutionId("3"))
+		assert.NoError(err)
+		assert.Len(testCases, 1)
+		assert.Equal(testkube.PASSED_TestCaseStatus, testCases[0].Status)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.NoError(repository.DeleteByExecutionIds(context.Background(), []string{"1"}))
+		testCases, err := repository.GetTestCases(context.Background(), NewTestCasesFilter())
+		assert.NoError(err)
+		assert.Len(testCases, 2)
+
+		assert.NoError(repository.DeleteForAllTestSuites(context.Background()))
+		testCases, err = repository.GetTestCases(context.Background(), NewTestCasesFilter())
+		assert.NoError(err)
+		assert.Empty(testCases)
+	})
+}
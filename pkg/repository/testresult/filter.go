@@ -7,15 +7,17 @@ import (
 )
 
 type FilterImpl struct {
-	FName       string
-	FLastNDays  int
-	FStartDate  *time.Time
-	FEndDate    *time.Time
-	FStatuses   testkube.TestSuiteExecutionStatuses
-	FPage       int
-	FPageSize   int
-	FTextSearch string
-	FSelector   string
+	FName               string
+	FLastNDays          int
+	FStartDate          *time.Time
+	FEndDate            *time.Time
+	FStatuses           testkube.TestSuiteExecutionStatuses
+	FPage               int
+	FPageSize           int
+	FTextSearch         string
+	FSelector           string
+	FCursor             string
+	FAnnotationCategory string
 }
 
 func NewExecutionsFilter() *FilterImpl {
@@ -72,6 +74,16 @@ func (f *FilterImpl) WithSelector(selector string) *FilterImpl {
 	return f
 }
 
+func (f *FilterImpl) WithCursor(cursor string) *FilterImpl {
+	f.FCursor = cursor
+	return f
+}
+
+func (f *FilterImpl) WithAnnotationCategory(category string) *FilterImpl {
+	f.FAnnotationCategory = category
+	return f
+}
+
 func (f FilterImpl) Name() string {
 	return f.FName
 }
@@ -131,3 +143,28 @@ func (f FilterImpl) TextSearch() string {
 func (f FilterImpl) Selector() string {
 	return f.FSelector
 }
+
+func (f FilterImpl) CursorDefined() bool {
+	return f.FCursor != ""
+}
+
+func (f FilterImpl) Cursor() string {
+	return f.FCursor
+}
+
+func (f FilterImpl) AnnotationCategoryDefined() bool {
+	return f.FAnnotationCategory != ""
+}
+
+func (f FilterImpl) AnnotationCategory() string {
+	return f.FAnnotationCategory
+}
+
+// pageOffset returns number of executions to skip, pages follow the cursor when it's defined
+func pageOffset(filter Filter) int {
+	if filter.CursorDefined() {
+		return 0
+	}
+
+	return filter.Page() * filter.PageSize()
+}
@@ -25,6 +25,10 @@ type Filter interface {
 	TextSearchDefined() bool
 	TextSearch() string
 	Selector() string
+	CursorDefined() bool
+	Cursor() string
+	AnnotationCategoryDefined() bool
+	AnnotationCategory() string
 }
 
 //go:generate mockgen -destination=./mock_repository.go -package=testresult "github.com/kubeshop/testkube/internal/pkg/api/repository/testresult" Repository
@@ -55,6 +59,15 @@ type Repository interface {
 	DeleteAll(ctx context.Context) error
 	// DeleteByTestSuites deletes execution results by test suites
 	DeleteByTestSuites(ctx context.Context, testSuiteNames []string) (err error)
+	// DeleteByIds deletes execution results by ids
+	DeleteByIds(ctx context.Context, ids []string) (err error)
+	// AddAnnotation adds annotation to execution result
+	AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error
+	// DeleteAnnotation deletes annotation from execution result
+	DeleteAnnotation(ctx context.Context, id, annotationID string) error
+	// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+	// is claimed only when its heartbeat is older than staleBefore
+	Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (claimed bool, err error)
 
 	GetTestSuiteMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error)
 }
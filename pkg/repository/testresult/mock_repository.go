@@ -37,6 +37,35 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddAnnotation mocks base method.
+func (m *MockRepository) AddAnnotation(arg0 context.Context, arg1 string, arg2 testkube.ExecutionAnnotation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnnotation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAnnotation indicates an expected call of AddAnnotation.
+func (mr *MockRepositoryMockRecorder) AddAnnotation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnnotation", reflect.TypeOf((*MockRepository)(nil).AddAnnotation), arg0, arg1, arg2)
+}
+
+// Claim mocks base method.
+func (m *MockRepository) Claim(arg0 context.Context, arg1, arg2 string, arg3 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockRepositoryMockRecorder) Claim(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockRepository)(nil).Claim), arg0, arg1, arg2, arg3)
+}
+
 // DeleteAll mocks base method.
 func (m *MockRepository) DeleteAll(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -51,6 +80,34 @@ func (mr *MockRepositoryMockRecorder) DeleteAll(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockRepository)(nil).DeleteAll), arg0)
 }
 
+// DeleteAnnotation mocks base method.
+func (m *MockRepository) DeleteAnnotation(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAnnotation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAnnotation indicates an expected call of DeleteAnnotation.
+func (mr *MockRepositoryMockRecorder) DeleteAnnotation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAnnotation", reflect.TypeOf((*MockRepository)(nil).DeleteAnnotation), arg0, arg1, arg2)
+}
+
+// DeleteByIds mocks base method.
+func (m *MockRepository) DeleteByIds(arg0 context.Context, arg1 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByIds", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByIds indicates an expected call of DeleteByIds.
+func (mr *MockRepositoryMockRecorder) DeleteByIds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByIds", reflect.TypeOf((*MockRepository)(nil).DeleteByIds), arg0, arg1)
+}
+
 // DeleteByTestSuite mocks base method.
 func (m *MockRepository) DeleteByTestSuite(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
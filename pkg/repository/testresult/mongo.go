@@ -45,6 +45,15 @@ func WithMongoRepositoryCollection(collection *mongo.Collection) MongoRepository
 
 type MongoRepositoryOpt func(*MongoRepository)
 
+// EnsureIndexes creates indexes used by cursor pagination of executions, like the testresults table indexes of SQL repository
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.Coll.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "testsuite.name", Value: 1}, {Key: "starttime", Value: -1}, {Key: "id", Value: -1}}},
+	})
+	return err
+}
+
 func (r *MongoRepository) Get(ctx context.Context, id string) (result testkube.TestSuiteExecution, err error) {
 	err = r.Coll.FindOne(ctx, bson.M{"$or": bson.A{bson.M{"id": id}, bson.M{"name": id}}}).Decode(&result)
 	return *result.UnscapeDots(), err
@@ -174,12 +183,16 @@ func (r *MongoRepository) GetExecutionsTotals(ctx context.Context, filter ...Fil
 	query := bson.M{}
 	if len(filter) > 0 {
 		query, _ = composeQueryAndOpts(filter[0])
+		query, err = composeCursorQuery(query, filter[0])
+		if err != nil {
+			return totals, err
+		}
 	}
 
 	pipeline := []bson.D{{{Key: "$match", Value: query}}}
 	if len(filter) > 0 {
-		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "starttime", Value: -1}}}})
-		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(filter[0].Page() * filter[0].PageSize())}})
+		pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}}}})
+		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(pageOffset(filter[0]))}})
 		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: int64(filter[0].PageSize())}})
 	}
 
@@ -227,6 +240,11 @@ func (r *MongoRepository) GetExecutions(ctx context.Context, filter Filter) (res
 		opts.SetAllowDiskUse(r.allowDiskUse)
 	}
 
+	query, err = composeCursorQuery(query, filter)
+	if err != nil {
+		return
+	}
+
 	cursor, err := r.Coll.Find(ctx, query, opts)
 	if err != nil {
 		return
@@ -249,7 +267,12 @@ func (r *MongoRepository) Insert(ctx context.Context, result testkube.TestSuiteE
 func (r *MongoRepository) Update(ctx context.Context, result testkube.TestSuiteExecution) (err error) {
 	result.EscapeDots()
 	result.CleanStepsOutput()
-	_, err = r.Coll.ReplaceOne(ctx, bson.M{"id": result.Id}, result)
+	// annotations and the runner are managed separately, keep the stored ones
+	update, err := common.SetExcept(result, "annotations", "runnerid", "heartbeattime")
+	if err != nil {
+		return
+	}
+	_, err = r.Coll.UpdateOne(ctx, bson.M{"id": result.Id}, update)
 	return
 }
 
@@ -265,6 +288,50 @@ func (r *MongoRepository) EndExecution(ctx context.Context, e testkube.TestSuite
 	return
 }
 
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore
+func (r *MongoRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	result, err := r.Coll.UpdateOne(ctx, bson.M{"id": id, "$or": bson.A{
+		bson.M{"runnerid": runnerID},
+		bson.M{"heartbeattime": bson.M{"$lt": staleBefore}},
+		bson.M{"heartbeattime": bson.M{"$exists": false}},
+	}}, bson.M{"$set": bson.M{"runnerid": runnerID, "heartbeattime": time.Now()}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount != 0, nil
+}
+
+// AddAnnotation adds annotation to execution result
+func (r *MongoRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	result, err := r.Coll.UpdateOne(ctx, bson.M{"id": id}, bson.M{"$push": bson.M{"annotations": annotation}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// DeleteAnnotation deletes annotation from execution result
+func (r *MongoRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	result, err := r.Coll.UpdateOne(ctx, bson.M{"id": id, "annotations.id": annotationID},
+		bson.M{"$pull": bson.M{"annotations": bson.M{"id": annotationID}}})
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
 func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 
 	query := bson.M{}
@@ -321,13 +388,34 @@ func composeQueryAndOpts(filter Filter) (bson.M, *options.FindOptions) {
 		}
 	}
 
-	opts.SetSkip(int64(filter.Page() * filter.PageSize()))
+	if filter.AnnotationCategoryDefined() {
+		query["annotations.category"] = filter.AnnotationCategory()
+	}
+
+	opts.SetSkip(int64(pageOffset(filter)))
 	opts.SetLimit(int64(filter.PageSize()))
-	opts.SetSort(bson.D{{Key: "starttime", Value: -1}})
+	opts.SetSort(bson.D{{Key: "starttime", Value: -1}, {Key: "id", Value: -1}})
 
 	return query, opts
 }
 
+// composeCursorQuery narrows the query to executions after the cursor of the filter
+func composeCursorQuery(query bson.M, filter Filter) (bson.M, error) {
+	if !filter.CursorDefined() {
+		return query, nil
+	}
+
+	cursor, err := common.DecodeCursor(filter.Cursor())
+	if err != nil {
+		return nil, err
+	}
+
+	return bson.M{"$and": bson.A{query, bson.M{"$or": bson.A{
+		bson.M{"starttime": bson.M{"$lt": cursor.StartTime}},
+		bson.M{"starttime": cursor.StartTime, "id": bson.M{"$lt": cursor.Id}},
+	}}}}, nil
+}
+
 // DeleteByTestSuite deletes execution results by test suite
 func (r *MongoRepository) DeleteByTestSuite(ctx context.Context, testSuiteName string) (err error) {
 	_, err = r.Coll.DeleteMany(ctx, bson.M{"testsuite.name": testSuiteName})
@@ -362,6 +450,16 @@ func (r *MongoRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames
 	return
 }
 
+// DeleteByIds deletes execution results by ids
+func (r *MongoRepository) DeleteByIds(ctx context.Context, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = r.Coll.DeleteMany(ctx, bson.M{"id": bson.M{"$in": ids}})
+	return
+}
+
 // GetTestSuiteMetrics returns test executions metrics
 func (r *MongoRepository) GetTestSuiteMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
 	query := bson.M{"testsuite.name": name}
@@ -380,6 +478,7 @@ func (r *MongoRepository) GetTestSuiteMetrics(ctx context.Context, name string,
 				{Key: "duration", Value: 1},
 				{Key: "starttime", Value: 1},
 				{Key: "name", Value: 1},
+				{Key: "labels", Value: 1},
 			},
 		},
 	})
@@ -0,0 +1,471 @@
+package testresult
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+)
+
+var _ Repository = (*SQLRepository)(nil)
+
+// errNotClaimed is returned when the execution is run by another runner with a recent heartbeat
+var errNotClaimed = errors.New("execution is run by another runner")
+
+const (
+	TableName = "testresults"
+
+	testResultColumns = "id, name, test_suite_name, status, start_time, end_time, labels, document, annotation_categories"
+	// updateTimeExpression is the most recent of start and end time, used to find the latest execution
+	updateTimeExpression = "CASE WHEN start_time > end_time THEN start_time ELSE end_time END"
+)
+
+// NewSQLRepository creates test suite execution results repository on top of database/sql connection (e.g. PostgreSQL)
+func NewSQLRepository(db *sql.DB) *SQLRepository {
+	return &SQLRepository{db: db}
+}
+
+type SQLRepository struct {
+	db *sql.DB
+}
+
+func (r *SQLRepository) Get(ctx context.Context, id string) (testkube.TestSuiteExecution, error) {
+	return r.findOne(ctx, "SELECT document FROM "+TableName+" WHERE id = $1 OR name = $2 LIMIT 1", id, id)
+}
+
+func (r *SQLRepository) GetByNameAndTestSuite(ctx context.Context, name, testSuiteName string) (testkube.TestSuiteExecution, error) {
+	return r.findOne(ctx, "SELECT document FROM "+TableName+" WHERE name = $1 AND test_suite_name = $2 LIMIT 1", name, testSuiteName)
+}
+
+func (r *SQLRepository) GetLatestByTestSuite(ctx context.Context, testSuiteName string) (testkube.TestSuiteExecution, error) {
+	return r.findOne(ctx, "SELECT document FROM "+TableName+" WHERE test_suite_name = $1 ORDER BY "+updateTimeExpression+" DESC LIMIT 1", testSuiteName)
+}
+
+func (r *SQLRepository) GetLatestByTestSuites(ctx context.Context, testSuiteNames []string) (executions []testkube.TestSuiteExecution, err error) {
+	if len(testSuiteNames) == 0 {
+		return executions, nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_suite_name", testSuiteNames)
+	return r.find(ctx, "SELECT document FROM ("+
+		"SELECT document, "+updateTimeExpression+" AS update_time, "+
+		"ROW_NUMBER() OVER (PARTITION BY test_suite_name ORDER BY "+updateTimeExpression+" DESC) AS latest_rank "+
+		"FROM "+TableName+query.Where()+") latest WHERE latest_rank = 1 ORDER BY update_time DESC", query.Args()...)
+}
+
+func (r *SQLRepository) GetExecutionsTotals(ctx context.Context, filter ...Filter) (totals testkube.ExecutionsTotals, err error) {
+	query := common.SQLQuery{}
+	source := TableName
+	if len(filter) > 0 {
+		query = composeSQLQuery(filter[0])
+		if err = addCursor(&query, filter[0]); err != nil {
+			return totals, err
+		}
+
+		limit := query.Arg(filter[0].PageSize())
+		offset := query.Arg(pageOffset(filter[0]))
+		source = "(SELECT status FROM " + TableName + query.Where() + " ORDER BY start_time DESC, id DESC LIMIT " + limit + " OFFSET " + offset + ") paged"
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM "+source+" GROUP BY status", query.Args()...)
+	if err != nil {
+		return totals, err
+	}
+	defer rows.Close()
+
+	var sum int32
+	for rows.Next() {
+		var status string
+		var count int32
+		if err = rows.Scan(&status, &count); err != nil {
+			return totals, err
+		}
+
+		sum += count
+		switch testkube.TestSuiteExecutionStatus(status) {
+		case testkube.QUEUED_TestSuiteExecutionStatus:
+			totals.Queued = count
+		case testkube.RUNNING_TestSuiteExecutionStatus:
+			totals.Running = count
+		case testkube.PASSED_TestSuiteExecutionStatus:
+			totals.Passed = count
+		case testkube.FAILED_TestSuiteExecutionStatus:
+			totals.Failed = count
+		}
+	}
+	totals.Results = sum
+
+	return totals, rows.Err()
+}
+
+func (r *SQLRepository) GetExecutions(ctx context.Context, filter Filter) (result []testkube.TestSuiteExecution, err error) {
+	query := composeSQLQuery(filter)
+	if err = addCursor(&query, filter); err != nil {
+		return nil, err
+	}
+
+	limit := query.Arg(filter.PageSize())
+	offset := query.Arg(pageOffset(filter))
+	result, err = r.find(ctx, "SELECT document FROM "+TableName+query.Where()+
+		" ORDER BY start_time DESC, id DESC LIMIT "+limit+" OFFSET "+offset, query.Args()...)
+	if result == nil {
+		result = make([]testkube.TestSuiteExecution, 0)
+	}
+	return
+}
+
+func (r *SQLRepository) Insert(ctx context.Context, result testkube.TestSuiteExecution) (err error) {
+	result.CleanStepsOutput()
+	args, err := testResultArgs(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, "INSERT INTO "+TableName+" ("+testResultColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)", args...)
+	return
+}
+
+func (r *SQLRepository) Update(ctx context.Context, result testkube.TestSuiteExecution) (err error) {
+	result.CleanStepsOutput()
+	// annotations and the runner are managed separately, keep the stored ones
+	err = r.update(ctx, result.Id, func(execution *testkube.TestSuiteExecution) error {
+		annotations, runnerID, heartbeatTime := execution.Annotations, execution.RunnerId, execution.HeartbeatTime
+		*execution = result
+		execution.Annotations, execution.RunnerId, execution.HeartbeatTime = annotations, runnerID, heartbeatTime
+		return nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// StartExecution updates execution start time
+func (r *SQLRepository) StartExecution(ctx context.Context, id string, startTime time.Time) (err error) {
+	err = r.update(ctx, id, func(execution *testkube.TestSuiteExecution) error {
+		execution.StartTime = startTime
+		return nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// EndExecution updates execution end time
+func (r *SQLRepository) EndExecution(ctx context.Context, e testkube.TestSuiteExecution) (err error) {
+	err = r.update(ctx, e.Id, func(execution *testkube.TestSuiteExecution) error {
+		execution.EndTime = e.EndTime
+		execution.Duration = e.Duration
+		execution.DurationMs = e.DurationMs
+		return nil
+	})
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	return err
+}
+
+// Claim sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore
+func (r *SQLRepository) Claim(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error) {
+	err := r.update(ctx, id, func(execution *testkube.TestSuiteExecution) error {
+		if execution.RunnerId != runnerID && !execution.HeartbeatTime.Before(staleBefore) {
+			return errNotClaimed
+		}
+
+		execution.RunnerId = runnerID
+		execution.HeartbeatTime = time.Now()
+		return nil
+	})
+	if err == errNotClaimed || err == mongo.ErrNoDocuments {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// AddAnnotation adds annotation to execution result
+func (r *SQLRepository) AddAnnotation(ctx context.Context, id string, annotation testkube.ExecutionAnnotation) error {
+	return r.update(ctx, id, func(execution *testkube.TestSuiteExecution) error {
+		execution.Annotations = append(execution.Annotations, annotation)
+		return nil
+	})
+}
+
+// DeleteAnnotation deletes annotation from execution result
+func (r *SQLRepository) DeleteAnnotation(ctx context.Context, id, annotationID string) error {
+	return r.update(ctx, id, func(execution *testkube.TestSuiteExecution) error {
+		for i, annotation := range execution.Annotations {
+			if annotation.Id == annotationID {
+				execution.Annotations = append(execution.Annotations[:i], execution.Annotations[i+1:]...)
+				return nil
+			}
+		}
+
+		return mongo.ErrNoDocuments
+	})
+}
+
+// DeleteByTestSuite deletes execution results by test suite
+func (r *SQLRepository) DeleteByTestSuite(ctx context.Context, testSuiteName string) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName+" WHERE test_suite_name = $1", testSuiteName)
+	return
+}
+
+// DeleteAll deletes all execution results
+func (r *SQLRepository) DeleteAll(ctx context.Context) (err error) {
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName)
+	return
+}
+
+// DeleteByTestSuites deletes execution results by test suites
+func (r *SQLRepository) DeleteByTestSuites(ctx context.Context, testSuiteNames []string) (err error) {
+	if len(testSuiteNames) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("test_suite_name", testSuiteNames)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName+query.Where(), query.Args()...)
+	return
+}
+
+// DeleteByIds deletes execution results by ids
+func (r *SQLRepository) DeleteByIds(ctx context.Context, ids []string) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := common.SQLQuery{}
+	query.AddIn("id", ids)
+	_, err = r.db.ExecContext(ctx, "DELETE FROM "+TableName+query.Where(), query.Args()...)
+	return
+}
+
+// GetTestSuiteMetrics returns test executions metrics
+func (r *SQLRepository) GetTestSuiteMetrics(ctx context.Context, name string, limit, last int) (metrics testkube.ExecutionsMetrics, err error) {
+	query := common.SQLQuery{}
+	query.Add("test_suite_name = " + query.Arg(name))
+	if last > 0 {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(time.Now().Add(-time.Duration(last)*24*time.Hour))))
+	}
+
+	executions, err := r.find(ctx, "SELECT document FROM "+TableName+query.Where()+" ORDER BY start_time DESC", query.Args()...)
+	if err != nil {
+		return metrics, err
+	}
+
+	metricsExecutions := make([]testkube.ExecutionsMetricsExecutions, len(executions))
+	for i, execution := range executions {
+		metricsExecutions[i] = testkube.ExecutionsMetricsExecutions{
+			ExecutionId: execution.Id,
+			Duration:    execution.Duration,
+			Name:        execution.Name,
+			StartTime:   execution.StartTime,
+			Labels:      execution.Labels,
+		}
+		if execution.Status != nil {
+			metricsExecutions[i].Status = string(*execution.Status)
+		}
+	}
+
+	metrics = common.CalculateMetrics(metricsExecutions)
+	if limit > 0 && limit < len(metrics.Executions) {
+		metrics.Executions = metrics.Executions[:limit]
+	}
+
+	return metrics, nil
+}
+
+// update applies the change to the stored execution and writes it back only when the document
+// was not modified in the meantime, so concurrent updates don't overwrite each other
+func (r *SQLRepository) update(ctx context.Context, id string, change func(execution *testkube.TestSuiteExecution) error) error {
+	for attempt := 0; attempt < common.MaxUpdateAttempts; attempt++ {
+		var document []byte
+		err := r.db.QueryRowContext(ctx, "SELECT document FROM "+TableName+" WHERE id = $1", id).Scan(&document)
+		if err == sql.ErrNoRows {
+			return mongo.ErrNoDocuments
+		}
+		if err != nil {
+			return err
+		}
+
+		var execution testkube.TestSuiteExecution
+		if err = json.Unmarshal(document, &execution); err != nil {
+			return err
+		}
+
+		if err = change(&execution); err != nil {
+			return err
+		}
+
+		args, err := testResultArgs(execution)
+		if err != nil {
+			return err
+		}
+
+		result, err := r.db.ExecContext(ctx, "UPDATE "+TableName+" SET name = $2, test_suite_name = $3, status = $4, "+
+			"start_time = $5, end_time = $6, labels = $7, document = $8, annotation_categories = $9 WHERE id = $1 AND document = $10",
+			append(args, string(document))...)
+		if err != nil {
+			return err
+		}
+
+		updated, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if updated != 0 {
+			return nil
+		}
+	}
+
+	return common.ErrConcurrentUpdate
+}
+
+func (r *SQLRepository) findOne(ctx context.Context, query string, args ...interface{}) (result testkube.TestSuiteExecution, err error) {
+	var document []byte
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&document)
+	if err == sql.ErrNoRows {
+		return result, mongo.ErrNoDocuments
+	}
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(document, &result)
+	return
+}
+
+func (r *SQLRepository) find(ctx context.Context, query string, args ...interface{}) (result []testkube.TestSuiteExecution, err error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var document []byte
+		if err = rows.Scan(&document); err != nil {
+			return nil, err
+		}
+
+		var execution testkube.TestSuiteExecution
+		if err = json.Unmarshal(document, &execution); err != nil {
+			return nil, err
+		}
+		result = append(result, execution)
+	}
+
+	return result, rows.Err()
+}
+
+func composeSQLQuery(filter Filter) common.SQLQuery {
+	query := common.SQLQuery{}
+
+	if filter.NameDefined() {
+		query.Add("test_suite_name = " + query.Arg(filter.Name()))
+	}
+
+	if filter.TextSearchDefined() {
+		query.Add("LOWER(name) LIKE " + query.Arg("%"+strings.ToLower(filter.TextSearch())+"%"))
+	}
+
+	if filter.LastNDaysDefined() && !filter.StartDateDefined() {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(time.Now().Add(-time.Duration(filter.LastNDays())*24*time.Hour))))
+	}
+
+	if filter.StartDateDefined() {
+		query.Add("start_time >= " + query.Arg(common.ToUnixMilli(filter.StartDate())))
+	}
+
+	if filter.EndDateDefined() {
+		query.Add("start_time <= " + query.Arg(common.ToUnixMilli(filter.EndDate())))
+	}
+
+	if filter.StatusesDefined() {
+		statuses := make([]string, len(filter.Statuses()))
+		for i, status := range filter.Statuses() {
+			statuses[i] = string(status)
+		}
+		query.AddIn("status", statuses)
+	}
+
+	if filter.Selector() != "" {
+		query.AddSelector(filter.Selector(), "labels")
+	}
+
+	if filter.AnnotationCategoryDefined() {
+		query.Add("annotation_categories->>CAST(" + query.Arg(filter.AnnotationCategory()) + " AS TEXT) IS NOT NULL")
+	}
+
+	return query
+}
+
+func testResultArgs(result testkube.TestSuiteExecution) ([]interface{}, error) {
+	document, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := result.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labelsData, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	categoriesData, err := json.Marshal(testkube.AnnotationCategories(result.Annotations))
+	if err != nil {
+		return nil, err
+	}
+
+	testSuiteName := ""
+	if result.TestSuite != nil {
+		testSuiteName = result.TestSuite.Name
+	}
+
+	status := ""
+	if result.Status != nil {
+		status = string(*result.Status)
+	}
+
+	return []interface{}{
+		result.Id,
+		result.Name,
+		testSuiteName,
+		status,
+		common.ToUnixMilli(result.StartTime),
+		common.ToUnixMilli(result.EndTime),
+		string(labelsData),
+		string(document),
+		string(categoriesData),
+	}, nil
+}
+
+// addCursor narrows the query to executions after the cursor of the filter
+func addCursor(query *common.SQLQuery, filter Filter) error {
+	if !filter.CursorDefined() {
+		return nil
+	}
+
+	cursor, err := common.DecodeCursor(filter.Cursor())
+	if err != nil {
+		return err
+	}
+
+	query.AddCursor(cursor)
+	return nil
+}
@@ -0,0 +1,157 @@
+package testresult
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+)
+
+func TestSQLRepository(t *testing.T) {
+	assert := require.New(t)
+
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(err)
+	defer db.Close()
+
+	repository := NewSQLRepository(db)
+	insert := func(id string, status testkube.TestSuiteExecutionStatus, startTime time.Time, labels map[string]string) {
+		err := repository.Insert(context.Background(), testkube.TestSuiteExecution{
+			Id:        id,
+			Name:      "ts-example-" + id,
+			TestSuite: &testkube.ObjectRef{Name: "example"},
+			Status:    &status,
+			StartTime: startTime,
+			EndTime:   startTime.Add(time.Minute),
+			Duration:  time.Minute.String(),
+			Labels:    labels,
+		})
+		assert.NoError(err)
+	}
+
+	insert("1", testkube.PASSED_TestSuiteExecutionStatus, time.Now().Add(-48*time.Hour), map[string]string{"key1": "value1"})
+	insert("2", testkube.FAILED_TestSuiteExecutionStatus, time.Now().Add(-time.Hour), map[string]string{"key2": "value2"})
+	insert("3", testkube.RUNNING_TestSuiteExecutionStatus, time.Now(), nil)
+
+	t.Run("get by id or name", func(t *testing.T) {
+		execution, err := repository.Get(context.Background(), "ts-example-2")
+		assert.NoError(err)
+		assert.Equal("2", execution.Id)
+
+		_, err = repository.Get(context.Background(), "missing")
+		assert.ErrorIs(err, mongo.ErrNoDocuments)
+	})
+
+	t.Run("latest by test suite", func(t *testing.T) {
+		execution, err := repository.GetLatestByTestSuite(context.Background(), "example")
+		assert.NoError(err)
+		assert.Equal("3", execution.Id)
+	})
+
+	t.Run("filter executions", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithName("example").WithSelector("key2=value2"))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal("2", executions[0].Id)
+
+		executions, err = repository.GetExecutions(context.Background(), NewExecutionsFilter().WithLastNDays(1))
+		assert.NoError(err)
+		assert.Len(executions, 2)
+	})
+
+	t.Run("filter executions by cursor", func(t *testing.T) {
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithPageSize(2))
+		assert.NoError(err)
+		assert.Len(executions, 2)
+		assert.Equal("3", executions[0].Id)
+
+		last := executions[1]
+		filter := NewExecutionsFilter().WithPageSize(2).WithCursor(common.EncodeCursor(last.StartTime, last.Id))
+		executions, err = repository.GetExecutions(context.Background(), filter)
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal("1", executions[0].Id)
+
+		totals, err := repository.GetExecutionsTotals(context.Background(), filter)
+		assert.NoError(err)
+		assert.Equal(int32(1), totals.Results)
+	})
+
+	t.Run("totals", func(t *testing.T) {
+		totals, err := repository.GetExecutionsTotals(context.Background())
+		assert.NoError(err)
+		assert.Equal(int32(3), totals.Results)
+		assert.Equal(int32(1), totals.Failed)
+		assert.Equal(int32(1), totals.Running)
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		metrics, err := repository.GetTestSuiteMetrics(context.Background(), "example", 10, 1)
+		assert.NoError(err)
+		assert.Equal(int32(2), metrics.TotalExecutions)
+		assert.Equal(int32(1), metrics.FailedExecutions)
+	})
+
+	t.Run("end execution", func(t *testing.T) {
+		endTime := time.Now().Add(time.Hour)
+		assert.NoError(repository.EndExecution(context.Background(), testkube.TestSuiteExecution{Id: "3", EndTime: endTime, Duration: "1h"}))
+
+		execution, err := repository.Get(context.Background(), "3")
+		assert.NoError(err)
+		assert.Equal("1h", execution.Duration)
+		assert.True(endTime.Equal(execution.EndTime))
+	})
+
+	t.Run("annotations", func(t *testing.T) {
+		annotation := testkube.NewExecutionAnnotation(testkube.ExecutionAnnotation{Text: "cluster upgrade", Category: "infra"})
+		assert.NoError(repository.AddAnnotation(context.Background(), "2", annotation))
+
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter().WithAnnotationCategory("infra"))
+		assert.NoError(err)
+		assert.Len(executions, 1)
+		assert.Equal("2", executions[0].Id)
+		assert.Equal(annotation.Text, executions[0].Annotations[0].Text)
+
+		assert.NoError(repository.DeleteAnnotation(context.Background(), "2", annotation.Id))
+		assert.ErrorIs(repository.DeleteAnnotation(context.Background(), "2", annotation.Id), mongo.ErrNoDocuments)
+
+		executions, err = repository.GetExecutions(context.Background(), NewExecutionsFilter().WithAnnotationCategory("infra"))
+		assert.NoError(err)
+		assert.Empty(executions)
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		claimed, err := repository.Claim(context.Background(), "3", "runner-1", time.Now().Add(-time.Minute))
+		assert.NoError(err)
+		assert.True(claimed)
+
+		claimed, err = repository.Claim(context.Background(), "3", "runner-2", time.Now().Add(-time.Minute))
+		assert.NoError(err)
+		assert.False(claimed)
+
+		claimed, err = repository.Claim(context.Background(), "3", "runner-2", time.Now().Add(time.Minute))
+		assert.NoError(err)
+		assert.True(claimed)
+
+		status := testkube.RUNNING_TestSuiteExecutionStatus
+		assert.NoError(repository.Update(context.Background(), testkube.TestSuiteExecution{Id: "3", Name: "ts-example-3",
+			TestSuite: &testkube.ObjectRef{Name: "example"}, Status: &status}))
+		execution, err := repository.Get(context.Background(), "3")
+		assert.NoError(err)
+		assert.Equal("runner-2", execution.RunnerId)
+	})
+
+	t.Run("delete by test suite", func(t *testing.T) {
+		assert.NoError(repository.DeleteByTestSuite(context.Background(), "example"))
+		executions, err := repository.GetExecutions(context.Background(), NewExecutionsFilter())
+		assert.NoError(err)
+		assert.Empty(executions)
+	})
+}
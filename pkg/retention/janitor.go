@@ -0,0 +1,354 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/storage"
+	"github.com/kubeshop/testkube/pkg/storage/local"
+	"github.com/kubeshop/testkube/pkg/storage/minio"
+	"github.com/kubeshop/testkube/pkg/utils"
+)
+
+// leaseClusterID identifies the lease of the janitor, separate from the test triggers lease
+const leaseClusterID = "testkube-api-retention"
+
+// LeaseBackend acquires lease shared by api server replicas, implemented by the test triggers lease backends
+type LeaseBackend interface {
+	TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error)
+}
+
+// NewJanitor returns janitor enforcing retention policies stored in the config,
+// test cases repository and storage client are optional, test cases and artifacts are kept when they are nil
+func NewJanitor(
+	configRepository config.Repository,
+	resultsRepository result.Repository,
+	testResultsRepository testresult.Repository,
+	testCasesRepository testcase.Repository,
+	storageClient storage.Client,
+	log *zap.SugaredLogger,
+) *Janitor {
+	return &Janitor{
+		configRepository:      configRepository,
+		resultsRepository:     resultsRepository,
+		testResultsRepository: testResultsRepository,
+		testCasesRepository:   testCasesRepository,
+		storageClient:         storageClient,
+		log:                   log,
+		now:                   time.Now,
+		pageSize:              result.PageDefaultLimit,
+	}
+}
+
+// Janitor deletes executions together with their outputs, test cases and artifacts not kept by retention policies
+type Janitor struct {
+	configRepository      config.Repository
+	resultsRepository     result.Repository
+	testResultsRepository testresult.Repository
+	testCasesRepository   testcase.Repository
+	storageClient         storage.Client
+	log                   *zap.SugaredLogger
+	now                   func() time.Time
+	pageSize              int
+	leaseBackend          LeaseBackend
+	identifier            string
+}
+
+// WithLease makes the janitor enforce retention policies only while it holds the lease,
+// so only one api server replica deletes executions at a time
+func (j *Janitor) WithLease(leaseBackend LeaseBackend) *Janitor {
+	j.leaseBackend = leaseBackend
+	j.identifier, _ = os.Hostname()
+	if j.identifier == "" {
+		j.identifier = "testkube-api-" + utils.RandAlphanum(10)
+	}
+
+	return j
+}
+
+// holdsLease checks if the janitor holds the lease, janitor without lease backend always holds it
+func (j *Janitor) holdsLease(ctx context.Context) bool {
+	if j.leaseBackend == nil {
+		return true
+	}
+
+	leased, err := j.leaseBackend.TryAcquire(ctx, j.identifier, leaseClusterID)
+	if err != nil {
+		j.log.Errorw("error acquiring retention lease", "error", err)
+		return false
+	}
+
+	return leased
+}
+
+// Run enforces retention policies in given interval until the context is done
+func (j *Janitor) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if j.holdsLease(ctx) {
+			report, err := j.Enforce(ctx, dryRun)
+			if err != nil {
+				j.log.Errorw("error enforcing retention policies", "error", err)
+			}
+
+			for _, item := range append(report.Executions, report.TestSuiteExecutions...) {
+				j.log.Infow("execution removed by retention policy", "dryRun", dryRun, "id", item.Id, "name", item.Name,
+					"test", item.Test, "testSuite", item.TestSuite, "artifacts", item.Artifacts)
+			}
+		} else {
+			j.log.Debugw("retention lease is held by other replica, skipping retention policies")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Enforce deletes executions not kept by retention policies and reports them, in dry run mode nothing is deleted
+func (j *Janitor) Enforce(ctx context.Context, dryRun bool) (report testkube.RetentionReport, err error) {
+	report.DryRun = dryRun
+	cfg, err := j.configRepository.Get(ctx)
+	if err != nil {
+		return report, fmt.Errorf("getting retention policies: %w", err)
+	}
+
+	now := j.now()
+	var errs []error
+	for _, policy := range cfg.RetentionPolicies {
+		// the lease expires during long runs, stop when other replica took it over
+		if !j.holdsLease(ctx) {
+			j.log.Warnw("retention lease was lost, skipping remaining retention policies")
+			break
+		}
+
+		if err = policy.Validate(); err != nil {
+			j.log.Warnw("skipping invalid retention policy", "policy", policy, "error", err)
+			continue
+		}
+
+		var executions, testSuiteExecutions []testkube.RetentionReportItem
+		if policy.Test != "" {
+			executions, err = j.enforceTestPolicy(ctx, policy, now, dryRun)
+		} else {
+			testSuiteExecutions, executions, err = j.enforceTestSuitePolicy(ctx, policy, now, dryRun)
+		}
+
+		report.Executions = append(report.Executions, executions...)
+		report.TestSuiteExecutions = append(report.TestSuiteExecutions, testSuiteExecutions...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		return report, errors.Join(errs...)
+	}
+
+	return report, nil
+}
+
+// enforceTestPolicy pages executions of the test with the cursor from the latest one, so only one page is loaded
+// at a time and deleting expired executions doesn't shift the following pages
+func (j *Janitor) enforceTestPolicy(ctx context.Context, policy testkube.RetentionPolicy, now time.Time, dryRun bool) (
+	items []testkube.RetentionReportItem, err error) {
+	for position, cursor := 0, ""; ; {
+		filter := result.NewExecutionsFilter().WithTestName(policy.Test).WithCursor(cursor).WithPageSize(j.pageSize)
+		executions, err := j.resultsRepository.GetExecutions(ctx, filter)
+		if err != nil {
+			return items, fmt.Errorf("getting executions of test %s: %w", policy.Test, err)
+		}
+
+		candidates := make([]candidate, len(executions))
+		for i := range executions {
+			candidates[i] = executionCandidate(executions[i])
+		}
+
+		var expiredExecutions []testkube.Execution
+		for _, i := range expired(policy, candidates, position, now) {
+			expiredExecutions = append(expiredExecutions, executions[i])
+		}
+
+		deleted, err := j.deleteExecutions(ctx, expiredExecutions, dryRun)
+		items = append(items, deleted...)
+		if err != nil {
+			return items, fmt.Errorf("deleting executions of test %s: %w", policy.Test, err)
+		}
+
+		if len(executions) < j.pageSize {
+			return items, nil
+		}
+
+		position += len(executions)
+		last := executions[len(executions)-1]
+		cursor = common.EncodeCursor(last.StartTime, last.Id)
+	}
+}
+
+// enforceTestSuitePolicy pages executions of the test suite the same way as enforceTestPolicy
+func (j *Janitor) enforceTestSuitePolicy(ctx context.Context, policy testkube.RetentionPolicy, now time.Time, dryRun bool) (
+	testSuiteItems, items []testkube.RetentionReportItem, err error) {
+	for position, cursor := 0, ""; ; {
+		filter := testresult.NewExecutionsFilter().WithName(policy.TestSuite).WithCursor(cursor).WithPageSize(j.pageSize)
+		executions, err := j.testResultsRepository.GetExecutions(ctx, filter)
+		if err != nil {
+			return testSuiteItems, items, fmt.Errorf("getting executions of test suite %s: %w", policy.TestSuite, err)
+		}
+
+		candidates := make([]candidate, len(executions))
+		for i := range executions {
+			candidates[i] = testSuiteExecutionCandidate(executions[i])
+		}
+
+		var ids []string
+		var stepExecutions []testkube.Execution
+		var expiredItems []testkube.RetentionReportItem
+		for _, i := range expired(policy, candidates, position, now) {
+			execution := executions[i]
+			ids = append(ids, execution.Id)
+			stepExecutions = append(stepExecutions, getStepExecutions(execution)...)
+			expiredItems = append(expiredItems, testkube.RetentionReportItem{
+				Id:        execution.Id,
+				Name:      execution.Name,
+				TestSuite: policy.TestSuite,
+				Status:    testSuiteExecutionStatus(execution),
+				StartTime: execution.StartTime,
+			})
+		}
+
+		// steps are removed together with the test suite execution
+		deleted, err := j.deleteExecutions(ctx, stepExecutions, dryRun)
+		items = append(items, deleted...)
+		if err != nil {
+			return testSuiteItems, items, fmt.Errorf("deleting step executions of test suite %s: %w", policy.TestSuite, err)
+		}
+
+		if !dryRun {
+			if err = j.testResultsRepository.DeleteByIds(ctx, ids); err != nil {
+				return testSuiteItems, items, fmt.Errorf("deleting executions of test suite %s: %w", policy.TestSuite, err)
+			}
+		}
+		testSuiteItems = append(testSuiteItems, expiredItems...)
+
+		if len(executions) < j.pageSize {
+			return testSuiteItems, items, nil
+		}
+
+		position += len(executions)
+		last := executions[len(executions)-1]
+		cursor = common.EncodeCursor(last.StartTime, last.Id)
+	}
+}
+
+// deleteExecutions deletes artifacts of the executions first, so they are not orphaned when deleting the results fails
+func (j *Janitor) deleteExecutions(ctx context.Context, executions []testkube.Execution, dryRun bool) (
+	items []testkube.RetentionReportItem, err error) {
+	var ids []string
+	for _, execution := range executions {
+		artifacts, err := j.deleteArtifacts(ctx, execution, dryRun)
+		if err != nil {
+			return items, fmt.Errorf("deleting artifacts of execution %s: %w", execution.Id, err)
+		}
+
+		ids = append(ids, execution.Id)
+		items = append(items, testkube.RetentionReportItem{
+			Id:        execution.Id,
+			Name:      execution.Name,
+			Test:      execution.TestName,
+			TestSuite: execution.TestSuiteName,
+			Status:    executionStatus(execution),
+			StartTime: execution.StartTime,
+			Artifacts: int32(artifacts),
+		})
+	}
+
+	if dryRun {
+		return items, nil
+	}
+
+	if j.testCasesRepository != nil {
+		if err = j.testCasesRepository.DeleteByExecutionIds(ctx, ids); err != nil {
+			return items, fmt.Errorf("deleting test cases: %w", err)
+		}
+	}
+
+	return items, j.resultsRepository.DeleteByIds(ctx, ids)
+}
+
+func (j *Janitor) deleteArtifacts(ctx context.Context, execution testkube.Execution, dryRun bool) (count int, err error) {
+	// artifacts stored in custom buckets or not in the folder per execution can't be told apart from other files
+	if j.storageClient == nil || execution.Id == "" ||
+		(execution.ArtifactRequest != nil && (execution.ArtifactRequest.StorageBucket != "" || execution.ArtifactRequest.OmitFolderPerExecution)) {
+		return 0, nil
+	}
+
+	files, err := j.storageClient.ListFiles(ctx, execution.Id)
+	if err != nil {
+		if errors.Is(err, minio.ErrArtifactsNotFound) || errors.Is(err, local.ErrArtifactsNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	if dryRun {
+		return len(files), nil
+	}
+
+	for _, file := range files {
+		if err = j.storageClient.DeleteFile(ctx, execution.Id, file.Name); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+func getStepExecutions(execution testkube.TestSuiteExecution) (executions []testkube.Execution) {
+	for _, step := range execution.StepResults {
+		if step.Execution != nil && step.Execution.Id != "" {
+			executions = append(executions, *step.Execution)
+		}
+	}
+
+	for _, batch := range execution.ExecuteStepResults {
+		for _, step := range batch.Execute {
+			if step.Execution != nil && step.Execution.Id != "" {
+				executions = append(executions, *step.Execution)
+			}
+		}
+	}
+
+	return executions
+}
+
+func executionStatus(execution testkube.Execution) string {
+	if execution.ExecutionResult == nil || execution.ExecutionResult.Status == nil {
+		return ""
+	}
+
+	return string(*execution.ExecutionResult.Status)
+}
+
+func testSuiteExecutionStatus(execution testkube.TestSuiteExecution) string {
+	if execution.Status == nil {
+		return ""
+	}
+
+	return string(*execution.Status)
+}
@@ -0,0 +1,154 @@
+package retention
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testcase"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+	"github.com/kubeshop/testkube/pkg/storage/local"
+	"github.com/kubeshop/testkube/pkg/triggers"
+)
+
+func TestJanitor_Enforce(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	configRepository := config.NewSQLRepository(db)
+	resultsRepository := result.NewSQLRepository(db)
+	testResultsRepository := testresult.NewSQLRepository(db)
+	testCasesRepository := testcase.NewSQLRepository(db)
+	storageClient := local.NewClient(t.TempDir(), "testkube-artifacts")
+	janitor := NewJanitor(configRepository, resultsRepository, testResultsRepository, testCasesRepository, storageClient, log.DefaultLogger)
+	// executions are paged, so the kept ones are counted across pages
+	janitor.pageSize = 2
+
+	now := time.Now()
+	passed := testkube.PASSED_ExecutionStatus
+	insertExecution := func(id, testName, testSuiteName string, startTime time.Time) testkube.Execution {
+		execution := testkube.Execution{
+			Id:              id,
+			Name:            id,
+			TestName:        testName,
+			TestSuiteName:   testSuiteName,
+			StartTime:       startTime,
+			EndTime:         startTime.Add(time.Minute),
+			ExecutionResult: &testkube.ExecutionResult{Status: &passed, Output: "output " + id},
+		}
+		require.NoError(t, resultsRepository.Insert(ctx, execution))
+		require.NoError(t, storageClient.UploadFile(ctx, id, "report.xml", strings.NewReader("report"), 6))
+		require.NoError(t, testCasesRepository.Replace(ctx, id, []testkube.TestCaseResult{{TestName: testName, Name: "case", Status: testkube.PASSED_TestCaseStatus}}))
+		return execution
+	}
+
+	insertExecution("test-1", "test", "", now.Add(-time.Hour))
+	insertExecution("test-2", "test", "", now.Add(-2*time.Hour))
+	insertExecution("test-3", "test", "", now.Add(-3*time.Hour))
+	insertExecution("other-1", "other", "", now.Add(-100*day))
+
+	suiteStatus := testkube.PASSED_TestSuiteExecutionStatus
+	for i, startTime := range []time.Time{now.Add(-10 * day), now.Add(-40 * day)} {
+		id := []string{"suite-1", "suite-2"}[i]
+		step := insertExecution(id+"-step", "step-test", "suite", startTime)
+		require.NoError(t, testResultsRepository.Insert(ctx, testkube.TestSuiteExecution{
+			Id:          id,
+			Name:        id,
+			TestSuite:   &testkube.ObjectRef{Name: "suite"},
+			Status:      &suiteStatus,
+			StartTime:   startTime,
+			EndTime:     startTime.Add(time.Minute),
+			StepResults: []testkube.TestSuiteStepExecutionResultV2{{Execution: &step}},
+		}))
+	}
+
+	_, err = configRepository.Upsert(ctx, testkube.Config{RetentionPolicies: []testkube.RetentionPolicy{
+		{Test: "test", KeepLast: 2},
+		{TestSuite: "suite", KeepDays: 30},
+	}})
+	require.NoError(t, err)
+
+	t.Run("dry run reports executions without deleting them", func(t *testing.T) {
+		report, err := janitor.Enforce(ctx, true)
+		require.NoError(t, err)
+
+		assert.True(t, report.DryRun)
+		require.Len(t, report.Executions, 2)
+		assert.Equal(t, "test-3", report.Executions[0].Id)
+		assert.Equal(t, int32(1), report.Executions[0].Artifacts)
+		assert.Equal(t, "suite-2-step", report.Executions[1].Id)
+		require.Len(t, report.TestSuiteExecutions, 1)
+		assert.Equal(t, "suite-2", report.TestSuiteExecutions[0].Id)
+
+		_, err = resultsRepository.Get(ctx, "test-3")
+		assert.NoError(t, err)
+		files, err := storageClient.ListFiles(ctx, "test-3")
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+	})
+
+	t.Run("deletes executions with outputs, test cases and artifacts", func(t *testing.T) {
+		report, err := janitor.Enforce(ctx, false)
+		require.NoError(t, err)
+
+		assert.False(t, report.DryRun)
+		assert.Len(t, report.Executions, 2)
+		assert.Len(t, report.TestSuiteExecutions, 1)
+
+		for _, id := range []string{"test-3", "suite-2-step"} {
+			_, err = resultsRepository.Get(ctx, id)
+			assert.Error(t, err)
+			_, err = resultsRepository.OutputRepository.GetOutput(ctx, id, "", "")
+			assert.Error(t, err)
+			files, _ := storageClient.ListFiles(ctx, id)
+			assert.Empty(t, files)
+			testCases, err := testCasesRepository.GetTestCases(ctx, testcase.NewTestCasesFilter().WithExecutionId(id))
+			assert.NoError(t, err)
+			assert.Empty(t, testCases)
+		}
+
+		_, err = testResultsRepository.Get(ctx, "suite-2")
+		assert.Error(t, err)
+
+		for _, id := range []string{"test-1", "test-2", "other-1", "suite-1-step"} {
+			_, err = resultsRepository.Get(ctx, id)
+			assert.NoError(t, err)
+		}
+		_, err = testResultsRepository.Get(ctx, "suite-1")
+		assert.NoError(t, err)
+	})
+}
+
+func TestJanitor_lease(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	leaseBackend := triggers.NewSQLLeaseBackend(db)
+	newJanitor := func(identifier string) *Janitor {
+		janitor := NewJanitor(config.NewSQLRepository(db), result.NewSQLRepository(db), testresult.NewSQLRepository(db), nil, nil, log.DefaultLogger).
+			WithLease(leaseBackend)
+		janitor.identifier = identifier
+		return janitor
+	}
+
+	janitor1 := newJanitor("api-1")
+	janitor2 := newJanitor("api-2")
+	assert.True(t, janitor1.holdsLease(ctx))
+	assert.False(t, janitor2.holdsLease(ctx))
+	assert.True(t, janitor1.holdsLease(ctx))
+	assert.True(t, NewJanitor(nil, nil, nil, nil, nil, log.DefaultLogger).holdsLease(ctx))
+}
@@ -0,0 +1,66 @@
+package retention
+
+import (
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+const day = 24 * time.Hour
+
+// candidate is execution considered for deletion by retention policy
+type candidate struct {
+	startTime time.Time
+	finished  bool
+	failed    bool
+}
+
+// expired returns indexes of candidates which are not kept by the policy, candidates have to be sorted from the latest one
+// and offset is the position of the first candidate among all executions.
+// Unfinished executions are always kept, the others are deleted only when none of the limits applicable to them keeps them.
+func expired(policy testkube.RetentionPolicy, candidates []candidate, offset int, now time.Time) (indexes []int) {
+	for i, c := range candidates {
+		if !c.finished {
+			continue
+		}
+
+		applicable := false
+		kept := false
+		if policy.KeepLast > 0 {
+			applicable = true
+			kept = kept || offset+i < int(policy.KeepLast)
+		}
+
+		if policy.KeepDays > 0 {
+			applicable = true
+			kept = kept || c.startTime.After(now.Add(-time.Duration(policy.KeepDays)*day))
+		}
+
+		if policy.KeepFailedDays > 0 && c.failed {
+			applicable = true
+			kept = kept || c.startTime.After(now.Add(-time.Duration(policy.KeepFailedDays)*day))
+		}
+
+		if applicable && !kept {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+func executionCandidate(execution testkube.Execution) candidate {
+	return candidate{
+		startTime: execution.StartTime,
+		finished:  !execution.IsRunning() && !execution.IsQueued(),
+		failed:    execution.IsFailed() || execution.IsTimeout(),
+	}
+}
+
+func testSuiteExecutionCandidate(execution testkube.TestSuiteExecution) candidate {
+	return candidate{
+		startTime: execution.StartTime,
+		finished:  execution.IsCompleted(),
+		failed:    execution.IsFailed() || execution.IsTimeout(),
+	}
+}
@@ -0,0 +1,72 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time {
+		return now.Add(-time.Duration(days) * day)
+	}
+
+	candidates := []candidate{
+		{startTime: daysAgo(0), finished: false},
+		{startTime: daysAgo(1), finished: true},
+		{startTime: daysAgo(10), finished: true, failed: true},
+		{startTime: daysAgo(40), finished: true},
+		{startTime: daysAgo(60), finished: true, failed: true},
+		{startTime: daysAgo(100), finished: true, failed: true},
+		{startTime: daysAgo(200), finished: false},
+	}
+
+	tests := []struct {
+		name     string
+		policy   testkube.RetentionPolicy
+		offset   int
+		expected []int
+	}{
+		{
+			name:     "keep last",
+			policy:   testkube.RetentionPolicy{KeepLast: 3},
+			expected: []int{3, 4, 5},
+		},
+		{
+			name:     "keep last counts executions before the offset",
+			policy:   testkube.RetentionPolicy{KeepLast: 3},
+			offset:   2,
+			expected: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "keep days",
+			policy:   testkube.RetentionPolicy{KeepDays: 30},
+			expected: []int{3, 4, 5},
+		},
+		{
+			name:     "keep days and failed days",
+			policy:   testkube.RetentionPolicy{KeepDays: 30, KeepFailedDays: 90},
+			expected: []int{3, 5},
+		},
+		{
+			name:     "keep failed days only applies to failed executions",
+			policy:   testkube.RetentionPolicy{KeepFailedDays: 30},
+			expected: []int{4, 5},
+		},
+		{
+			name:     "execution is kept by any of the limits",
+			policy:   testkube.RetentionPolicy{KeepLast: 5, KeepDays: 30},
+			expected: []int{5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, expired(tt.policy, candidates, tt.offset, now))
+		})
+	}
+}
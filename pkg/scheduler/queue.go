@@ -0,0 +1,269 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+const (
+	// queuePollingInterval is interval of admitting queued executions after running ones finish
+	queuePollingInterval = time.Second
+	// queueLeaseClusterID identifies the lease of the execution queue, separate from the test triggers lease
+	queueLeaseClusterID = "testkube-api-queue"
+)
+
+// LeaseBackend acquires lease shared by api server replicas, implemented by the test triggers lease backends
+type LeaseBackend interface {
+	TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error)
+}
+
+// executionQueue admits test executions stored as queued, queued executions are kept only in the storage
+// so concurrency limits are shared by all api server replicas
+type executionQueue struct {
+	leaseBackend LeaseBackend
+	// wake admits queued executions without waiting for the polling interval
+	wake chan struct{}
+}
+
+// WithLease makes the scheduler admit queued test executions only while it holds the lease,
+// so only one api server replica admits executions at a time, scheduler without lease always admits them
+func (s *Scheduler) WithLease(leaseBackend LeaseBackend) *Scheduler {
+	s.queue.leaseBackend = leaseBackend
+	return s
+}
+
+// RunExecutionQueue admits queued test executions when running ones finish, including executions queued
+// before api server restart
+func (s *Scheduler) RunExecutionQueue(ctx context.Context) {
+	ticker := time.NewTicker(queuePollingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.queue.wake:
+		}
+
+		if s.holdsQueueLease(ctx) {
+			s.admitQueuedExecutions(ctx)
+		}
+	}
+}
+
+// holdsQueueLease checks if the replica holds the execution queue lease
+func (s *Scheduler) holdsQueueLease(ctx context.Context) bool {
+	if s.queue.leaseBackend == nil {
+		return true
+	}
+
+	leased, err := s.queue.leaseBackend.TryAcquire(ctx, s.runnerID, queueLeaseClusterID)
+	if err != nil {
+		s.logger.Errorw("error acquiring execution queue lease", "error", err)
+		return false
+	}
+
+	return leased
+}
+
+// wakeExecutionQueue requests admission of queued executions without waiting for the polling interval
+func (s *Scheduler) wakeExecutionQueue() {
+	select {
+	case s.queue.wake <- struct{}{}:
+	default:
+	}
+}
+
+// GetQueuePosition returns position of the execution in the execution queue starting from 1, 0 when it's not queued
+func (s *Scheduler) GetQueuePosition(ctx context.Context, id string) int32 {
+	queued, err := s.getQueuedExecutions(ctx)
+	if err != nil {
+		s.logger.Errorw("getting queued executions", "error", err)
+		return 0
+	}
+
+	for i := range queued {
+		if queued[i].Id == id {
+			return int32(i + 1)
+		}
+	}
+
+	return 0
+}
+
+// getQueuedExecutions returns stored queued executions ordered by their priority class and time they were queued
+func (s *Scheduler) getQueuedExecutions(ctx context.Context) ([]testkube.Execution, error) {
+	queued, err := s.executionResults.GetExecutions(ctx, result.NewExecutionsFilter().
+		WithStatus(string(testkube.QUEUED_ExecutionStatus)).WithPageSize(math.MaxInt32))
+	if err != nil {
+		return nil, err
+	}
+
+	// executions queued before the queued time was stored have it empty, so they're admitted first
+	sort.Slice(queued, func(i, j int) bool {
+		if queued[i].PriorityClass.Priority() != queued[j].PriorityClass.Priority() {
+			return queued[i].PriorityClass.Priority() > queued[j].PriorityClass.Priority()
+		}
+
+		if !queued[i].QueuedTime.Equal(queued[j].QueuedTime) {
+			return queued[i].QueuedTime.Before(queued[j].QueuedTime)
+		}
+
+		return queued[i].Id < queued[j].Id
+	})
+
+	return queued, nil
+}
+
+// admitQueuedExecutions admits queued test executions in order while they fit concurrency limits together with
+// running executions, executions over one limit don't block the ones limited by other limits, admitted executions
+// are stored as running before they're started, so they're counted by the next admission on any replica
+func (s *Scheduler) admitQueuedExecutions(ctx context.Context) {
+	queued, err := s.getQueuedExecutions(ctx)
+	if err != nil {
+		s.logger.Errorw("getting queued executions", "error", err)
+		return
+	}
+
+	if len(queued) == 0 {
+		return
+	}
+
+	policy := s.getQueuePolicy(ctx)
+	executors := map[string]string{}
+	getLimits := func(execution testkube.Execution) map[string]int32 {
+		if !policy.IsLimited() {
+			return nil
+		}
+
+		if _, ok := executors[execution.TestType]; !ok {
+			executors[execution.TestType] = s.getExecutorName(execution.TestType)
+		}
+
+		return policy.GetLimits(execution.TestNamespace, executors[execution.TestType], execution.Labels)
+	}
+
+	counts := map[string]int32{}
+	if policy.IsLimited() {
+		running, err := s.executionResults.GetExecutions(ctx, result.NewExecutionsFilter().
+			WithStatus(string(testkube.RUNNING_ExecutionStatus)).WithPageSize(math.MaxInt32))
+		if err != nil {
+			s.logger.Errorw("getting running executions for execution queue", "error", err)
+			return
+		}
+
+		for _, execution := range running {
+			for group := range getLimits(execution) {
+				counts[group]++
+			}
+		}
+	}
+
+	for _, execution := range queued {
+		limits := getLimits(execution)
+		if !fitsQueueLimits(limits, counts) {
+			continue
+		}
+
+		// execution aborted or admitted meanwhile isn't queued anymore
+		admitted, err := s.executionResults.AdmitExecution(ctx, execution.Id)
+		if err != nil {
+			s.logger.Errorw("admitting queued test execution", "executionId", execution.Id, "error", err)
+			continue
+		}
+
+		if !admitted {
+			continue
+		}
+
+		for group := range limits {
+			counts[group]++
+		}
+
+		go s.startAdmittedExecution(ctx, execution)
+	}
+}
+
+// getQueuePolicy returns concurrency limits of running executions, executions aren't queued when it can't be read
+func (s *Scheduler) getQueuePolicy(ctx context.Context) *testkube.QueuePolicy {
+	if s.configMap == nil {
+		return nil
+	}
+
+	config, err := s.configMap.Get(ctx)
+	if err != nil {
+		s.logger.Warnw("getting queue policy", "error", err)
+		return nil
+	}
+
+	return config.Queue
+}
+
+// getExecutorName returns name of the executor running tests of given type
+func (s *Scheduler) getExecutorName(testType string) string {
+	if s.executorsClient == nil {
+		return ""
+	}
+
+	executor, err := s.executorsClient.GetByType(testType)
+	if err != nil {
+		s.logger.Warnw("getting executor for execution queue", "type", testType, "error", err)
+		return ""
+	}
+
+	return executor.Name
+}
+
+// fitsQueueLimits checks if one more execution fits all given limits with current counts of executions
+func fitsQueueLimits(limits, counts map[string]int32) bool {
+	for group, limit := range limits {
+		if counts[group] >= limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newStoredExecutionRequest returns request stored with the queued or retried execution to start it after api server
+// restart, variables are already stored in the execution with secret values replaced by references
+func newStoredExecutionRequest(request testkube.ExecutionRequest) *testkube.ExecutionRequest {
+	request.Variables = nil
+	return &request
+}
+
+// startAdmittedExecution starts the admitted test execution with the request stored when it was queued,
+// execution which can't be started is failed
+func (s *Scheduler) startAdmittedExecution(ctx context.Context, execution testkube.Execution) {
+	if execution.ExecutionRequest == nil {
+		s.failResumedExecution(ctx, &execution, errors.New("queued test execution has no stored request"))
+		return
+	}
+
+	request := *execution.ExecutionRequest
+	request.Id = execution.Id
+	request.Name = execution.Name
+	request.Number = execution.Number
+	request.Variables = execution.Variables
+	// callers of sync executions wait for the stored result
+	request.Sync = false
+
+	options, err := s.getExecuteOptions(execution.TestNamespace, execution.TestName, request)
+	if err != nil {
+		s.logger.Errorw("starting queued test execution", "executionId", execution.Id, "error", err)
+		s.failResumedExecution(ctx, &execution, fmt.Errorf("can't create valid execution options: %w", err))
+		return
+	}
+
+	options.ID = execution.Id
+	s.logger.Infow("starting queued test execution", "executionId", execution.Id, "test", execution.TestName)
+	s.runTestExecution(ctx, options, execution)
+}
@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/config"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+)
+
+func TestFitsQueueLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := map[string]int32{"cluster": 3, "namespace/testkube": 1}
+
+	assert.True(t, fitsQueueLimits(limits, map[string]int32{"cluster": 2}))
+	assert.False(t, fitsQueueLimits(limits, map[string]int32{"cluster": 2, "namespace/testkube": 1}))
+	assert.False(t, fitsQueueLimits(limits, map[string]int32{"cluster": 3}))
+	assert.True(t, fitsQueueLimits(nil, map[string]int32{"cluster": 3}))
+}
+
+func TestAdmitQueuedExecutions(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queued := []testkube.Execution{
+		{Id: "second", TestNamespace: "staging", PriorityClass: testkube.TRIGGER_PriorityClass},
+		{Id: "first", TestNamespace: "staging", PriorityClass: testkube.SCHEDULED_PriorityClass},
+		{Id: "limited", TestNamespace: "testkube", PriorityClass: testkube.CI_PriorityClass},
+	}
+	repository := result.NewMockRepository(mockCtrl)
+	repository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter result.Filter) ([]testkube.Execution, error) {
+			if filter.Statuses()[0] == testkube.QUEUED_ExecutionStatus {
+				return append([]testkube.Execution{}, queued...), nil
+			}
+
+			return []testkube.Execution{{Id: "running", TestNamespace: "testkube"}}, nil
+		}).AnyTimes()
+	repository.EXPECT().AdmitExecution(gomock.Any(), "first").Return(true, nil)
+
+	// admitted execution without stored request is failed
+	failed := make(chan struct{})
+	repository.EXPECT().UpdateResult(gomock.Any(), "first", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, execution testkube.Execution) error {
+			close(failed)
+			return nil
+		})
+
+	configRepository := config.NewMockRepository(mockCtrl)
+	configRepository.EXPECT().Get(gomock.Any()).Return(testkube.Config{Queue: &testkube.QueuePolicy{
+		Concurrency: 2,
+		Namespaces:  map[string]int32{"testkube": 1},
+	}}, nil).AnyTimes()
+
+	s := &Scheduler{
+		logger:           log.DefaultLogger,
+		executionResults: repository,
+		configMap:        configRepository,
+		events:           event.NewEmitter(bus.NewEventBusMock(), "", nil),
+	}
+
+	assert.Equal(t, int32(1), s.GetQueuePosition(context.Background(), "limited"))
+	assert.Equal(t, int32(2), s.GetQueuePosition(context.Background(), "first"))
+	assert.Equal(t, int32(3), s.GetQueuePosition(context.Background(), "second"))
+	assert.Equal(t, int32(0), s.GetQueuePosition(context.Background(), "running"))
+
+	s.admitQueuedExecutions(context.Background())
+
+	select {
+	case <-failed:
+	case <-time.After(time.Second):
+		t.Fatal("first execution wasn't started")
+	}
+}
+
+func TestGetQueuedExecutions(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	queuedTime := time.Now()
+	repository := result.NewMockRepository(mockCtrl)
+	repository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).Return([]testkube.Execution{
+		{Id: "c", PriorityClass: testkube.CI_PriorityClass, QueuedTime: queuedTime},
+		{Id: "b", PriorityClass: testkube.CI_PriorityClass, QueuedTime: queuedTime},
+		{Id: "a", PriorityClass: testkube.CI_PriorityClass, QueuedTime: queuedTime.Add(time.Second)},
+	}, nil)
+
+	s := &Scheduler{logger: log.DefaultLogger, executionResults: repository}
+	queued, err := s.getQueuedExecutions(context.Background())
+	assert.NoError(t, err)
+
+	var ids []string
+	for _, execution := range queued {
+		ids = append(ids, execution.Id)
+	}
+	assert.Equal(t, []string{"b", "c", "a"}, ids)
+}
+
+func TestHoldsQueueLease(t *testing.T) {
+	t.Parallel()
+
+	s := &Scheduler{logger: log.DefaultLogger, runnerID: "replica-1"}
+	assert.True(t, s.holdsQueueLease(context.Background()))
+
+	s.WithLease(leaseBackendFunc(func(ctx context.Context, id, clusterID string) (bool, error) {
+		return id == "replica-2" && clusterID == queueLeaseClusterID, nil
+	}))
+	assert.False(t, s.holdsQueueLease(context.Background()))
+
+	s.runnerID = "replica-2"
+	assert.True(t, s.holdsQueueLease(context.Background()))
+}
+
+type leaseBackendFunc func(ctx context.Context, id, clusterID string) (bool, error)
+
+func (f leaseBackendFunc) TryAcquire(ctx context.Context, id, clusterID string) (bool, error) {
+	return f(ctx, id, clusterID)
+}
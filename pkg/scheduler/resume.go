@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// heartbeatInterval is interval of refreshing heartbeats of executions run by the replica
+	heartbeatInterval = 10 * time.Second
+	// heartbeatTimeout is time after which execution without heartbeat is resumed by another replica
+	heartbeatTimeout = time.Minute
+	// resumeInterval is interval of checking for executions to resume
+	resumeInterval = time.Minute
+)
+
+// claimFunc sets the runner of the execution and refreshes its heartbeat, execution of another runner
+// is claimed only when its heartbeat is older than staleBefore
+type claimFunc func(ctx context.Context, id, runnerID string, staleBefore time.Time) (bool, error)
+
+// WithoutResume disables resuming executions of other replicas and heartbeats of executions run by the replica,
+// for results backends which can't claim executions atomically
+func (s *Scheduler) WithoutResume() *Scheduler {
+	s.resumeDisabled = true
+	return s
+}
+
+// RunResumer periodically resumes test suite executions and test execution retries of replicas
+// which stopped running them, including the ones interrupted by restart of this replica
+func (s *Scheduler) RunResumer(ctx context.Context) {
+	if s.resumeDisabled {
+		return
+	}
+
+	ticker := time.NewTicker(resumeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.ResumeTestSuiteExecutions(ctx); err != nil {
+			s.logger.Errorw("resuming test suite executions", "error", err)
+		}
+
+		if err := s.ResumeTestRetries(ctx); err != nil {
+			s.logger.Errorw("resuming test execution retries", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// keep marks the execution as run by this replica until the returned function is called,
+// heartbeats of the execution keep other replicas from resuming it
+func (s *Scheduler) keep(ctx context.Context, running *sync.Map, id string, claim claimFunc) (release func()) {
+	running.Store(id, struct{}{})
+	if s.resumeDisabled {
+		return func() {
+			running.Delete(id)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				claimed, err := claim(ctx, id, s.runnerID, time.Time{})
+				if err != nil {
+					s.logger.Errorw("refreshing execution heartbeat", "id", id, "error", err)
+				} else if !claimed {
+					s.logger.Warnw("execution was claimed by another replica", "id", id)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		running.Delete(id)
+	}
+}
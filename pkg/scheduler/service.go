@@ -1,6 +1,9 @@
 package scheduler
 
 import (
+	"os"
+	"sync"
+
 	"go.uber.org/zap"
 
 	"github.com/kubeshop/testkube/pkg/event/bus"
@@ -18,6 +21,7 @@ import (
 	"github.com/kubeshop/testkube/pkg/repository/result"
 	"github.com/kubeshop/testkube/pkg/repository/testresult"
 	"github.com/kubeshop/testkube/pkg/secret"
+	"github.com/kubeshop/testkube/pkg/utils"
 )
 
 type Scheduler struct {
@@ -38,6 +42,15 @@ type Scheduler struct {
 	testSuiteExecutionsClient testsuiteexecutionsclientv1.Interface
 	eventsBus                 bus.Bus
 	dashboardURI              string
+	queue                     executionQueue
+	// runnerID identifies the replica running test suite executions and test execution retries
+	runnerID string
+	// runningTestSuites are ids of test suite executions run by the replica
+	runningTestSuites sync.Map
+	// retriedExecutions are ids of test executions retried by the replica
+	retriedExecutions sync.Map
+	// resumeDisabled stops resuming executions and refreshing their heartbeats
+	resumeDisabled bool
 }
 
 func NewScheduler(
@@ -59,7 +72,13 @@ func NewScheduler(
 	eventsBus bus.Bus,
 	dashboardURI string,
 ) *Scheduler {
+	runnerID, _ := os.Hostname()
+	if runnerID == "" {
+		runnerID = "testkube-api-" + utils.RandAlphanum(10)
+	}
+
 	return &Scheduler{
+		runnerID:                  runnerID,
 		metrics:                   metrics,
 		executor:                  executor,
 		containerExecutor:         containerExecutor,
@@ -77,5 +96,6 @@ func NewScheduler(
 		testSuiteExecutionsClient: testSuiteExecutionsClient,
 		eventsBus:                 eventsBus,
 		dashboardURI:              dashboardURI,
+		queue:                     executionQueue{wake: make(chan struct{}, 1)},
 	}
 }
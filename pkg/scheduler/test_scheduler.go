@@ -3,9 +3,12 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"math"
 	"path/filepath"
+	"time"
 
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	v1 "k8s.io/api/core/v1"
 
 	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
@@ -15,21 +18,62 @@ import (
 	"github.com/kubeshop/testkube/pkg/executor"
 	"github.com/kubeshop/testkube/pkg/executor/client"
 	testsmapper "github.com/kubeshop/testkube/pkg/mapper/tests"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/secret"
 	"github.com/kubeshop/testkube/pkg/workerpool"
 )
 
 const (
 	containerType = "container"
+	// retryPollingInterval is interval of checking if async execution is finished before retrying it
+	retryPollingInterval = 5 * time.Second
+	// redactedSecretValue replaces values of secret variables in rendered manifests of dry run executions
+	redactedSecretValue = "********"
 )
 
 func (s *Scheduler) PrepareTestRequests(work []testsv3.Test, request testkube.ExecutionRequest) []workerpool.Request[
 	testkube.Test, testkube.ExecutionRequest, testkube.Execution] {
-	requests := make([]workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution], len(work))
+	var requests []workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]
 	for i := range work {
-		requests[i] = workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]{
-			Object:  testsmapper.MapTestCRToAPI(work[i]),
-			Options: request,
-			ExecFn:  s.executeTest,
+		// each test has its own matrix executions grouped under a separate parent
+		options := expandExecutionRequest(request)
+		for j := range options {
+			requests = append(requests, workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]{
+				Object:  testsmapper.MapTestCRToAPI(work[i]),
+				Options: options[j],
+				ExecFn:  s.executeTest,
+			})
+		}
+	}
+
+	return requests
+}
+
+// expandExecutionRequest returns one request for each combination of the request matrix, requests are grouped
+// under the same parent execution id and have matrix values set as variables and execution labels
+func expandExecutionRequest(request testkube.ExecutionRequest) []testkube.ExecutionRequest {
+	combinations := testkube.ExpandMatrix(request.Matrix)
+	if len(combinations) == 0 {
+		return []testkube.ExecutionRequest{request}
+	}
+
+	if request.ParentExecutionId == "" {
+		request.ParentExecutionId = primitive.NewObjectID().Hex()
+	}
+
+	requests := make([]testkube.ExecutionRequest, len(combinations))
+	for i, combination := range combinations {
+		requests[i] = request
+		requests[i].Matrix = make(map[string][]string, len(combination))
+		requests[i].Variables = make(map[string]testkube.Variable, len(request.Variables)+len(combination))
+		for name, variable := range request.Variables {
+			requests[i].Variables[name] = variable
+		}
+
+		requests[i].ExecutionLabels = common.MergeMaps(request.ExecutionLabels, combination)
+		for name, value := range combination {
+			requests[i].Matrix[name] = []string{value}
+			requests[i].Variables[name] = testkube.NewBasicVariable(name, value)
 		}
 	}
 
@@ -38,6 +82,202 @@ func (s *Scheduler) PrepareTestRequests(work []testsv3.Test, request testkube.Ex
 
 func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest) (
 	execution testkube.Execution, err error) {
+	execution, errored := s.executeTestAttempt(ctx, test, request, 1, "")
+	if request.DryRun || request.RetryPolicy.GetMaxAttempts() == 1 || (execution.StartTime.IsZero() && !execution.IsQueued()) {
+		return execution, nil
+	}
+
+	// sync executions are returned with the final attempt, async ones are retried in background
+	if request.Sync {
+		return s.retryTestExecution(ctx, test, request, execution, errored), nil
+	}
+
+	go s.retryTestExecution(context.Background(), test, request, execution, errored)
+	return execution, nil
+}
+
+// ResumeTestRetries continues retries of test executions interrupted by api server restart, only retries
+// of this replica or with a stale heartbeat are claimed
+func (s *Scheduler) ResumeTestRetries(ctx context.Context) error {
+	executions, err := s.executionResults.GetExecutions(ctx, result.NewExecutionsFilter().
+		WithRetryPending(true).WithPageSize(math.MaxInt32))
+	if err != nil {
+		return err
+	}
+
+	for i := range executions {
+		if _, ok := s.retriedExecutions.Load(executions[i].Id); ok {
+			continue
+		}
+
+		claimed, err := s.executionResults.Claim(ctx, executions[i].Id, s.runnerID, time.Now().Add(-heartbeatTimeout))
+		if err != nil {
+			s.logger.Errorw("claiming test execution retry", "executionId", executions[i].Id, "error", err)
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		s.logger.Infow("resuming test execution retry", "executionId", executions[i].Id, "attempt", executions[i].Attempt,
+			"previousRunner", executions[i].RunnerId)
+		release := s.keep(ctx, &s.retriedExecutions, executions[i].Id, s.executionResults.Claim)
+		go s.resumeTestRetry(ctx, executions[i], release)
+	}
+
+	return nil
+}
+
+// resumeTestRetry continues retrying the stored attempt with the request it was started with
+func (s *Scheduler) resumeTestRetry(ctx context.Context, execution testkube.Execution, release func()) {
+	defer release()
+
+	testCR, err := s.testsClient.Get(execution.TestName)
+	if err != nil || execution.ExecutionRequest == nil {
+		s.logger.Errorw("can't resume test execution retry", "executionId", execution.Id, "error", err)
+		s.resolveRetry(ctx, execution)
+		return
+	}
+
+	request := *execution.ExecutionRequest
+	request.Variables = execution.Variables
+	request.Sync = false
+	s.retryTestExecution(ctx, testsmapper.MapTestCRToAPI(*testCR), request, execution, false)
+}
+
+// retryTestExecution runs new attempts of the test execution according to the retry policy of the request
+// and returns the final attempt, each attempt is stored as a separate execution linked to the first one
+func (s *Scheduler) retryTestExecution(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest,
+	execution testkube.Execution, errored bool) testkube.Execution {
+	policy := request.RetryPolicy
+	retryOf := execution.RetryOf
+	if retryOf == "" {
+		retryOf = execution.Id
+	}
+
+	first := int(execution.Attempt) + 1
+	if first < 2 {
+		first = 2
+	}
+
+	// pending attempt is kept by this replica while it's being retried
+	release := func() {}
+	defer func() { release() }()
+	for attempt := first; attempt <= policy.GetMaxAttempts(); attempt++ {
+		release()
+		release = func() {}
+		if execution.RetryPending {
+			release = s.keep(ctx, &s.retriedExecutions, execution.Id, s.executionResults.Claim)
+		}
+
+		if !request.Sync && !errored {
+			execution = s.waitForExecution(ctx, execution)
+		}
+
+		// pending retry is kept to be resumed after api server restart
+		if ctx.Err() != nil {
+			return execution
+		}
+
+		status, ok := getRetryStatus(execution, errored)
+		if !ok || !policy.ShouldRetry(status) {
+			break
+		}
+
+		backoff := policy.GetBackoff(attempt - 1)
+		s.logger.Infow("retrying test execution", "test", test.Name, "executionId", execution.Id,
+			"status", status, "attempt", attempt, "backoff", backoff)
+
+		select {
+		case <-ctx.Done():
+			return execution
+		case <-time.After(backoff):
+		}
+
+		previous := execution
+		retryRequest := request
+		retryRequest.Id = ""
+		execution, errored = s.executeTestAttempt(ctx, test, retryRequest, int32(attempt), retryOf)
+		s.resolveRetry(ctx, previous)
+	}
+
+	s.resolveRetry(ctx, execution)
+	return execution
+}
+
+// resolveRetry clears pending retry of the attempt, which was either retried or won't be retried anymore
+func (s *Scheduler) resolveRetry(ctx context.Context, execution testkube.Execution) {
+	if !execution.RetryPending {
+		return
+	}
+
+	stored, err := s.executionResults.Get(ctx, execution.Id)
+	if err != nil {
+		s.logger.Errorw("getting retried test execution", "executionId", execution.Id, "error", err)
+		return
+	}
+
+	stored.RetryPending = false
+	if err = s.executionResults.Update(ctx, stored); err != nil {
+		s.logger.Errorw("resolving test execution retry", "executionId", execution.Id, "error", err)
+	}
+}
+
+// waitForExecution polls the storage until the async execution is finished
+func (s *Scheduler) waitForExecution(ctx context.Context, execution testkube.Execution) testkube.Execution {
+	ticker := time.NewTicker(retryPollingInterval)
+	defer ticker.Stop()
+
+	for {
+		if execution.ExecutionResult != nil && !execution.ExecutionResult.IsQueued() && !execution.ExecutionResult.IsRunning() {
+			return execution
+		}
+
+		select {
+		case <-ctx.Done():
+			return execution
+		case <-ticker.C:
+			result, err := s.executionResults.Get(ctx, execution.Id)
+			if err != nil {
+				s.logger.Errorw("getting execution for retry", "executionId", execution.Id, "error", err)
+				continue
+			}
+
+			execution = result
+		}
+	}
+}
+
+// getRetryStatus returns the retry status of the finished attempt, false means the attempt can't be retried
+func getRetryStatus(execution testkube.Execution, errored bool) (testkube.RetryStatus, bool) {
+	// execution which wasn't started failed on its definition, another attempt won't help
+	if execution.StartTime.IsZero() {
+		return "", false
+	}
+
+	if errored {
+		return testkube.ERROR_RetryStatus, true
+	}
+
+	if execution.ExecutionResult == nil || execution.ExecutionResult.Status == nil {
+		return "", false
+	}
+
+	switch {
+	case execution.ExecutionResult.IsFailed():
+		return testkube.FAILED_RetryStatus, true
+	case execution.ExecutionResult.IsTimeout():
+		return testkube.TIMEOUT_RetryStatus, true
+	}
+
+	return "", false
+}
+
+// executeTestAttempt runs single attempt of the test execution, errored is set when the execution was
+// stored but the executor failed to run it
+func (s *Scheduler) executeTestAttempt(ctx context.Context, test testkube.Test, request testkube.ExecutionRequest,
+	attempt int32, retryOf string) (execution testkube.Execution, errored bool) {
 	// generate random execution name in case there is no one set
 	// like for docker images
 	if request.Name == "" && test.ExecutionRequest != nil && test.ExecutionRequest.Name != "" {
@@ -48,40 +288,115 @@ func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request
 		request.Name = test.Name
 	}
 
-	request.Number = s.getNextExecutionNumber(test.Name)
-	request.Name = fmt.Sprintf("%s-%d", request.Name, request.Number)
+	// dry run doesn't use execution number, so it's not allocated
+	if !request.DryRun {
+		request.Number = s.getNextExecutionNumber(test.Name)
+		request.Name = fmt.Sprintf("%s-%d", request.Name, request.Number)
 
-	// test name + test execution name should be unique
-	execution, _ = s.executionResults.GetByNameAndTest(ctx, request.Name, test.Name)
-	if execution.Name == request.Name {
-		return execution.Err(errors.Errorf("test execution with name %s already exists", request.Name)), nil
+		// test name + test execution name should be unique
+		execution, _ = s.executionResults.GetByNameAndTest(ctx, request.Name, test.Name)
+		if execution.Name == request.Name {
+			return execution.Err(errors.Errorf("test execution with name %s already exists", request.Name)), false
+		}
 	}
 
 	secretUUID, err := s.testsClient.GetCurrentSecretUUID(test.Name)
 	if err != nil {
-		return execution.Errw(request.Id, "can't get current secret uuid: %w", err), nil
+		return execution.Errw(request.Id, "can't get current secret uuid: %w", err), false
 	}
 
 	request.TestSecretUUID = secretUUID
 	// merge available data into execution options test spec, executor spec, request, test id
 	options, err := s.getExecuteOptions(test.Namespace, test.Name, request)
 	if err != nil {
-		return execution.Errw(request.Id, "can't create valid execution options: %w", err), nil
+		return execution.Errw(request.Id, "can't create valid execution options: %w", err), false
 	}
 
 	// store execution in storage, can be fetched from API now
 	execution = newExecutionFromExecutionOptions(options)
+	execution.Attempt = attempt
+	execution.RetryOf = retryOf
 	options.ID = execution.Id
 
+	if request.DryRun {
+		return s.dryRunTestExecution(ctx, options, execution), false
+	}
+
 	if err := s.createSecretsReferences(&execution); err != nil {
-		return execution.Errw(execution.Id, "can't create secret variables `Secret` references: %w", err), nil
+		return execution.Errw(execution.Id, "can't create secret variables `Secret` references: %w", err), false
+	}
+
+	// pending retry is stored with the request to continue retrying after api server restart,
+	// test suite steps are retried within their test suite execution
+	if request.TestSuiteName == "" && int(attempt) < request.RetryPolicy.GetMaxAttempts() {
+		execution.RetryPending = true
+		execution.RunnerId = s.runnerID
+		execution.HeartbeatTime = time.Now()
+		execution.ExecutionRequest = newStoredExecutionRequest(request)
+	}
+
+	execution.PriorityClass = request.GetPriorityClass()
+	if s.getQueuePolicy(ctx).IsLimited() {
+		return s.queueTestExecution(ctx, request, execution)
 	}
 
 	err = s.executionResults.Insert(ctx, execution)
 	if err != nil {
-		return execution.Errw(execution.Id, "can't create new test execution, can't insert into storage: %w", err), nil
+		return execution.Errw(execution.Id, "can't create new test execution, can't insert into storage: %w", err), false
+	}
+
+	return s.runTestExecution(ctx, options, execution)
+}
+
+// dryRunTestExecution renders Kubernetes manifests which would be created for the execution, neither the manifests
+// nor the execution are created, so the execution has no status and values of secret variables are redacted
+func (s *Scheduler) dryRunTestExecution(ctx context.Context, options client.ExecuteOptions, execution testkube.Execution) testkube.Execution {
+	secretName, labels, secrets := newSecretsReferences(&execution)
+	objects, err := s.getExecutor(options.TestName).DryRun(ctx, &execution, options)
+	if err != nil {
+		return execution.Errw(execution.Id, "can't render test execution manifests: %w", err)
+	}
+
+	if len(secrets) > 0 {
+		for name := range secrets {
+			secrets[name] = redactedSecretValue
+		}
+
+		objects = append(objects, secret.NewSpec(secretName, execution.TestNamespace, labels, secrets))
+	}
+
+	execution.Manifests, err = executor.EncodeManifests(objects...)
+	if err != nil {
+		return execution.Errw(execution.Id, "can't encode test execution manifests: %w", err)
 	}
 
+	execution.ExecutionResult = nil
+	return execution
+}
+
+// queueTestExecution stores the execution limited by the queue policy as queued, it's started by the replica
+// admitting queued executions, async executions are returned right after they're queued and sync ones once
+// they're finished
+func (s *Scheduler) queueTestExecution(ctx context.Context, request testkube.ExecutionRequest,
+	execution testkube.Execution) (testkube.Execution, bool) {
+	execution.ExecutionResult = &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued}
+	execution.ExecutionRequest = newStoredExecutionRequest(request)
+	execution.QueuedTime = time.Now()
+	if err := s.executionResults.Insert(ctx, execution); err != nil {
+		return execution.Errw(execution.Id, "can't create new test execution, can't insert into storage: %w", err), false
+	}
+
+	s.wakeExecutionQueue()
+	if !request.Sync {
+		execution.QueuePosition = s.GetQueuePosition(ctx, execution.Id)
+		return execution, false
+	}
+
+	return s.waitForExecution(ctx, execution), false
+}
+
+// runTestExecution starts the stored test execution with the executor
+func (s *Scheduler) runTestExecution(ctx context.Context, options client.ExecuteOptions, execution testkube.Execution) (testkube.Execution, bool) {
 	s.logger.Infow("calling executor with options", "options", options.Request)
 
 	execution.Start()
@@ -89,10 +404,10 @@ func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request
 	s.events.Notify(testkube.NewEventStartTest(&execution))
 
 	// update storage with current execution status
-	err = s.executionResults.StartExecution(ctx, execution.Id, execution.StartTime)
+	err := s.executionResults.StartExecution(ctx, execution.Id, execution.StartTime)
 	if err != nil {
 		s.events.Notify(testkube.NewEventEndTestFailed(&execution))
-		return execution.Errw(execution.Id, "can't execute test, can't insert into storage error: %w", err), nil
+		return execution.Errw(execution.Id, "can't execute test, can't insert into storage error: %w", err), true
 	}
 
 	// sync/async test execution
@@ -104,17 +419,17 @@ func (s *Scheduler) executeTest(ctx context.Context, test testkube.Test, request
 	// update storage with current execution status
 	if uerr := s.executionResults.UpdateResult(ctx, execution.Id, execution); uerr != nil {
 		s.events.Notify(testkube.NewEventEndTestFailed(&execution))
-		return execution.Errw(execution.Id, "update execution error: %w", uerr), nil
+		return execution.Errw(execution.Id, "update execution error: %w", uerr), true
 	}
 
 	if err != nil {
 		s.events.Notify(testkube.NewEventEndTestFailed(&execution))
-		return execution.Errw(execution.Id, "test execution failed: %w", err), nil
+		return execution.Errw(execution.Id, "test execution failed: %w", err), true
 	}
 
 	s.logger.Infow("test started", "executionId", execution.Id, "status", execution.ExecutionResult.Status)
 
-	return execution, nil
+	return execution, false
 }
 
 func (s *Scheduler) startTestExecution(ctx context.Context, options client.ExecuteOptions, execution *testkube.Execution) (result *testkube.ExecutionResult, err error) {
@@ -155,8 +470,23 @@ func (s *Scheduler) getNextExecutionNumber(testName string) int32 {
 
 // createSecretsReferences strips secrets from text and store it inside model as reference to secret
 func (s *Scheduler) createSecretsReferences(execution *testkube.Execution) (err error) {
-	secrets := map[string]string{}
-	secretName := execution.Id + "-vars"
+	secretName, labels, secrets := newSecretsReferences(execution)
+	if len(secrets) > 0 {
+		return s.secretClient.Create(
+			secretName,
+			labels,
+			secrets,
+		)
+	}
+
+	return nil
+}
+
+// newSecretsReferences replaces secret variable values of the execution with references to secret and returns
+// name, labels and data of the secret to create for them
+func newSecretsReferences(execution *testkube.Execution) (secretName string, labels, secrets map[string]string) {
+	secrets = map[string]string{}
+	secretName = execution.Id + "-vars"
 
 	for k, v := range execution.Variables {
 		if v.IsSecret() {
@@ -182,17 +512,8 @@ func (s *Scheduler) createSecretsReferences(execution *testkube.Execution) (err
 		}
 	}
 
-	labels := map[string]string{"executionID": execution.Id, "testName": execution.TestName}
-
-	if len(secrets) > 0 {
-		return s.secretClient.Create(
-			secretName,
-			labels,
-			secrets,
-		)
-	}
-
-	return nil
+	labels = map[string]string{"executionID": execution.Id, "testName": execution.TestName}
+	return secretName, labels, secrets
 }
 
 func newExecutionFromExecutionOptions(options client.ExecuteOptions) testkube.Execution {
@@ -225,6 +546,16 @@ func newExecutionFromExecutionOptions(options client.ExecuteOptions) testkube.Ex
 	execution.ExecutePostRunScriptBeforeScraping = options.Request.ExecutePostRunScriptBeforeScraping
 	execution.RunningContext = options.Request.RunningContext
 	execution.TestExecutionName = options.Request.TestExecutionName
+	execution.NegativeTest = options.Request.NegativeTest
+	execution.ParentExecutionId = options.Request.ParentExecutionId
+	execution.Matrix = options.Request.GetMatrixValues()
+	if options.Request.Shards > 1 {
+		execution.Shards = options.Request.Shards
+	}
+	execution.Image = options.ImageOverride
+	if execution.Image == "" {
+		execution.Image = options.ExecutorSpec.Image
+	}
 
 	return execution
 }
@@ -338,6 +669,10 @@ func (s *Scheduler) getExecuteOptions(namespace, id string, request testkube.Exe
 			request.ActiveDeadlineSeconds = test.ExecutionRequest.ActiveDeadlineSeconds
 		}
 
+		if request.Shards == 0 && test.ExecutionRequest.Shards != 0 {
+			request.Shards = test.ExecutionRequest.Shards
+		}
+
 		if !request.ExecutePostRunScriptBeforeScraping && test.ExecutionRequest.ExecutePostRunScriptBeforeScraping {
 			request.ExecutePostRunScriptBeforeScraping = test.ExecutionRequest.ExecutePostRunScriptBeforeScraping
 		}
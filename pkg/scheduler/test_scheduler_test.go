@@ -1,7 +1,10 @@
 package scheduler
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -59,6 +62,71 @@ func TestParamsNilAssign(t *testing.T) {
 
 }
 
+func TestGetRetryStatus(t *testing.T) {
+	t.Parallel()
+
+	started := func(status testkube.ExecutionStatus) testkube.Execution {
+		return testkube.Execution{StartTime: time.Now(), ExecutionResult: &testkube.ExecutionResult{Status: &status}}
+	}
+
+	tests := []struct {
+		name      string
+		execution testkube.Execution
+		errored   bool
+		status    testkube.RetryStatus
+		retryable bool
+	}{
+		{"failed", started(testkube.FAILED_ExecutionStatus), false, testkube.FAILED_RetryStatus, true},
+		{"timeout", started(testkube.TIMEOUT_ExecutionStatus), false, testkube.TIMEOUT_RetryStatus, true},
+		{"executor error", started(testkube.FAILED_ExecutionStatus), true, testkube.ERROR_RetryStatus, true},
+		{"passed", started(testkube.PASSED_ExecutionStatus), false, "", false},
+		{"aborted", started(testkube.ABORTED_ExecutionStatus), false, "", false},
+		{"not started", testkube.NewFailedExecution(errors.New("invalid test")), false, "", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, retryable := getRetryStatus(tt.execution, tt.errored)
+			assert.Equal(t, tt.retryable, retryable)
+			assert.Equal(t, tt.status, status)
+		})
+	}
+}
+
+func TestExpandExecutionRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request without matrix is kept", func(t *testing.T) {
+		t.Parallel()
+
+		request := testkube.ExecutionRequest{Name: "run"}
+		assert.Equal(t, []testkube.ExecutionRequest{request}, expandExecutionRequest(request))
+	})
+
+	t.Run("request is expanded for each matrix combination", func(t *testing.T) {
+		t.Parallel()
+
+		requests := expandExecutionRequest(testkube.ExecutionRequest{
+			Matrix:          map[string][]string{"region": {"eu", "us"}, "env": {"a"}},
+			Variables:       map[string]testkube.Variable{"user": testkube.NewBasicVariable("user", "admin")},
+			ExecutionLabels: map[string]string{"team": "api"},
+		})
+
+		assert.Len(t, requests, 2)
+		assert.NotEmpty(t, requests[0].ParentExecutionId)
+		assert.Equal(t, requests[0].ParentExecutionId, requests[1].ParentExecutionId)
+		assert.Equal(t, map[string]string{"env": "a", "region": "eu"}, requests[0].GetMatrixValues())
+		assert.Equal(t, map[string]string{"env": "a", "region": "us"}, requests[1].GetMatrixValues())
+		assert.Equal(t, "us", requests[1].Variables["region"].Value)
+		assert.Equal(t, "admin", requests[1].Variables["user"].Value)
+		assert.Equal(t, map[string]string{"team": "api", "env": "a", "region": "us"}, requests[1].ExecutionLabels)
+		assert.Equal(t, "eu", requests[0].Variables["region"].Value)
+	})
+}
+
 func TestGetExecuteOptions(t *testing.T) {
 	t.Parallel()
 
@@ -193,3 +261,34 @@ func TestGetExecuteOptions(t *testing.T) {
 
 	assert.Equal(t, want, got)
 }
+
+func TestDryRunTestExecution(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTestsClient := testsclientv3.NewMockInterface(mockCtrl)
+	mockTestsClient.EXPECT().Get("some-test").Return(nil, errors.New("not found"))
+	mockExecutor := client.NewMockExecutor(mockCtrl)
+	mockExecutor.EXPECT().DryRun(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	sc := Scheduler{
+		testsClient: mockTestsClient,
+		executor:    mockExecutor,
+		logger:      log.DefaultLogger,
+	}
+
+	execution := sc.dryRunTestExecution(context.Background(), client.ExecuteOptions{TestName: "some-test"}, testkube.Execution{
+		Id:              "dry-run",
+		TestName:        "some-test",
+		TestNamespace:   "testkube",
+		Variables:       map[string]testkube.Variable{"password": testkube.NewSecretVariable("password", "secret-value")},
+		ExecutionResult: testkube.NewRunningExecutionResult(),
+	})
+
+	assert.Nil(t, execution.ExecutionResult)
+	assert.Contains(t, execution.Manifests, "kind: Secret")
+	assert.Contains(t, execution.Manifests, redactedSecretValue)
+	assert.NotContains(t, execution.Manifests, "secret-value")
+}
@@ -0,0 +1,260 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/stepcondition"
+)
+
+// graphStep is a position of the step in test suite execution batches
+type graphStep struct {
+	batch int
+	index int
+}
+
+// graphStepResult is a result of the step finished in the graph
+type graphStepResult struct {
+	name   string
+	result testkube.TestSuiteStepExecutionResult
+}
+
+// runStepGraph runs test suite steps as a graph, each step starts as soon as all steps it depends on are finished,
+// batches only group steps sharing stopOnFailure and default condition
+func (s *Scheduler) runStepGraph(ctx context.Context, testsuiteExecution *testkube.TestSuiteExecution, request testkube.TestSuiteExecutionRequest,
+	statusChan chan *testkube.TestSuiteExecutionStatus, quarantine *testkube.QuarantinePolicy) (hasFailedSteps, cancelSteps bool, abortionStatus *testkube.TestSuiteExecutionStatus) {
+	var names []string
+	steps := map[string]graphStep{}
+	for i := range testsuiteExecution.ExecuteStepResults {
+		for j, result := range testsuiteExecution.ExecuteStepResults[i].Execute {
+			if result.Step == nil {
+				continue
+			}
+
+			// steps created without validation can have duplicate names, only the first one is used
+			name := result.Step.StepName()
+			if _, ok := steps[name]; ok {
+				continue
+			}
+
+			names = append(names, name)
+			steps[name] = graphStep{batch: i, index: j}
+		}
+	}
+
+	concurrencyLevel := DefaultConcurrencyLevel
+	if request.ConcurrencyLevel != 0 {
+		concurrencyLevel = int(request.ConcurrencyLevel)
+	}
+
+	stepResult := func(name string) *testkube.TestSuiteStepExecutionResult {
+		step := steps[name]
+		return &testsuiteExecution.ExecuteStepResults[step.batch].Execute[step.index]
+	}
+
+	started := map[string]bool{}
+	finished := map[string]bool{}
+	results := make(chan graphStepResult)
+	running := 0
+
+	// steps finished before the execution was resumed only restore its failure state
+	for _, name := range names {
+		if result := stepResult(name); result.IsFinished() {
+			started[name], finished[name] = true, true
+			failed, stopped := getFinishedStepsState(testsuiteExecution.ExecuteStepResults[steps[name].batch].Step, *result)
+			hasFailedSteps = hasFailedSteps || failed
+			cancelSteps = cancelSteps || stopped
+		}
+	}
+
+	for len(finished) < len(steps) {
+		changed := false
+		for _, name := range names {
+			if started[name] || running >= concurrencyLevel {
+				continue
+			}
+
+			result := stepResult(name)
+			ready, err := isGraphStepReady(result, steps, finished)
+			if err != nil {
+				result.Err(err)
+				hasFailedSteps = true
+			}
+
+			if !ready && err == nil {
+				continue
+			}
+
+			started[name] = true
+			changed = true
+			if err != nil {
+				finished[name] = true
+				continue
+			}
+
+			dependencies := getGraphDependencies(name, steps, stepResult)
+			dependencyFailed, dependencySkipped := false, false
+			for _, dependency := range dependencies {
+				if (dependency.IsFailed() && !dependency.Quarantined) || dependency.IsAborted() {
+					dependencyFailed = true
+				}
+			}
+
+			for _, dependency := range result.Step.DependsOn {
+				if stepResult(dependency).IsSkipped() {
+					dependencySkipped = true
+				}
+			}
+
+			// steps without own condition use condition of their batch
+			condition := result.Step.Condition
+			if batch := testsuiteExecution.ExecuteStepResults[steps[name].batch].Step; condition == "" && batch != nil {
+				condition = batch.Condition
+			}
+
+			// steps with explicit condition are still evaluated after a step stopped the test suite on failure
+			if cancelSteps && (abortionStatus != nil || condition == "") {
+				s.logger.Infow("Aborting graph step", "step", name)
+				if result.Execution != nil && result.Execution.ExecutionResult != nil {
+					result.Execution.ExecutionResult.Abort()
+				}
+
+				testsuiteExecution.Status = testkube.TestSuiteExecutionStatusAborting
+				finished[name] = true
+				continue
+			}
+
+			state := newStepConditionState(dependencies, testsuiteExecution.Variables, dependencyFailed)
+			run, err := stepcondition.Evaluate(condition, state, dependencyFailed || dependencySkipped)
+			if err != nil {
+				s.logger.Warnw("evaluating step condition", "condition", condition, "error", err)
+				result.Err(err)
+				hasFailedSteps = true
+				finished[name] = true
+				continue
+			}
+
+			if !run {
+				s.logger.Infow("Skipping graph step", "step", name)
+				result.Skip()
+				finished[name] = true
+				continue
+			}
+
+			s.logger.Infow("Running graph step", "step", name)
+			batch := testkube.TestSuiteBatchStepExecutionResult{
+				Step:    testsuiteExecution.ExecuteStepResults[steps[name].batch].Step,
+				Execute: []testkube.TestSuiteStepExecutionResult{*result},
+			}
+
+			if result.Execution != nil {
+				execution := *result.Execution
+				execution.ExecutionResult = execution.ExecutionResult.GetDeepCopy()
+				batch.Execute[0].Execution = &execution
+				if result.Execution.ExecutionResult != nil {
+					result.Execution.ExecutionResult.InProgress()
+				}
+			}
+
+			running++
+			go func(name string, execution testkube.TestSuiteExecution, outputs map[string]map[string]string) {
+				s.executeTestStep(ctx, execution, request, &batch, outputs)
+				results <- graphStepResult{name: name, result: batch.Execute[0]}
+			}(name, *testsuiteExecution, newStepOutputs(dependencies))
+		}
+
+		if changed {
+			if err := s.testExecutionResults.Update(ctx, *testsuiteExecution); err != nil {
+				s.logger.Errorw("saving test suite execution results error", "error", err)
+			}
+		}
+
+		if running == 0 {
+			if !changed {
+				// steps created without validation can depend on each other in a cycle
+				for _, name := range names {
+					if !finished[name] {
+						stepResult(name).Err(errors.New("step dependencies can't be satisfied, check the dependency cycle"))
+						finished[name] = true
+						hasFailedSteps = true
+					}
+				}
+			}
+
+			continue
+		}
+
+		select {
+		case r := <-results:
+			running--
+			finished[r.name] = true
+			result := stepResult(r.name)
+			*result = r.result
+
+			if result.IsFailed() {
+				if s.isQuarantined(ctx, quarantine, *result) {
+					result.Quarantined = true
+					testsuiteExecution.QuarantinedTests = append(testsuiteExecution.QuarantinedTests, result.Execution.TestName)
+				} else {
+					hasFailedSteps = true
+					if batch := testsuiteExecution.ExecuteStepResults[steps[r.name].batch].Step; batch != nil && batch.StopOnFailure {
+						cancelSteps = true
+					}
+				}
+			}
+
+			if err := s.testExecutionResults.Update(ctx, *testsuiteExecution); err != nil {
+				s.logger.Errorw("saving test suite execution results error", "error", err)
+			}
+		case status := <-statusChan:
+			abortionStatus = status
+			cancelSteps = true
+		}
+	}
+
+	for i := range testsuiteExecution.ExecuteStepResults {
+		testsuiteExecution.ExecuteStepResults[i].CalculateStatus()
+	}
+
+	return hasFailedSteps, cancelSteps, abortionStatus
+}
+
+// isGraphStepReady checks if all dependencies of the step are finished
+func isGraphStepReady(result *testkube.TestSuiteStepExecutionResult, steps map[string]graphStep, finished map[string]bool) (bool, error) {
+	for _, dependency := range result.Step.DependsOn {
+		if _, ok := steps[dependency]; !ok {
+			return false, fmt.Errorf("step %s depends on unknown step %s", result.Step.StepName(), dependency)
+		}
+
+		if !finished[dependency] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// getGraphDependencies returns results of all direct and transitive dependencies of the step
+func getGraphDependencies(name string, steps map[string]graphStep,
+	stepResult func(name string) *testkube.TestSuiteStepExecutionResult) (dependencies []testkube.TestSuiteStepExecutionResult) {
+	visited := map[string]bool{name: true}
+	queue := append([]string{}, stepResult(name).Step.DependsOn...)
+	for len(queue) != 0 {
+		dependency := queue[0]
+		queue = queue[1:]
+		if _, ok := steps[dependency]; !ok || visited[dependency] {
+			continue
+		}
+
+		visited[dependency] = true
+		result := stepResult(dependency)
+		dependencies = append(dependencies, *result)
+		if result.Step != nil {
+			queue = append(queue, result.Step.DependsOn...)
+		}
+	}
+
+	return dependencies
+}
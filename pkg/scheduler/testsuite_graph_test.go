@@ -0,0 +1,87 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+)
+
+func TestScheduler_runStepGraph(t *testing.T) {
+	t.Parallel()
+
+	newScheduler := func(t *testing.T) *Scheduler {
+		mockCtrl := gomock.NewController(t)
+		repository := testresult.NewMockRepository(mockCtrl)
+		repository.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		return &Scheduler{
+			logger:               log.DefaultLogger,
+			eventsBus:            bus.NewEventBusMock(),
+			testExecutionResults: repository,
+		}
+	}
+
+	status := func(execution *testkube.TestSuiteExecution, batch, index int) testkube.ExecutionStatus {
+		return *execution.ExecuteStepResults[batch].Execute[index].Execution.ExecutionResult.Status
+	}
+
+	t.Run("steps run after their dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		execution := testkube.NewStartedTestSuiteExecution(testkube.TestSuite{
+			Name: "suite",
+			Steps: []testkube.TestSuiteBatchStep{
+				{Execute: []testkube.TestSuiteStep{
+					{Name: "setup", Delay: "10ms"},
+					{Name: "independent", Delay: "10ms"},
+				}},
+				{Execute: []testkube.TestSuiteStep{
+					{Name: "check", Delay: "10ms", DependsOn: []string{"setup"}},
+					{Name: "diagnostics", Delay: "10ms", DependsOn: []string{"setup"}, Condition: "onFailure"},
+					{Name: "after-diagnostics", Delay: "10ms", DependsOn: []string{"diagnostics"}},
+				}},
+			},
+		}, testkube.TestSuiteExecutionRequest{})
+
+		hasFailedSteps, cancelSteps, abortionStatus := newScheduler(t).runStepGraph(context.Background(), &execution,
+			testkube.TestSuiteExecutionRequest{}, make(chan *testkube.TestSuiteExecutionStatus), nil)
+
+		assert.False(t, hasFailedSteps)
+		assert.False(t, cancelSteps)
+		assert.Nil(t, abortionStatus)
+		assert.Equal(t, testkube.PASSED_ExecutionStatus, status(&execution, 0, 0))
+		assert.Equal(t, testkube.PASSED_ExecutionStatus, status(&execution, 0, 1))
+		assert.Equal(t, testkube.PASSED_ExecutionStatus, status(&execution, 1, 0))
+		assert.Equal(t, testkube.SKIPPED_ExecutionStatus, status(&execution, 1, 1))
+		assert.Equal(t, testkube.SKIPPED_ExecutionStatus, status(&execution, 1, 2))
+		assert.Equal(t, testkube.ExecutionStatusPassed, execution.ExecuteStepResults[1].Status)
+	})
+
+	t.Run("steps in a cycle fail", func(t *testing.T) {
+		t.Parallel()
+
+		execution := testkube.NewStartedTestSuiteExecution(testkube.TestSuite{
+			Name: "suite",
+			Steps: []testkube.TestSuiteBatchStep{
+				{Execute: []testkube.TestSuiteStep{
+					{Name: "first", Delay: "10ms", DependsOn: []string{"second"}},
+					{Name: "second", Delay: "10ms", DependsOn: []string{"first"}},
+				}},
+			},
+		}, testkube.TestSuiteExecutionRequest{})
+
+		hasFailedSteps, _, _ := newScheduler(t).runStepGraph(context.Background(), &execution,
+			testkube.TestSuiteExecutionRequest{}, make(chan *testkube.TestSuiteExecutionStatus), nil)
+
+		assert.True(t, hasFailedSteps)
+		assert.Equal(t, testkube.FAILED_ExecutionStatus, status(&execution, 0, 0))
+		assert.Equal(t, testkube.FAILED_ExecutionStatus, status(&execution, 0, 1))
+	})
+}
@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// stepOutputReference matches references to outputs of earlier steps, like {{ steps.create-tenant.outputs.tenantId }}
+var stepOutputReference = regexp.MustCompile(`{{\s*steps\.([a-z0-9][-a-z0-9.]*?)\.outputs\.([A-Za-z0-9_.-]+)\s*}}`)
+
+// getStepOutputs returns outputs of test steps executed before the batch with given index by step name
+func getStepOutputs(testsuiteExecution *testkube.TestSuiteExecution, index int) map[string]map[string]string {
+	return newStepOutputs(getEarlierStepResults(testsuiteExecution, index))
+}
+
+// newStepOutputs returns outputs of given test steps by step name
+func newStepOutputs(results []testkube.TestSuiteStepExecutionResult) map[string]map[string]string {
+	outputs := map[string]map[string]string{}
+	for _, result := range results {
+		if result.Step == nil || result.Step.Test == "" ||
+			result.Execution == nil || result.Execution.ExecutionResult == nil || len(result.Execution.ExecutionResult.Outputs) == 0 {
+			continue
+		}
+
+		outputs[result.Step.StepName()] = result.Execution.ExecutionResult.Outputs
+	}
+
+	return outputs
+}
+
+// resolveStepOutputs returns variables with references to outputs of earlier steps replaced by their values
+func resolveStepOutputs(variables map[string]testkube.Variable, outputs map[string]map[string]string) (map[string]testkube.Variable, error) {
+	resolved := make(map[string]testkube.Variable, len(variables))
+	for name, variable := range variables {
+		var err error
+		variable.Value = stepOutputReference.ReplaceAllStringFunc(variable.Value, func(reference string) string {
+			match := stepOutputReference.FindStringSubmatch(reference)
+			value, ok := outputs[match[1]][match[2]]
+			if !ok && err == nil {
+				err = fmt.Errorf("variable %s references unknown output %s of step %s", name, match[2], match[1])
+			}
+
+			return value
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		resolved[name] = variable
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+func TestGetStepOutputs(t *testing.T) {
+	t.Parallel()
+
+	execution := testkube.NewStartedTestSuiteExecution(testkube.TestSuite{
+		Name: "suite",
+		Steps: []testkube.TestSuiteBatchStep{
+			{Execute: []testkube.TestSuiteStep{{Test: "create-tenant"}, {Delay: "1s"}}},
+			{Execute: []testkube.TestSuiteStep{{Test: "create-user"}}},
+			{Execute: []testkube.TestSuiteStep{{Test: "api"}}},
+		},
+	}, testkube.TestSuiteExecutionRequest{})
+	execution.ExecuteStepResults[0].Execute[0].Execution.ExecutionResult.Outputs = map[string]string{"tenantId": "t-1"}
+	execution.ExecuteStepResults[1].Execute[0].Execution.ExecutionResult.Outputs = map[string]string{"userId": "u-1"}
+
+	assert.Equal(t, map[string]map[string]string{}, getStepOutputs(&execution, 0))
+	assert.Equal(t, map[string]map[string]string{"create-tenant": {"tenantId": "t-1"}}, getStepOutputs(&execution, 1))
+	assert.Len(t, getStepOutputs(&execution, 2), 2)
+}
+
+func TestResolveStepOutputs(t *testing.T) {
+	t.Parallel()
+
+	outputs := map[string]map[string]string{
+		"create-tenant": {"tenantId": "t-1", "region": "eu"},
+	}
+
+	t.Run("references are replaced", func(t *testing.T) {
+		t.Parallel()
+
+		variables := map[string]testkube.Variable{
+			"TENANT": testkube.NewBasicVariable("TENANT", "{{ steps.create-tenant.outputs.tenantId }}"),
+			"URL":    testkube.NewBasicVariable("URL", "https://{{steps.create-tenant.outputs.region}}.example.com/{{ steps.create-tenant.outputs.tenantId }}"),
+			"ENV":    testkube.NewBasicVariable("ENV", "staging"),
+		}
+
+		resolved, err := resolveStepOutputs(variables, outputs)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "t-1", resolved["TENANT"].Value)
+		assert.Equal(t, "https://eu.example.com/t-1", resolved["URL"].Value)
+		assert.Equal(t, "staging", resolved["ENV"].Value)
+		assert.Equal(t, "{{ steps.create-tenant.outputs.tenantId }}", variables["TENANT"].Value)
+	})
+
+	t.Run("unknown output", func(t *testing.T) {
+		t.Parallel()
+
+		variables := map[string]testkube.Variable{
+			"USER": testkube.NewBasicVariable("USER", "{{ steps.create-user.outputs.userId }}"),
+		}
+
+		_, err := resolveStepOutputs(variables, outputs)
+
+		assert.Error(t, err)
+	})
+}
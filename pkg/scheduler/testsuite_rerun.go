@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// RerunTestSuiteExecution starts new execution of the test suite execution steps, with onlyFailed
+// failed, timed out and aborted steps run again and other step results are reused
+func (s *Scheduler) RerunTestSuiteExecution(ctx context.Context, execution testkube.TestSuiteExecution, onlyFailed bool) (
+	testsuiteExecution testkube.TestSuiteExecution, err error) {
+	testsuiteExecution, err = s.newRerunTestSuiteExecution(execution, onlyFailed)
+	if err != nil {
+		return testsuiteExecution, err
+	}
+
+	if err = s.testExecutionResults.Insert(ctx, testsuiteExecution); err != nil {
+		return testsuiteExecution, errors.Wrap(err, "inserting test suite execution")
+	}
+
+	s.events.Notify(testkube.NewEventStartTestSuite(&testsuiteExecution))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go s.runSteps(ctx, &wg, &testsuiteExecution, *testsuiteExecution.ExecutionRequest)
+
+	return testsuiteExecution, nil
+}
+
+// newRerunTestSuiteExecution returns rerun of the test suite execution owned by this replica
+func (s *Scheduler) newRerunTestSuiteExecution(execution testkube.TestSuiteExecution, onlyFailed bool) (
+	testsuiteExecution testkube.TestSuiteExecution, err error) {
+	if execution.TestSuite == nil || execution.TestSuite.Name == "" {
+		return testsuiteExecution, errors.Errorf("test suite execution %s has no test suite", execution.Id)
+	}
+
+	var request testkube.TestSuiteExecutionRequest
+	if execution.ExecutionRequest != nil {
+		request = *execution.ExecutionRequest
+	}
+
+	testSuiteName := execution.TestSuite.Name
+	request.SecretUUID, err = s.testSuitesClient.GetCurrentSecretUUID(testSuiteName)
+	if err != nil {
+		return testsuiteExecution, err
+	}
+
+	request.Number = s.getNextExecutionNumber("ts-" + testSuiteName)
+	request.Name = fmt.Sprintf("ts-%s-%d", testSuiteName, request.Number)
+
+	s.logger.Infow("Rerunning test suite execution", "execution", execution.Id, "onlyFailed", onlyFailed)
+
+	testsuiteExecution = testkube.NewRerunTestSuiteExecution(execution, request, onlyFailed)
+	testsuiteExecution.RunnerId = s.runnerID
+	testsuiteExecution.HeartbeatTime = time.Now()
+	return testsuiteExecution, nil
+}
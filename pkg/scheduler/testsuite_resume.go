@@ -0,0 +1,176 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+)
+
+var errStepInterrupted = errors.New("test execution was interrupted by api server restart before it started")
+
+// ResumeTestSuiteExecutions continues test suite executions interrupted by api server restart, only executions
+// of this replica or with a stale heartbeat are claimed, running steps are reattached to their jobs and
+// the execution continues with remaining steps
+func (s *Scheduler) ResumeTestSuiteExecutions(ctx context.Context) error {
+	executions, err := s.testExecutionResults.GetExecutions(ctx, testresult.NewExecutionsFilter().
+		WithStatus(string(*testkube.TestSuiteExecutionStatusRunning)).WithPageSize(math.MaxInt32))
+	if err != nil {
+		return err
+	}
+
+	for i := range executions {
+		if _, ok := s.runningTestSuites.Load(executions[i].Id); ok {
+			continue
+		}
+
+		claimed, err := s.testExecutionResults.Claim(ctx, executions[i].Id, s.runnerID, time.Now().Add(-heartbeatTimeout))
+		if err != nil {
+			s.logger.Errorw("claiming test suite execution", "id", executions[i].Id, "error", err)
+			continue
+		}
+
+		if !claimed {
+			continue
+		}
+
+		s.logger.Infow("resuming test suite execution", "id", executions[i].Id, "name", executions[i].Name,
+			"previousRunner", executions[i].RunnerId)
+		release := s.keep(ctx, &s.runningTestSuites, executions[i].Id, s.testExecutionResults.Claim)
+		go s.resumeTestSuiteExecution(ctx, executions[i], release)
+	}
+
+	return nil
+}
+
+// resumeTestSuiteExecution resolves steps which were running during api server restart and runs remaining steps,
+// the execution is released once all its steps are finished
+func (s *Scheduler) resumeTestSuiteExecution(ctx context.Context, testsuiteExecution testkube.TestSuiteExecution, release func()) {
+	defer release()
+
+	var request testkube.TestSuiteExecutionRequest
+	if testsuiteExecution.ExecutionRequest != nil {
+		request = *testsuiteExecution.ExecutionRequest
+	}
+
+	request.Sync = false
+	request.Timeout = getRemainingTimeout(request.Timeout, testsuiteExecution.StartTime, time.Now())
+
+	var wg sync.WaitGroup
+	for i := range testsuiteExecution.ExecuteStepResults {
+		for j := range testsuiteExecution.ExecuteStepResults[i].Execute {
+			result := &testsuiteExecution.ExecuteStepResults[i].Execute[j]
+			if result.Execution == nil || result.Execution.ExecutionResult == nil || !result.Execution.ExecutionResult.IsRunning() {
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.resumeTestSuiteStep(ctx, result)
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	for i := range testsuiteExecution.ExecuteStepResults {
+		if testsuiteExecution.ExecuteStepResults[i].IsFinished() {
+			testsuiteExecution.ExecuteStepResults[i].CalculateStatus()
+		}
+	}
+
+	if err := s.testExecutionResults.Update(ctx, testsuiteExecution); err != nil {
+		s.logger.Errorw("saving resumed test suite execution error", "id", testsuiteExecution.Id, "error", err)
+	}
+
+	wg.Add(1)
+	s.runSteps(ctx, &wg, &testsuiteExecution, request)
+}
+
+// resumeTestSuiteStep waits for the step execution started or queued before api server restart,
+// steps which weren't stored yet are queued to run again
+func (s *Scheduler) resumeTestSuiteStep(ctx context.Context, result *testkube.TestSuiteStepExecutionResult) {
+	execution, err := s.executionResults.Get(ctx, result.Execution.Id)
+	if err == mongo.ErrNoDocuments {
+		s.logger.Infow("queueing test suite step again", "executionId", result.Execution.Id)
+		result.Execution.ExecutionResult = &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued}
+		return
+	}
+
+	if err != nil {
+		result.Err(fmt.Errorf("can't get test execution: %w", err))
+		return
+	}
+
+	result.Execution = &execution
+	if execution.IsQueued() && execution.ExecutionRequest != nil {
+		*result.Execution = s.waitForExecution(ctx, execution)
+		return
+	}
+
+	if execution.ExecutionResult == nil || execution.ExecutionResult.IsQueued() {
+		s.failResumedExecution(ctx, result.Execution, errStepInterrupted)
+		return
+	}
+
+	if !execution.ExecutionResult.IsRunning() {
+		return
+	}
+
+	options, err := s.getExecuteOptions(execution.TestNamespace, execution.TestName, testkube.ExecutionRequest{
+		Id:                         execution.Id,
+		Name:                       execution.Name,
+		Number:                     execution.Number,
+		Sync:                       true,
+		NegativeTest:               execution.NegativeTest,
+		IsNegativeTestChangedOnRun: true,
+	})
+	if err != nil {
+		s.failResumedExecution(ctx, result.Execution, fmt.Errorf("can't create valid execution options: %w", err))
+		return
+	}
+
+	s.logger.Infow("reattaching to test execution", "executionId", execution.Id, "test", execution.TestName)
+	executionResult, err := s.getExecutor(execution.TestName).Reattach(ctx, result.Execution, options)
+	if executionResult != nil {
+		result.Execution.ExecutionResult = executionResult
+	}
+
+	if err != nil {
+		s.logger.Errorw("reattaching to test execution error", "executionId", execution.Id, "error", err)
+	}
+}
+
+// failResumedExecution stores failure of the execution which can't be resumed or started from the queue
+func (s *Scheduler) failResumedExecution(ctx context.Context, execution *testkube.Execution, err error) {
+	*execution = execution.Err(err)
+	execution.Stop()
+	if uerr := s.executionResults.UpdateResult(ctx, execution.Id, *execution); uerr != nil {
+		s.logger.Errorw("saving resumed test execution error", "executionId", execution.Id, "error", uerr)
+	}
+
+	s.events.Notify(testkube.NewEventEndTestFailed(execution))
+}
+
+// getRemainingTimeout returns the part of test suite timeout left after the restart, already exceeded timeout
+// is shortened to a second to time out the execution right after it's resumed
+func getRemainingTimeout(timeout int32, startTime, now time.Time) int32 {
+	if timeout <= 0 || startTime.IsZero() {
+		return timeout
+	}
+
+	remaining := int32(math.Ceil(float64(timeout) - now.Sub(startTime).Seconds()))
+	if remaining < 1 {
+		return 1
+	}
+
+	return remaining
+}
@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+)
+
+func TestGetRemainingTimeout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no timeout", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, int32(0), getRemainingTimeout(0, now.Add(-time.Hour), now))
+	})
+
+	t.Run("remaining part of timeout", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, int32(90), getRemainingTimeout(300, now.Add(-210*time.Second), now))
+	})
+
+	t.Run("exceeded timeout", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, int32(1), getRemainingTimeout(60, now.Add(-time.Hour), now))
+	})
+}
+
+func TestGetFinishedStepsState(t *testing.T) {
+	t.Parallel()
+
+	newResult := func(status *testkube.ExecutionStatus, quarantined bool) testkube.TestSuiteStepExecutionResult {
+		return testkube.TestSuiteStepExecutionResult{
+			Execution:   &testkube.Execution{ExecutionResult: &testkube.ExecutionResult{Status: status}},
+			Quarantined: quarantined,
+		}
+	}
+
+	t.Run("passed steps", func(t *testing.T) {
+		t.Parallel()
+
+		failed, stopped := getFinishedStepsState(&testkube.TestSuiteBatchStep{StopOnFailure: true},
+			newResult(testkube.ExecutionStatusPassed, false), newResult(testkube.ExecutionStatusSkipped, false))
+
+		assert.False(t, failed)
+		assert.False(t, stopped)
+	})
+
+	t.Run("failed step stops test suite", func(t *testing.T) {
+		t.Parallel()
+
+		failed, stopped := getFinishedStepsState(&testkube.TestSuiteBatchStep{StopOnFailure: true},
+			newResult(testkube.ExecutionStatusPassed, false), newResult(testkube.ExecutionStatusFailed, false))
+
+		assert.True(t, failed)
+		assert.True(t, stopped)
+	})
+
+	t.Run("failed step without stop on failure", func(t *testing.T) {
+		t.Parallel()
+
+		failed, stopped := getFinishedStepsState(&testkube.TestSuiteBatchStep{}, newResult(testkube.ExecutionStatusFailed, false))
+
+		assert.True(t, failed)
+		assert.False(t, stopped)
+	})
+
+	t.Run("quarantined failure is ignored", func(t *testing.T) {
+		t.Parallel()
+
+		failed, stopped := getFinishedStepsState(&testkube.TestSuiteBatchStep{StopOnFailure: true}, newResult(testkube.ExecutionStatusFailed, true))
+
+		assert.False(t, failed)
+		assert.False(t, stopped)
+	})
+}
+
+func TestResumeTestSuiteExecutions_rerun(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTestSuitesClient := testsuitesclientv3.NewMockInterface(mockCtrl)
+	mockResultRepository := result.NewMockRepository(mockCtrl)
+	mockTestSuitesClient.EXPECT().GetCurrentSecretUUID("suite").Return("", nil)
+	mockResultRepository.EXPECT().GetNextExecutionNumber(gomock.Any(), "ts-suite").Return(int32(2), nil)
+
+	testResultRepository := testresult.NewSQLRepository(db)
+	owner := &Scheduler{
+		runnerID:             "replica-1",
+		testSuitesClient:     mockTestSuitesClient,
+		executionResults:     mockResultRepository,
+		testExecutionResults: testResultRepository,
+		logger:               log.DefaultLogger,
+	}
+	other := &Scheduler{
+		runnerID:             "replica-2",
+		testExecutionResults: testResultRepository,
+		logger:               log.DefaultLogger,
+	}
+
+	execution := testkube.NewStartedTestSuiteExecution(testkube.TestSuite{
+		Name:  "suite",
+		Steps: []testkube.TestSuiteBatchStep{{Execute: []testkube.TestSuiteStep{{Test: "api"}}}},
+	}, testkube.TestSuiteExecutionRequest{Name: "ts-suite-1"})
+	execution.ExecuteStepResults[0].Execute[0].Execution.ExecutionResult.Error()
+	execution.Status = testkube.TestSuiteExecutionStatusFailed
+
+	rerun, err := owner.newRerunTestSuiteExecution(execution, true)
+	require.NoError(t, err)
+	assert.Equal(t, "replica-1", rerun.RunnerId)
+	assert.False(t, rerun.HeartbeatTime.IsZero())
+	require.NoError(t, testResultRepository.Insert(ctx, rerun))
+
+	require.NoError(t, other.ResumeTestSuiteExecutions(ctx))
+	_, resumed := other.runningTestSuites.Load(rerun.Id)
+	assert.False(t, resumed)
+
+	claimed, err := testResultRepository.Claim(ctx, rerun.Id, owner.runnerID, time.Time{})
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	stored, err := testResultRepository.Get(ctx, rerun.Id)
+	require.NoError(t, err)
+	assert.Equal(t, "replica-1", stored.RunnerId)
+}
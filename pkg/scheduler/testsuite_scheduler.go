@@ -12,8 +12,10 @@ import (
 	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/flakiness"
 	testsuiteexecutionsmapper "github.com/kubeshop/testkube/pkg/mapper/testsuiteexecutions"
 	testsuitesmapper "github.com/kubeshop/testkube/pkg/mapper/testsuites"
+	"github.com/kubeshop/testkube/pkg/stepcondition"
 	"github.com/kubeshop/testkube/pkg/telemetry"
 	"github.com/kubeshop/testkube/pkg/version"
 	"github.com/kubeshop/testkube/pkg/workerpool"
@@ -28,6 +30,8 @@ const (
 type testTuple struct {
 	test        testkube.Test
 	executionID string
+	variables   map[string]testkube.Variable
+	retryPolicy *testkube.RetryPolicy
 }
 
 func (s *Scheduler) PrepareTestSuiteRequests(work []testsuitesv3.TestSuite, request testkube.TestSuiteExecutionRequest) []workerpool.Request[
@@ -118,6 +122,8 @@ func (s *Scheduler) executeTestSuite(ctx context.Context, testSuite testkube.Tes
 	}
 
 	testsuiteExecution = testkube.NewStartedTestSuiteExecution(testSuite, request)
+	testsuiteExecution.RunnerId = s.runnerID
+	testsuiteExecution.HeartbeatTime = time.Now()
 	err = s.testExecutionResults.Insert(ctx, testsuiteExecution)
 	if err != nil {
 		s.logger.Infow("Inserting test execution", "error", err)
@@ -139,18 +145,18 @@ func (s *Scheduler) executeTestSuite(ctx context.Context, testSuite testkube.Tes
 
 func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteExecution *testkube.TestSuiteExecution, request testkube.TestSuiteExecutionRequest) {
 	defer s.runAfterEachStep(ctx, testsuiteExecution, wg)
+	defer s.keep(ctx, &s.runningTestSuites, testsuiteExecution.Id, s.testExecutionResults.Claim)()
 
 	s.logger.Infow("Running steps", "test", testsuiteExecution.Name)
 
 	statusChan := make(chan *testkube.TestSuiteExecutionStatus)
-	hasFailedSteps := false
-	cancelSteps := false
-	var batchStepResult *testkube.TestSuiteBatchStepExecutionResult
-
+	var hasFailedSteps, cancelSteps bool
 	var abortionStatus *testkube.TestSuiteExecutionStatus
 
 	go s.timeoutCheck(ctx, testsuiteExecution, request.Timeout)
 
+	quarantine := s.getQuarantinePolicy(ctx)
+
 	err := s.eventsBus.SubscribeTopic(bus.InternalSubscribeTopic, testsuiteExecution.Name, func(event testkube.Event) error {
 		s.logger.Infow("test suite abortion event in runSteps", "event", event)
 		if event.TestSuiteExecution != nil &&
@@ -172,10 +178,56 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 		s.logger.Errorw("error subscribing to event", "error", err)
 	}
 
+	if testsuiteExecution.IsStepGraph() {
+		hasFailedSteps, cancelSteps, abortionStatus = s.runStepGraph(ctx, testsuiteExecution, request, statusChan, quarantine)
+	} else {
+		hasFailedSteps, cancelSteps, abortionStatus = s.runBatches(ctx, testsuiteExecution, request, statusChan, quarantine)
+	}
+
+	s.logger.Infow("Finished running steps", "test", testsuiteExecution.Name, "hasFailedSteps", hasFailedSteps, "cancelSteps", cancelSteps, "status", testsuiteExecution.Status)
+
+	if testsuiteExecution.Status != nil && *testsuiteExecution.Status == testkube.ABORTING_TestSuiteExecutionStatus {
+		if abortionStatus != nil && *abortionStatus == testkube.TIMEOUT_TestSuiteExecutionStatus {
+			s.events.Notify(testkube.NewEventEndTestSuiteTimeout(testsuiteExecution))
+			testsuiteExecution.Status = testkube.TestSuiteExecutionStatusTimeout
+		} else {
+			s.events.Notify(testkube.NewEventEndTestSuiteAborted(testsuiteExecution))
+			testsuiteExecution.Status = testkube.TestSuiteExecutionStatusAborted
+		}
+	} else if hasFailedSteps {
+		testsuiteExecution.Status = testkube.TestSuiteExecutionStatusFailed
+		s.events.Notify(testkube.NewEventEndTestSuiteFailed(testsuiteExecution))
+	} else {
+		testsuiteExecution.Status = testkube.TestSuiteExecutionStatusPassed
+		s.events.Notify(testkube.NewEventEndTestSuiteSuccess(testsuiteExecution))
+	}
+
+	s.metrics.IncExecuteTestSuite(*testsuiteExecution, s.dashboardURI)
+
+	err = s.testExecutionResults.Update(ctx, *testsuiteExecution)
+	if err != nil {
+		s.logger.Errorw("saving final test suite execution result error", "error", err)
+	}
+
+	s.eventsBus.Unsubscribe(testsuiteExecution.Name)
+}
+
+// runBatches runs test suite batches one after another, steps of a batch run in parallel
+func (s *Scheduler) runBatches(ctx context.Context, testsuiteExecution *testkube.TestSuiteExecution, request testkube.TestSuiteExecutionRequest,
+	statusChan chan *testkube.TestSuiteExecutionStatus, quarantine *testkube.QuarantinePolicy) (hasFailedSteps, cancelSteps bool, abortionStatus *testkube.TestSuiteExecutionStatus) {
+	var batchStepResult *testkube.TestSuiteBatchStepExecutionResult
 	for i := range testsuiteExecution.ExecuteStepResults {
 		batchStepResult = &testsuiteExecution.ExecuteStepResults[i]
 		s.logger.Debugw("Running batch step", "step", batchStepResult.Execute, "i", i)
 
+		// batches finished before the execution was resumed only restore its failure state
+		if batchStepResult.IsFinished() {
+			failed, stopped := getFinishedStepsState(batchStepResult.Step, batchStepResult.Execute...)
+			hasFailedSteps = hasFailedSteps || failed
+			cancelSteps = cancelSteps || stopped
+			continue
+		}
+
 		select {
 		case status := <-statusChan:
 			abortionStatus = status
@@ -183,7 +235,8 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 		default:
 		}
 
-		if cancelSteps {
+		// batches with explicit condition are still evaluated after earlier batch stopped on failure
+		if cancelSteps && (abortionStatus != nil || batchStepResult.Step == nil || batchStepResult.Step.Condition == "") {
 			s.logger.Infow("Aborting batch step", "step", batchStepResult.Execute, "i", i)
 			for j := range batchStepResult.Execute {
 				if batchStepResult.Execute[j].Execution != nil && batchStepResult.Execute[j].Execution.ExecutionResult != nil {
@@ -202,8 +255,21 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 			continue
 		}
 
+		if !s.applyStepConditions(testsuiteExecution, i, hasFailedSteps, cancelSteps) {
+			s.logger.Infow("Skipping batch step", "step", batchStepResult.Execute, "i", i)
+			if err := s.testExecutionResults.Update(ctx, *testsuiteExecution); err != nil {
+				s.logger.Errorw("saving test suite execution results error", "error", err)
+			}
+
+			continue
+		}
+
 		// start execution of given step
 		for j := range batchStepResult.Execute {
+			if batchStepResult.Execute[j].IsFinished() || batchStepResult.Execute[j].IsFailed() {
+				continue
+			}
+
 			if batchStepResult.Execute[j].Execution != nil && batchStepResult.Execute[j].Execution.ExecutionResult != nil {
 				batchStepResult.Execute[j].Execution.ExecutionResult.InProgress()
 			}
@@ -214,7 +280,7 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 			s.logger.Infow("Updating test execution", "error", err)
 		}
 
-		s.executeTestStep(ctx, *testsuiteExecution, request, batchStepResult)
+		s.executeTestStep(ctx, *testsuiteExecution, request, batchStepResult, getStepOutputs(testsuiteExecution, i))
 
 		var results []*testkube.ExecutionResult
 		for j := range batchStepResult.Execute {
@@ -235,6 +301,12 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 
 		for j := range batchStepResult.Execute {
 			if batchStepResult.Execute[j].IsFailed() {
+				if s.isQuarantined(ctx, quarantine, batchStepResult.Execute[j]) {
+					batchStepResult.Execute[j].Quarantined = true
+					testsuiteExecution.QuarantinedTests = append(testsuiteExecution.QuarantinedTests, batchStepResult.Execute[j].Execution.TestName)
+					continue
+				}
+
 				hasFailedSteps = true
 				if batchStepResult.Step != nil && batchStepResult.Step.StopOnFailure {
 					cancelSteps = true
@@ -242,33 +314,143 @@ func (s *Scheduler) runSteps(ctx context.Context, wg *sync.WaitGroup, testsuiteE
 				}
 			}
 		}
+
+		batchStepResult.CalculateStatus()
 	}
-	s.logger.Infow("Finished running steps", "test", testsuiteExecution.Name, "hasFailedSteps", hasFailedSteps, "cancelSteps", cancelSteps, "status", testsuiteExecution.Status)
 
-	if testsuiteExecution.Status != nil && *testsuiteExecution.Status == testkube.ABORTING_TestSuiteExecutionStatus {
-		if abortionStatus != nil && *abortionStatus == testkube.TIMEOUT_TestSuiteExecutionStatus {
-			s.events.Notify(testkube.NewEventEndTestSuiteTimeout(testsuiteExecution))
-			testsuiteExecution.Status = testkube.TestSuiteExecutionStatusTimeout
-		} else {
-			s.events.Notify(testkube.NewEventEndTestSuiteAborted(testsuiteExecution))
-			testsuiteExecution.Status = testkube.TestSuiteExecutionStatusAborted
+	return hasFailedSteps, cancelSteps, abortionStatus
+}
+
+// getFinishedStepsState returns if given steps finished before the execution was resumed have failed
+// and if the failure stopped the test suite, quarantined failures are ignored
+func getFinishedStepsState(batch *testkube.TestSuiteBatchStep, results ...testkube.TestSuiteStepExecutionResult) (failed, stopped bool) {
+	for _, result := range results {
+		if result.IsFailed() && !result.Quarantined {
+			failed = true
+			if batch != nil && batch.StopOnFailure {
+				stopped = true
+			}
 		}
-	} else if hasFailedSteps {
-		testsuiteExecution.Status = testkube.TestSuiteExecutionStatusFailed
-		s.events.Notify(testkube.NewEventEndTestSuiteFailed(testsuiteExecution))
-	} else {
-		testsuiteExecution.Status = testkube.TestSuiteExecutionStatusPassed
-		s.events.Notify(testkube.NewEventEndTestSuiteSuccess(testsuiteExecution))
 	}
 
-	s.metrics.IncExecuteTestSuite(*testsuiteExecution, s.dashboardURI)
+	return failed, stopped
+}
 
-	err = s.testExecutionResults.Update(ctx, *testsuiteExecution)
+// applyStepConditions evaluates conditions of the batch and its steps against results of earlier batches,
+// steps which shouldn't run are marked as skipped, it returns false when there is nothing to run in the batch
+func (s *Scheduler) applyStepConditions(testsuiteExecution *testkube.TestSuiteExecution, index int, hasFailedSteps, stopped bool) bool {
+	batchStepResult := &testsuiteExecution.ExecuteStepResults[index]
+	if batchStepResult.Step == nil {
+		return true
+	}
+
+	state := getStepConditionState(testsuiteExecution, index, hasFailedSteps)
+	run, err := stepcondition.Evaluate(batchStepResult.Step.Condition, state, stopped)
 	if err != nil {
-		s.logger.Errorw("saving final test suite execution result error", "error", err)
+		s.logger.Warnw("evaluating batch step condition", "condition", batchStepResult.Step.Condition, "error", err)
+		for j := range batchStepResult.Execute {
+			batchStepResult.Execute[j].Err(err)
+		}
+
+		return true
 	}
 
-	s.eventsBus.Unsubscribe(testsuiteExecution.Name)
+	if !run {
+		batchStepResult.Skip()
+		return false
+	}
+
+	for j := range batchStepResult.Execute {
+		step := batchStepResult.Execute[j].Step
+		if step == nil || step.Condition == "" {
+			continue
+		}
+
+		run, err = stepcondition.Evaluate(step.Condition, state, stopped)
+		if err != nil {
+			s.logger.Warnw("evaluating step condition", "condition", step.Condition, "error", err)
+			batchStepResult.Execute[j].Err(err)
+			continue
+		}
+
+		if !run {
+			batchStepResult.Execute[j].Skip()
+		}
+	}
+
+	return true
+}
+
+// getStepConditionState returns statuses of steps executed before the batch with given index and execution variables
+func getStepConditionState(testsuiteExecution *testkube.TestSuiteExecution, index int, hasFailedSteps bool) stepcondition.State {
+	return newStepConditionState(getEarlierStepResults(testsuiteExecution, index), testsuiteExecution.Variables, hasFailedSteps)
+}
+
+// newStepConditionState returns statuses of given test steps by step name and values of variables
+func newStepConditionState(results []testkube.TestSuiteStepExecutionResult, variables map[string]testkube.Variable, failed bool) stepcondition.State {
+	state := stepcondition.State{
+		Failed:    failed,
+		Steps:     map[string]string{},
+		Variables: map[string]string{},
+	}
+
+	for _, result := range results {
+		if result.Step == nil || result.Step.Test == "" ||
+			result.Execution == nil || result.Execution.ExecutionResult == nil || result.Execution.ExecutionResult.Status == nil {
+			continue
+		}
+
+		state.Steps[result.Step.StepName()] = string(*result.Execution.ExecutionResult.Status)
+	}
+
+	for name, variable := range variables {
+		state.Variables[name] = variable.Value
+	}
+
+	return state
+}
+
+// getEarlierStepResults returns results of steps from batches before the batch with given index
+func getEarlierStepResults(testsuiteExecution *testkube.TestSuiteExecution, index int) (results []testkube.TestSuiteStepExecutionResult) {
+	for i := 0; i < index && i < len(testsuiteExecution.ExecuteStepResults); i++ {
+		results = append(results, testsuiteExecution.ExecuteStepResults[i].Execute...)
+	}
+
+	return results
+}
+
+// getQuarantinePolicy returns quarantine of flaky tests, failures are never quarantined when it can't be read
+func (s *Scheduler) getQuarantinePolicy(ctx context.Context) *testkube.QuarantinePolicy {
+	if s.configMap == nil {
+		return nil
+	}
+
+	config, err := s.configMap.Get(ctx)
+	if err != nil {
+		s.logger.Warnw("getting quarantine policy", "error", err)
+		return nil
+	}
+
+	return config.Quarantine
+}
+
+// isQuarantined checks if failed step execution belongs to a flaky test and shouldn't fail the test suite execution
+func (s *Scheduler) isQuarantined(ctx context.Context, quarantine *testkube.QuarantinePolicy, result testkube.TestSuiteStepExecutionResult) bool {
+	if !quarantine.IsEnabled() || result.Execution == nil || result.Execution.TestName == "" {
+		return false
+	}
+
+	testFlakiness, err := flakiness.NewAnalyzer(s.executionResults, nil).GetTestFlakiness(ctx, result.Execution.TestName, quarantine)
+	if err != nil {
+		s.logger.Warnw("getting test flakiness", "test", result.Execution.TestName, "error", err)
+		return false
+	}
+
+	if testFlakiness.Quarantined {
+		s.logger.Infow("quarantining failure of flaky test", "test", result.Execution.TestName, "execution", result.Execution.Id, "score", testFlakiness.Score)
+	}
+
+	return testFlakiness.Quarantined
 }
 
 func (s *Scheduler) runAfterEachStep(ctx context.Context, execution *testkube.TestSuiteExecution, wg *sync.WaitGroup) {
@@ -378,7 +560,7 @@ func (s *Scheduler) timeoutCheck(ctx context.Context, testsuiteExecution *testku
 }
 
 func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution testkube.TestSuiteExecution,
-	request testkube.TestSuiteExecutionRequest, result *testkube.TestSuiteBatchStepExecutionResult) {
+	request testkube.TestSuiteExecutionRequest, result *testkube.TestSuiteBatchStepExecutionResult, outputs map[string]map[string]string) {
 
 	var testSuiteName string
 	if testsuiteExecution.TestSuite != nil {
@@ -389,7 +571,7 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 	var duration time.Duration
 	for i := range result.Execute {
 		step := result.Execute[i].Step
-		if step == nil {
+		if step == nil || result.Execute[i].IsFinished() || result.Execute[i].IsFailed() {
 			continue
 		}
 
@@ -407,11 +589,19 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 				continue
 			}
 
-			l.Info("executing test", "variables", testsuiteExecution.Variables, "request", request)
+			variables, err := resolveStepOutputs(testsuiteExecution.Variables, outputs)
+			if err != nil {
+				result.Execute[i].Err(err)
+				continue
+			}
+
+			l.Info("executing test", "variables", variables, "request", request)
 
 			testTuples = append(testTuples, testTuple{
 				test:        testkube.Test{Name: executeTestStep},
 				executionID: execution.Id,
+				variables:   variables,
+				retryPolicy: step.RetryPolicy,
 			})
 		case testkube.TestSuiteStepTypeDelay:
 			if step.Delay == "" {
@@ -444,7 +634,6 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 	if len(testTuples) != 0 {
 		req := testkube.ExecutionRequest{
 			TestSuiteName:         testSuiteName,
-			Variables:             testsuiteExecution.Variables,
 			TestSuiteSecretUUID:   request.SecretUUID,
 			Sync:                  true,
 			HttpProxy:             request.HttpProxy,
@@ -462,12 +651,15 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 			ScraperTemplateReference: request.ScraperTemplateReference,
 			PvcTemplate:              request.PvcTemplate,
 			PvcTemplateReference:     request.PvcTemplateReference,
+			PriorityClass:            testkube.GetPriorityClass(testsuiteExecution.RunningContext),
 		}
 
 		requests := make([]workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution], len(testTuples))
 		for i := range testTuples {
 			req.Name = fmt.Sprintf("%s-%s", testSuiteName, testTuples[i].test.Name)
 			req.Id = testTuples[i].executionID
+			req.Variables = testTuples[i].variables
+			req.RetryPolicy = testTuples[i].retryPolicy
 			requests[i] = workerpool.Request[testkube.Test, testkube.ExecutionRequest, testkube.Execution]{
 				Object:  testTuples[i].test,
 				Options: req,
@@ -486,7 +678,13 @@ func (s *Scheduler) executeTestStep(ctx context.Context, testsuiteExecution test
 	results := make(map[string]testkube.Execution, len(testTuples))
 	if len(testTuples) != 0 {
 		for r := range workerpoolService.GetResponses() {
-			results[r.Result.Id] = r.Result
+			// retried step is represented by its final attempt linked to the step execution
+			id := r.Result.Id
+			if r.Result.RetryOf != "" {
+				id = r.Result.RetryOf
+			}
+
+			results[id] = r.Result
 			status := ""
 			if r.Result.ExecutionResult != nil && r.Result.ExecutionResult.Status != nil {
 				status = string(*r.Result.ExecutionResult.Status)
@@ -539,7 +737,7 @@ func (s *Scheduler) delayWithAbortionCheck(duration time.Duration, testSuiteId s
 			s.logger.Infow("delay finished", "testSuiteId", testSuiteId, "duration", duration)
 
 			for i := range result.Execute {
-				if result.Execute[i].Step != nil && result.Execute[i].Step.Delay != "" &&
+				if result.Execute[i].Step != nil && result.Execute[i].Step.Delay != "" && !result.Execute[i].IsSkipped() &&
 					result.Execute[i].Execution != nil && result.Execute[i].Execution.ExecutionResult != nil {
 					result.Execute[i].Execution.ExecutionResult.Success()
 				}
@@ -549,7 +747,7 @@ func (s *Scheduler) delayWithAbortionCheck(duration time.Duration, testSuiteId s
 		case <-abortChan:
 
 			for i := range result.Execute {
-				if result.Execute[i].Step != nil && result.Execute[i].Step.Delay != "" &&
+				if result.Execute[i].Step != nil && result.Execute[i].Step.Delay != "" && !result.Execute[i].IsSkipped() &&
 					result.Execute[i].Execution != nil && result.Execute[i].Execution.ExecutionResult != nil {
 					delay, err := time.ParseDuration(result.Execute[i].Step.Delay)
 					if err != nil {
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/log"
+)
+
+func TestScheduler_applyStepConditions(t *testing.T) {
+	t.Parallel()
+
+	newExecution := func(batches ...testkube.TestSuiteBatchStep) *testkube.TestSuiteExecution {
+		execution := testkube.NewStartedTestSuiteExecution(testkube.TestSuite{Name: "suite", Steps: batches}, testkube.TestSuiteExecutionRequest{})
+		execution.Variables = map[string]testkube.Variable{"env": testkube.NewBasicVariable("env", "staging")}
+		execution.ExecuteStepResults[0].Execute[0].Execution.ExecutionResult.Error()
+		return &execution
+	}
+
+	s := &Scheduler{logger: log.DefaultLogger}
+
+	t.Run("batch runs on failure", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution(
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{{Test: "api"}}},
+			testkube.TestSuiteBatchStep{Condition: "onFailure", Execute: []testkube.TestSuiteStep{{Test: "diagnostics"}}},
+		)
+
+		assert.True(t, s.applyStepConditions(execution, 1, true, true))
+		assert.False(t, execution.ExecuteStepResults[1].Execute[0].IsSkipped())
+	})
+
+	t.Run("batch is skipped on success condition", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution(
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{{Test: "api"}}},
+			testkube.TestSuiteBatchStep{Condition: "onSuccess", Execute: []testkube.TestSuiteStep{{Test: "ui"}, {Delay: "1s"}}},
+		)
+
+		assert.False(t, s.applyStepConditions(execution, 1, true, false))
+		assert.True(t, execution.ExecuteStepResults[1].IsSkipped())
+		assert.True(t, execution.ExecuteStepResults[1].Execute[0].IsSkipped())
+		assert.True(t, execution.ExecuteStepResults[1].Execute[1].IsSkipped())
+	})
+
+	t.Run("steps are skipped by expression", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution(
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{{Test: "api"}}},
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{
+				{Test: "api-logs", Condition: `steps["api"] == "failed"`},
+				{Test: "cleanup-production", Condition: `variables["env"] == "production"`},
+			}},
+		)
+
+		assert.True(t, s.applyStepConditions(execution, 1, true, false))
+		assert.False(t, execution.ExecuteStepResults[1].Execute[0].IsSkipped())
+		assert.True(t, execution.ExecuteStepResults[1].Execute[1].IsSkipped())
+	})
+
+	t.Run("step fails on invalid expression", func(t *testing.T) {
+		t.Parallel()
+
+		execution := newExecution(
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{{Test: "api"}}},
+			testkube.TestSuiteBatchStep{Execute: []testkube.TestSuiteStep{{Test: "ui", Condition: `steps["unknown"] == "failed"`}}},
+		)
+
+		assert.True(t, s.applyStepConditions(execution, 1, true, false))
+		assert.True(t, execution.ExecuteStepResults[1].Execute[0].IsFailed())
+	})
+}
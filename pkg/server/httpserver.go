@@ -46,6 +46,16 @@ func (s *HTTPServer) Init() {
 		return c.Next()
 	})
 
+	// streamed request bodies are not checked against the body limit by fiber
+	if s.Config.Http.StreamRequestBody && s.Config.Http.BodyLimit > 0 {
+		s.Mux.Use(func(c *fiber.Ctx) error {
+			if c.Request().Header.ContentLength() > s.Config.Http.BodyLimit {
+				return fiber.ErrRequestEntityTooLarge
+			}
+			return c.Next()
+		})
+	}
+
 	s.Mux.Use(pprof.New())
 
 	// server generic endpoints
@@ -0,0 +1,74 @@
+package stepcondition
+
+import (
+	"github.com/google/cel-go/cel"
+
+	"github.com/kubeshop/testkube/pkg/celprogram"
+)
+
+const (
+	// Always runs the step regardless of earlier results, also after a batch stopped on failure
+	Always = "always"
+	// OnSuccess runs the step only when no earlier step failed
+	OnSuccess = "onSuccess"
+	// OnFailure runs the step only when at least one earlier step failed
+	OnFailure = "onFailure"
+)
+
+// compiler compiles step conditions with variables describing earlier steps
+var compiler = celprogram.NewCompiler("condition",
+	cel.Variable("failed", cel.BoolType),
+	cel.Variable("steps", cel.MapType(cel.StringType, cel.StringType)),
+	cel.Variable("variables", cel.MapType(cel.StringType, cel.StringType)),
+)
+
+// State is a state of earlier steps of the test suite execution available for conditions
+type State struct {
+	// Failed is true when any earlier step failed
+	Failed bool
+	// Steps contains statuses of earlier steps by test name
+	Steps map[string]string
+	// Variables contains values of test suite execution variables
+	Variables map[string]string
+}
+
+// Validate checks if condition is a known keyword or a valid expression
+func Validate(condition string) error {
+	if condition == "" || condition == Always || condition == OnSuccess || condition == OnFailure {
+		return nil
+	}
+
+	_, err := compiler.Compile(condition)
+	return err
+}
+
+// Evaluate checks if step with condition should run for the given state,
+// stopped is true when earlier batch stopped the test suite on failure
+func Evaluate(condition string, state State, stopped bool) (bool, error) {
+	switch condition {
+	case "":
+		return !stopped, nil
+	case Always:
+		return true, nil
+	case OnSuccess:
+		return !state.Failed, nil
+	case OnFailure:
+		return state.Failed, nil
+	}
+
+	steps := state.Steps
+	if steps == nil {
+		steps = map[string]string{}
+	}
+
+	variables := state.Variables
+	if variables == nil {
+		variables = map[string]string{}
+	}
+
+	return compiler.Evaluate(condition, map[string]interface{}{
+		"failed":    state.Failed,
+		"steps":     steps,
+		"variables": variables,
+	})
+}
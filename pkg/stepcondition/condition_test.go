@@ -0,0 +1,71 @@
+package stepcondition
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Validate(""))
+	assert.NoError(t, Validate(Always))
+	assert.NoError(t, Validate(OnFailure))
+	assert.NoError(t, Validate(`steps["api"] == "failed" && variables["env"] == "staging"`))
+	assert.Error(t, Validate(`steps["api"] ==`))
+	assert.Error(t, Validate(`variables["env"]`))
+	assert.Error(t, Validate(`unknown == 1`))
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	failed := State{
+		Failed:    true,
+		Steps:     map[string]string{"api": "failed", "ui": "passed"},
+		Variables: map[string]string{"env": "staging"},
+	}
+	passed := State{
+		Steps: map[string]string{"api": "passed"},
+	}
+
+	tests := []struct {
+		name      string
+		condition string
+		state     State
+		stopped   bool
+		expected  bool
+	}{
+		{name: "no condition", condition: "", state: failed, expected: true},
+		{name: "no condition when stopped", condition: "", state: failed, stopped: true, expected: false},
+		{name: "always when stopped", condition: Always, state: failed, stopped: true, expected: true},
+		{name: "on success after failure", condition: OnSuccess, state: failed, expected: false},
+		{name: "on success", condition: OnSuccess, state: passed, expected: true},
+		{name: "on failure after failure", condition: OnFailure, state: failed, stopped: true, expected: true},
+		{name: "on failure", condition: OnFailure, state: passed, expected: false},
+		{name: "expression over steps", condition: `steps["api"] == "failed"`, state: failed, expected: true},
+		{name: "expression over variables", condition: `variables["env"] == "production"`, state: failed, expected: false},
+		{name: "expression with missing step", condition: `"api" in steps && failed`, state: State{}, expected: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := Evaluate(tt.condition, tt.state, tt.stopped)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("missing key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Evaluate(`steps["ui"] == "passed"`, passed, false)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,381 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"go.uber.org/zap"
+
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/archive"
+	"github.com/kubeshop/testkube/pkg/log"
+	"github.com/kubeshop/testkube/pkg/storage"
+)
+
+var _ storage.Client = (*Client)(nil)
+
+// ErrArtifactsNotFound contains error for not existing artifacts
+var ErrArtifactsNotFound = errors.New("Execution doesn't have any artifacts associated with it")
+
+// ErrNotSupported is returned for operations which need a MinIO compatible storage
+var ErrNotSupported = errors.New("operation is not supported by local filesystem storage")
+
+// Client for managing artifacts stored on the local filesystem, buckets are directories in the base directory
+type Client struct {
+	dir    string
+	bucket string
+	Log    *zap.SugaredLogger
+}
+
+// NewClient returns new local filesystem client storing files of the default bucket in dir/bucket
+func NewClient(dir, bucket string) *Client {
+	return &Client{
+		dir:    dir,
+		bucket: bucket,
+		Log:    log.DefaultLogger,
+	}
+}
+
+// Dir returns base directory of the storage
+func (c *Client) Dir() string {
+	return c.dir
+}
+
+// IsConnectionPossible checks if the base directory is accessible
+func (c *Client) IsConnectionPossible(ctx context.Context) (bool, error) {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateBucket creates new bucket directory
+func (c *Client) CreateBucket(ctx context.Context, bucket string) error {
+	path, err := c.path(bucket, "", "")
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(path); err == nil {
+		return fmt.Errorf("bucket %q already exists", bucket)
+	}
+
+	return os.MkdirAll(path, 0755)
+}
+
+// DeleteBucket deletes bucket directory, not empty bucket is deleted only when force is true
+func (c *Client) DeleteBucket(ctx context.Context, bucket string, force bool) error {
+	path, err := c.path(bucket, "", "")
+	if err != nil {
+		return err
+	}
+
+	if force {
+		return os.RemoveAll(path)
+	}
+
+	return os.Remove(path)
+}
+
+// ListBuckets lists available buckets
+func (c *Client) ListBuckets(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var buckets []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			buckets = append(buckets, entry.Name())
+		}
+	}
+
+	return buckets, nil
+}
+
+// ListFiles lists available files in the bucket from the config
+func (c *Client) ListFiles(ctx context.Context, bucketFolder string) ([]testkube.Artifact, error) {
+	c.Log.Infow("listing files", "bucket", c.bucket, "bucketFolder", bucketFolder)
+	files, err := c.walk(c.bucket, bucketFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	var toReturn []testkube.Artifact
+	for _, file := range files {
+		toReturn = append(toReturn, testkube.Artifact{Name: file.name, Size: int32(file.info.Size())})
+	}
+
+	return toReturn, nil
+}
+
+// SaveFile saves file defined by local filePath to the bucket from the config
+func (c *Client) SaveFile(ctx context.Context, bucketFolder, filePath string) error {
+	c.Log.Debugw("saving file", "bucket", c.bucket, "bucketFolder", bucketFolder, "filePath", filePath)
+	object, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("local filesystem saving file (%s) open error: %w", filePath, err)
+	}
+	defer object.Close()
+
+	return c.UploadFile(ctx, bucketFolder, filepath.Base(filePath), object, -1)
+}
+
+// DownloadFile is not supported as it's bound to the MinIO object type, use ArtifactClient instead
+func (c *Client) DownloadFile(ctx context.Context, bucketFolder, file string) (*minio.Object, error) {
+	return nil, ErrNotSupported
+}
+
+// DownloadArchive downloads archive from the bucket from the config
+func (c *Client) DownloadArchive(ctx context.Context, bucketFolder string, masks []string) (io.Reader, error) {
+	return c.DownloadArchiveFromBucket(ctx, c.bucket, bucketFolder, masks)
+}
+
+// UploadFile uploads file to the bucket from the config
+func (c *Client) UploadFile(ctx context.Context, bucketFolder, filePath string, reader io.Reader, objectSize int64) error {
+	return c.UploadFileToBucket(ctx, c.bucket, bucketFolder, filePath, reader, objectSize)
+}
+
+// PlaceFiles saves the content of the bucket folders to the filesystem
+func (c *Client) PlaceFiles(ctx context.Context, bucketFolders []string, prefix string) error {
+	for _, folder := range bucketFolders {
+		files, err := c.walk(c.bucket, folder)
+		if err != nil {
+			return fmt.Errorf("could not list files in bucket %s folder %s: %w", c.bucket, folder, err)
+		}
+
+		for _, file := range files {
+			if err = copyFile(file.path, filepath.Join(prefix, file.name)); err != nil {
+				return fmt.Errorf("could not persist file %s from bucket %s, folder %s: %w", file.name, c.bucket, folder, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeleteFile deletes file from the bucket from the config
+func (c *Client) DeleteFile(ctx context.Context, bucketFolder, file string) error {
+	return c.DeleteFileFromBucket(ctx, c.bucket, bucketFolder, file)
+}
+
+// DownloadFileFromBucket downloads file from given bucket
+func (c *Client) DownloadFileFromBucket(ctx context.Context, bucket, bucketFolder, file string) (io.Reader, error) {
+	c.Log.Debugw("downloading file", "bucket", bucket, "bucketFolder", bucketFolder, "file", file)
+	path, err := c.path(bucket, bucketFolder, file)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrArtifactsNotFound
+	}
+
+	return object, err
+}
+
+// DownloadArchiveFromBucket downloads tarball with files matching masks from given bucket
+func (c *Client) DownloadArchiveFromBucket(ctx context.Context, bucket, bucketFolder string, masks []string) (io.Reader, error) {
+	c.Log.Debugw("downloading archive", "bucket", bucket, "bucketFolder", bucketFolder, "masks", masks)
+	var regexps []*regexp.Regexp
+	for _, mask := range masks {
+		for _, value := range strings.Split(mask, ",") {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("local filesystem DownloadArchive regexp error: %w", err)
+			}
+
+			regexps = append(regexps, re)
+		}
+	}
+
+	files, err := c.walk(bucket, bucketFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	var archiveFiles []*archive.File
+	for _, file := range files {
+		// keep the same object keys as MinIO archives do
+		key := file.name
+		if folder := strings.Trim(bucketFolder, "/"); folder != "" {
+			key = folder + "/" + key
+		}
+
+		found := len(regexps) == 0
+		for i := range regexps {
+			if found = regexps[i].MatchString(key); found {
+				break
+			}
+		}
+
+		if !found {
+			continue
+		}
+
+		data, err := os.ReadFile(file.path)
+		if err != nil {
+			return nil, fmt.Errorf("local filesystem DownloadArchive read error: %w", err)
+		}
+
+		archiveFiles = append(archiveFiles, &archive.File{
+			Name:    key,
+			Size:    file.info.Size(),
+			Mode:    int64(os.ModePerm),
+			ModTime: file.info.ModTime(),
+			Data:    bytes.NewBuffer(data),
+		})
+	}
+
+	data := &bytes.Buffer{}
+	if err = archive.NewTarballService().Create(data, archiveFiles); err != nil {
+		return nil, fmt.Errorf("local filesystem DownloadArchive CreateArchive error: %w", err)
+	}
+
+	return data, nil
+}
+
+// UploadFileToBucket uploads file to given bucket
+func (c *Client) UploadFileToBucket(ctx context.Context, bucket, bucketFolder, filePath string, reader io.Reader, objectSize int64) error {
+	c.Log.Debugw("uploading file", "bucket", bucket, "bucketFolder", bucketFolder, "filePath", filePath, "size", objectSize)
+	path, err := c.path(bucket, bucketFolder, filePath)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("local filesystem UploadFile mkdir error: %w", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("local filesystem UploadFile create error: %w", err)
+	}
+	defer file.Close()
+
+	if _, err = io.Copy(file, reader); err != nil {
+		return fmt.Errorf("local filesystem UploadFile write error: %w", err)
+	}
+
+	return nil
+}
+
+// GetValidBucketName returns a bucket name which is also a valid directory name
+func (c *Client) GetValidBucketName(parentType string, parentName string) string {
+	bucketName := fmt.Sprintf("%s-%s", parentType, parentName)
+	if len(bucketName) <= 63 {
+		return bucketName
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(bucketName))
+
+	return fmt.Sprintf("%s-%d", bucketName[:52], h.Sum32())
+}
+
+// DeleteFileFromBucket deletes file from given bucket
+func (c *Client) DeleteFileFromBucket(ctx context.Context, bucket, bucketFolder, file string) error {
+	path, err := c.path(bucket, bucketFolder, file)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrArtifactsNotFound
+	}
+
+	return err
+}
+
+type localFile struct {
+	name string
+	path string
+	info fs.FileInfo
+}
+
+// walk returns all files stored in the bucket folder with names relative to the folder
+func (c *Client) walk(bucket, bucketFolder string) ([]localFile, error) {
+	root, err := c.path(bucket, bucketFolder, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = os.Stat(root); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			c.Log.Debugw("bucket folder doesn't exist", "bucket", bucket, "bucketFolder", bucketFolder)
+			return nil, ErrArtifactsNotFound
+		}
+		return nil, err
+	}
+
+	var files []localFile
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, localFile{name: filepath.ToSlash(name), path: path, info: info})
+		return nil
+	})
+
+	return files, err
+}
+
+// path joins bucket, folder and file into a path inside the base directory, escaping the base directory is not allowed
+func (c *Client) path(bucket, bucketFolder, file string) (string, error) {
+	base := filepath.Clean(c.dir)
+	path := filepath.Join(base, bucket, bucketFolder, file)
+	if path != base && !strings.HasPrefix(path, base+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path %q", filepath.Join(bucket, bucketFolder, file))
+	}
+
+	return path, nil
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
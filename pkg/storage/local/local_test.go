@@ -0,0 +1,74 @@
+package local
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/testkube/pkg/archive"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	client := NewClient(t.TempDir(), "testkube-artifacts")
+	artifacts := NewLocalArtifactClient(client)
+
+	require.NoError(t, client.UploadFile(ctx, "execution-1", "reports/report.xml", strings.NewReader("<xml/>"), 6))
+	require.NoError(t, client.UploadFile(ctx, "execution-1", "log.txt", strings.NewReader("log"), 3))
+
+	t.Run("lists files in the bucket folder", func(t *testing.T) {
+		files, err := artifacts.ListFiles(ctx, "execution-1", "", "")
+		require.NoError(t, err)
+		assert.Len(t, files, 2)
+		assert.Equal(t, "log.txt", files[0].Name)
+		assert.Equal(t, int32(3), files[0].Size)
+		assert.Equal(t, "reports/report.xml", files[1].Name)
+	})
+
+	t.Run("returns not found for missing folder", func(t *testing.T) {
+		_, err := artifacts.ListFiles(ctx, "execution-2", "", "")
+		assert.ErrorIs(t, err, ErrArtifactsNotFound)
+	})
+
+	t.Run("downloads file", func(t *testing.T) {
+		reader, err := artifacts.DownloadFile(ctx, "reports/report.xml", "execution-1", "", "")
+		require.NoError(t, err)
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "<xml/>", string(data))
+	})
+
+	t.Run("downloads archive of files matching masks", func(t *testing.T) {
+		reader, err := artifacts.DownloadArchive(ctx, "execution-1", []string{".*\\.xml"})
+		require.NoError(t, err)
+
+		files, err := archive.NewTarballService().Extract(reader)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "execution-1/reports/report.xml", files[0].Name)
+	})
+
+	t.Run("places files", func(t *testing.T) {
+		prefix := t.TempDir()
+		require.NoError(t, artifacts.PlaceFiles(ctx, []string{"execution-1"}, prefix))
+		data, err := os.ReadFile(filepath.Join(prefix, "reports", "report.xml"))
+		require.NoError(t, err)
+		assert.Equal(t, "<xml/>", string(data))
+	})
+
+	t.Run("rejects paths outside of the base directory", func(t *testing.T) {
+		_, err := client.DownloadFileFromBucket(ctx, "testkube-artifacts", "..", "../../etc/passwd")
+		assert.Error(t, err)
+	})
+
+	t.Run("deletes file", func(t *testing.T) {
+		require.NoError(t, client.DeleteFile(ctx, "execution-1", "log.txt"))
+		assert.ErrorIs(t, client.DeleteFile(ctx, "execution-1", "log.txt"), ErrArtifactsNotFound)
+	})
+}
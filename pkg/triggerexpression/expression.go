@@ -0,0 +1,92 @@
+package triggerexpression
+
+import (
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/testkube/pkg/celprogram"
+)
+
+// compiler compiles test trigger expressions with variables describing the resource event
+var compiler = celprogram.NewCompiler("expression",
+	cel.Variable("resource", cel.StringType),
+	cel.Variable("event", cel.StringType),
+	cel.Variable("labels", cel.MapType(cel.StringType, cel.StringType)),
+	cel.Variable("causes", cel.ListType(cel.StringType)),
+	cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+	cel.Variable("oldObject", cel.MapType(cel.StringType, cel.DynType)),
+)
+
+// Input is a resource event matched by test trigger expression
+type Input struct {
+	// Resource is a watched resource type, or group/version/kind for custom resources
+	Resource string
+	// Event is created, modified or deleted
+	Event string
+	// Labels are resource labels
+	Labels map[string]string
+	// Causes are finer-grained causes of the event, like deployment-image-update
+	Causes []string
+	// Object is a resource after the event
+	Object interface{}
+	// OldObject is a resource before modification, nil for created and deleted events
+	OldObject interface{}
+}
+
+// Validate checks that expression is empty or compiles to bool
+func Validate(expression string) error {
+	if expression == "" {
+		return nil
+	}
+
+	_, err := compiler.Compile(expression)
+	return err
+}
+
+// Evaluate checks if expression matches the input, empty expression matches all inputs
+func Evaluate(expression string, input Input) (bool, error) {
+	if expression == "" {
+		return true, nil
+	}
+
+	object, err := toUnstructuredMap(input.Object)
+	if err != nil {
+		return false, err
+	}
+
+	oldObject, err := toUnstructuredMap(input.OldObject)
+	if err != nil {
+		return false, err
+	}
+
+	labels := input.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	causes := input.Causes
+	if causes == nil {
+		causes = []string{}
+	}
+
+	return compiler.Evaluate(expression, map[string]interface{}{
+		"resource":  input.Resource,
+		"event":     input.Event,
+		"labels":    labels,
+		"causes":    causes,
+		"object":    object,
+		"oldObject": oldObject,
+	})
+}
+
+func toUnstructuredMap(object interface{}) (map[string]interface{}, error) {
+	switch o := object.(type) {
+	case nil:
+		return map[string]interface{}{}, nil
+	case *unstructured.Unstructured:
+		return o.Object, nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+}
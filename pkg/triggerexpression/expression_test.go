@@ -0,0 +1,99 @@
+package triggerexpression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Validate(""))
+	assert.NoError(t, Validate(`"deployment-image-update" in causes && labels["team"] == "payments"`))
+	assert.NoError(t, Validate(`object.data["feature.flags"] != oldObject.data["feature.flags"]`))
+	assert.Error(t, Validate(`labels["team"] ==`))
+	assert.Error(t, Validate(`labels["team"]`))
+	assert.Error(t, Validate(`unknown == 1`))
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	deployment := func(image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "payments", Labels: map[string]string{"team": "payments"}},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "api", Image: image}},
+			}}},
+		}
+	}
+	configMap := func(flags string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "features", Namespace: "payments"},
+			Data:       map[string]string{"feature.flags": flags, "other": "value"},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		input      Input
+		expected   bool
+	}{
+		{
+			name:       "no expression",
+			expression: "",
+			input:      Input{},
+			expected:   true,
+		},
+		{
+			name:       "image tag changed for team",
+			expression: `"deployment-image-update" in causes && labels["team"] == "payments"`,
+			input: Input{Resource: "deployment", Event: "modified", Labels: map[string]string{"team": "payments"},
+				Causes: []string{"deployment-image-update"}, Object: deployment("api:2"), OldObject: deployment("api:1")},
+			expected: true,
+		},
+		{
+			name:       "image compared between objects",
+			expression: `object.spec.template.spec.containers[0].image != oldObject.spec.template.spec.containers[0].image`,
+			input:      Input{Event: "modified", Object: deployment("api:1"), OldObject: deployment("api:1")},
+			expected:   false,
+		},
+		{
+			name:       "config map key changed",
+			expression: `object.data["feature.flags"] != oldObject.data["feature.flags"]`,
+			input:      Input{Resource: "configmap", Event: "modified", Object: configMap("b=true"), OldObject: configMap("a=true")},
+			expected:   true,
+		},
+		{
+			name:       "old object is empty for created event",
+			expression: `event == "created" && !has(oldObject.data) && object.metadata.namespace == "payments"`,
+			input:      Input{Event: "created", Object: configMap("a=true")},
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, err := Evaluate(tt.expression, tt.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	t.Run("missing field", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Evaluate(`oldObject.data["feature.flags"] == "a"`, Input{Object: configMap("a=true")})
+
+		assert.Error(t, err)
+	})
+}
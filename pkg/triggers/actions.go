@@ -0,0 +1,176 @@
+package triggers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+)
+
+// WebhookPayload is a body of the request sent by the webhook test trigger action
+type WebhookPayload struct {
+	// Trigger is a test trigger name
+	Trigger string `json:"trigger"`
+	// Namespace is a test trigger namespace
+	Namespace string `json:"namespace"`
+	// Event is an event which fired the test trigger
+	Event string `json:"event"`
+	// Variables describe the event, the same way as for executions started by the test trigger
+	Variables map[string]string `json:"variables"`
+	// Resource is the matched resource
+	Resource any `json:"resource"`
+}
+
+// abort aborts running and queued executions of tests or test suites selected by the test trigger
+func (s *Service) abort(ctx context.Context, t *testtriggersv1.TestTrigger) error {
+	switch t.Spec.Execution {
+	case ExecutionTest:
+		tests, err := s.getTests(t)
+		if err != nil {
+			return err
+		}
+
+		statuses := string(testkube.RUNNING_ExecutionStatus) + "," + string(testkube.QUEUED_ExecutionStatus)
+		for _, test := range tests {
+			executions, err := s.resultRepository.GetExecutions(ctx, result.NewExecutionsFilter().WithTestName(test.Name).WithStatus(statuses))
+			if err != nil {
+				return err
+			}
+
+			for i := range executions {
+				if executions[i].IsQueued() {
+					aborted, err := s.abortQueuedTestExecution(ctx, &executions[i])
+					if err != nil {
+						s.logger.Errorf("trigger service: executor component: error aborting queued test execution %s: %v", executions[i].Id, err)
+						continue
+					}
+
+					if aborted {
+						s.logger.Infof("trigger service: executor component: queued test execution %s is aborted by trigger %s/%s", executions[i].Id, t.Namespace, t.Name)
+						continue
+					}
+				}
+
+				res, err := s.testExecutor.Abort(ctx, &executions[i])
+				if err != nil {
+					s.logger.Errorf("trigger service: executor component: error aborting test execution %s: %v", executions[i].Id, err)
+					continue
+				}
+				s.metrics.IncAbortTest(executions[i].TestType, res.IsFailed())
+
+				s.logger.Infof("trigger service: executor component: test execution %s is aborted by trigger %s/%s", executions[i].Id, t.Namespace, t.Name)
+			}
+		}
+	case ExecutionTestSuite:
+		testSuites, err := s.getTestSuites(t)
+		if err != nil {
+			return err
+		}
+
+		statuses := string(testkube.RUNNING_TestSuiteExecutionStatus) + "," + string(testkube.QUEUED_TestSuiteExecutionStatus)
+		for _, testSuite := range testSuites {
+			executions, err := s.testResultRepository.GetExecutions(ctx, testresult.NewExecutionsFilter().WithName(testSuite.Name).WithStatus(statuses))
+			if err != nil {
+				return err
+			}
+
+			for i := range executions {
+				executions[i].Status = testkube.TestSuiteExecutionStatusAborting
+				if err = s.eventsBus.PublishTopic(bus.InternalPublishTopic, testkube.NewEventEndTestSuiteAborted(&executions[i])); err != nil {
+					s.logger.Errorf("trigger service: executor component: error aborting testsuite execution %s: %v", executions[i].Id, err)
+					continue
+				}
+
+				s.logger.Infof("trigger service: executor component: testsuite execution %s is aborted by trigger %s/%s", executions[i].Id, t.Namespace, t.Name)
+			}
+		}
+	default:
+		return errors.Errorf("invalid execution: %s", t.Spec.Execution)
+	}
+
+	return nil
+}
+
+// abortQueuedTestExecution marks the queued test execution as aborted, so it's never admitted from the queue,
+// false is returned when the execution was admitted in the meantime and has to be aborted in the executor
+func (s *Service) abortQueuedTestExecution(ctx context.Context, execution *testkube.Execution) (bool, error) {
+	aborted, err := s.resultRepository.AbortQueuedExecution(ctx, execution.Id)
+	if err != nil || !aborted {
+		return false, err
+	}
+
+	if execution.ExecutionResult == nil {
+		execution.ExecutionResult = &testkube.ExecutionResult{}
+	}
+	execution.ExecutionResult.Abort()
+	s.metrics.IncAbortTest(execution.TestType, false)
+
+	event := testkube.NewEventEndTestAborted(execution)
+	if err = s.eventsBus.PublishTopic(event.Topic(), event); err != nil {
+		s.logger.Errorf("trigger service: executor component: error publishing abort of test execution %s: %v", execution.Id, err)
+	}
+
+	return true, nil
+}
+
+// notify emits trigger notification event to the event listeners, without running anything
+func (s *Service) notify(e *watcherEvent, t *testtriggersv1.TestTrigger) error {
+	event := testkube.NewEventTriggerNotification(t.Name, getTriggerVariableValues(t, e))
+	if err := s.eventsBus.PublishTopic(event.Topic(), event); err != nil {
+		return errors.WithMessagef(err, "error publishing notification for trigger %s/%s", t.Namespace, t.Name)
+	}
+
+	s.logger.Infof("trigger service: executor component: published notification for trigger %s/%s", t.Namespace, t.Name)
+	return nil
+}
+
+// callWebhook sends the matched resource to the webhook url of the test trigger
+func (s *Service) callWebhook(ctx context.Context, e *watcherEvent, t *testtriggersv1.TestTrigger) error {
+	url := testtriggersmapper.GetWebhookURL(t)
+	if url == "" {
+		return errors.Errorf("missing webhook url for trigger %s/%s", t.Namespace, t.Name)
+	}
+
+	body, err := json.Marshal(WebhookPayload{
+		Trigger:   t.Name,
+		Namespace: t.Namespace,
+		Event:     string(e.eventType),
+		Variables: getTriggerVariableValues(t, e),
+		Resource:  e.object,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "error marshaling webhook payload")
+	}
+
+	// webhook is called by the matcher, so a slow endpoint can't hold back other test triggers
+	ctx, cancel := context.WithTimeout(ctx, defaultWebhookTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.WithMessagef(err, "error creating webhook request for trigger %s/%s", t.Namespace, t.Name)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(request)
+	if err != nil {
+		return errors.WithMessagef(err, "error calling webhook for trigger %s/%s", t.Namespace, t.Name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errors.Errorf("webhook for trigger %s/%s responded with status %d", t.Namespace, t.Name, resp.StatusCode)
+	}
+
+	s.logger.Infof("trigger service: executor component: called webhook for trigger %s/%s", t.Namespace, t.Name)
+	return nil
+}
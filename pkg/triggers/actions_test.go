@@ -0,0 +1,190 @@
+package triggers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
+	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	testsclientv3 "github.com/kubeshop/testkube-operator/pkg/client/tests/v3"
+	testsuitesclientv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	"github.com/kubeshop/testkube/internal/app/api/metrics"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	"github.com/kubeshop/testkube/pkg/event/bus"
+	"github.com/kubeshop/testkube/pkg/log"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+	"github.com/kubeshop/testkube/pkg/repository/result"
+	"github.com/kubeshop/testkube/pkg/repository/testresult"
+)
+
+func TestService_abort(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTestSuitesClient := testsuitesclientv3.NewMockInterface(mockCtrl)
+	mockTestResultRepository := testresult.NewMockRepository(mockCtrl)
+	mockBus := bus.NewEventBusMock()
+
+	mockTestSuitesClient.EXPECT().Get("some-testsuite").Return(&testsuitesv3.TestSuite{ObjectMeta: metav1.ObjectMeta{Name: "some-testsuite"}}, nil)
+	mockTestResultRepository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filter testresult.Filter) ([]testkube.TestSuiteExecution, error) {
+			assert.Equal(t, "some-testsuite", filter.Name())
+			assert.Equal(t, testkube.TestSuiteExecutionStatuses{testkube.RUNNING_TestSuiteExecutionStatus, testkube.QUEUED_TestSuiteExecutionStatus}, filter.Statuses())
+			return []testkube.TestSuiteExecution{{Id: "execution-1", Name: "some-testsuite", Status: testkube.TestSuiteExecutionStatusRunning}}, nil
+		})
+
+	aborted := make(chan testkube.Event, 1)
+	assert.NoError(t, mockBus.SubscribeTopic(bus.InternalSubscribeTopic, "test", func(event testkube.Event) error {
+		aborted <- event
+		return nil
+	}))
+
+	s := &Service{
+		testSuitesClient:     mockTestSuitesClient,
+		testResultRepository: mockTestResultRepository,
+		eventsBus:            mockBus,
+		logger:               log.DefaultLogger,
+	}
+
+	testTrigger := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-trigger-1"},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Execution:    ExecutionTestSuite,
+			TestSelector: testtriggersv1.TestTriggerSelector{Name: "some-testsuite"},
+		},
+	}
+	assert.NoError(t, s.abort(context.Background(), testTrigger))
+
+	select {
+	case event := <-aborted:
+		assert.Equal(t, testkube.END_TESTSUITE_ABORTED_EventType, event.Type())
+		assert.Equal(t, "execution-1", event.TestSuiteExecution.Id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("testsuite execution was not aborted")
+	}
+}
+
+func TestService_abort_queued(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockTestsClient := testsclientv3.NewMockInterface(mockCtrl)
+	mockResultRepository := result.NewMockRepository(mockCtrl)
+	mockBus := bus.NewEventBusMock()
+
+	mockTestsClient.EXPECT().Get("some-test").Return(&testsv3.Test{ObjectMeta: metav1.ObjectMeta{Name: "some-test"}}, nil)
+	mockResultRepository.EXPECT().GetExecutions(gomock.Any(), gomock.Any()).Return([]testkube.Execution{{
+		Id:              "execution-1",
+		TestName:        "some-test",
+		ExecutionResult: &testkube.ExecutionResult{Status: testkube.ExecutionStatusQueued},
+	}}, nil)
+	mockResultRepository.EXPECT().AbortQueuedExecution(gomock.Any(), "execution-1").Return(true, nil)
+
+	aborted := make(chan testkube.Event, 1)
+	assert.NoError(t, mockBus.SubscribeTopic("events.>", "test", func(event testkube.Event) error {
+		aborted <- event
+		return nil
+	}))
+
+	s := &Service{
+		testsClient:      mockTestsClient,
+		resultRepository: mockResultRepository,
+		eventsBus:        mockBus,
+		metrics:          metrics.NewMetrics(),
+		logger:           log.DefaultLogger,
+	}
+
+	testTrigger := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-trigger-1"},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Execution:    ExecutionTest,
+			TestSelector: testtriggersv1.TestTriggerSelector{Name: "some-test"},
+		},
+	}
+	assert.NoError(t, s.abort(context.Background(), testTrigger))
+
+	select {
+	case event := <-aborted:
+		assert.Equal(t, testkube.END_TEST_ABORTED_EventType, event.Type())
+		assert.Equal(t, "execution-1", event.TestExecution.Id)
+		assert.Equal(t, testkube.ExecutionStatusAborted, event.TestExecution.ExecutionResult.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("queued test execution was not aborted")
+	}
+}
+
+func TestService_notify(t *testing.T) {
+	t.Parallel()
+
+	mockBus := bus.NewEventBusMock()
+	notified := make(chan testkube.Event, 1)
+	assert.NoError(t, mockBus.SubscribeTopic("events.>", "test", func(event testkube.Event) error {
+		notified <- event
+		return nil
+	}))
+
+	s := &Service{eventsBus: mockBus, logger: log.DefaultLogger}
+
+	testTrigger := &testtriggersv1.TestTrigger{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-trigger-1"}}
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "features"}}
+	assert.NoError(t, s.notify(newWatcherEvent("modified", configMap, "configmap"), testTrigger))
+
+	select {
+	case event := <-notified:
+		assert.Equal(t, testkube.TRIGGER_NOTIFICATION_EventType, event.Type())
+		assert.Equal(t, "test-trigger-1", event.ResourceId)
+		assert.Equal(t, "events.trigger.test-trigger-1", event.Topic())
+		assert.Equal(t, "features", event.TriggerVariables[VariableTriggerResourceName])
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification was not published")
+	}
+}
+
+func TestService_callWebhook(t *testing.T) {
+	t.Parallel()
+
+	var payload WebhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	s := &Service{httpClient: srv.Client(), logger: log.DefaultLogger}
+
+	testTrigger := &testtriggersv1.TestTrigger{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-trigger-1"}}
+	testtriggersmapper.SetAction(testTrigger, testkube.WEBHOOK_TestTriggerActions)
+	testtriggersmapper.SetWebhookURL(testTrigger, srv.URL)
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "features"}, Data: map[string]string{"a": "b"}}
+	assert.NoError(t, s.callWebhook(context.Background(), newWatcherEvent("modified", configMap, "configmap"), testTrigger))
+
+	assert.Equal(t, "test-trigger-1", payload.Trigger)
+	assert.Equal(t, "testkube", payload.Namespace)
+	assert.Equal(t, "modified", payload.Event)
+	assert.Equal(t, "features", payload.Variables[VariableTriggerResourceName])
+	assert.Equal(t, map[string]any{"a": "b"}, payload.Resource.(map[string]any)["data"])
+
+	testtriggersmapper.SetWebhookURL(testTrigger, srv.URL+"/missing")
+	assert.Error(t, s.callWebhook(context.Background(), newWatcherEvent("modified", configMap, "configmap"), testTrigger))
+}
@@ -0,0 +1,220 @@
+package triggers
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+)
+
+// getTriggerResource returns resource watched by test trigger, custom resources are identified by group/version/kind
+func getTriggerResource(t *testtriggersv1.TestTrigger) (testtrigger.ResourceType, error) {
+	kind, err := testtriggersmapper.GetResourceKind(t)
+	if err != nil {
+		return "", err
+	}
+
+	if kind != nil {
+		return testtrigger.ResourceType(kind.String()), nil
+	}
+
+	return testtrigger.ResourceType(t.Spec.Resource), nil
+}
+
+// watchResourceKind starts dynamic informers for custom resource watched by test trigger, unless they are already running,
+// custom resource previously watched by the test trigger is released
+func (s *Service) watchResourceKind(ctx context.Context, t *testtriggersv1.TestTrigger) {
+	// test triggers with invalid resource kind are skipped when they're added
+	kind, err := testtriggersmapper.GetResourceKind(t)
+	if err != nil || kind == nil {
+		s.unwatchResourceKind(t)
+		return
+	}
+
+	if s.dynamicClient == nil || s.restMapper == nil {
+		s.logger.Errorf(
+			"trigger service: watcher component: cannot watch %s for testtrigger %s/%s: dynamic client is not configured",
+			kind, t.Namespace, t.Name,
+		)
+		return
+	}
+
+	informers := s.informers
+	if informers == nil || informers.stop == nil {
+		return
+	}
+
+	groupKind := schema.GroupKind{Group: kind.Group, Kind: kind.Kind}
+	mapping, err := s.restMapper.RESTMapping(groupKind, kind.Version)
+	if err != nil {
+		// custom resource definition could be installed after discovery was cached
+		if resettable, ok := s.restMapper.(meta.ResettableRESTMapper); ok {
+			resettable.Reset()
+			mapping, err = s.restMapper.RESTMapping(groupKind, kind.Version)
+		}
+	}
+	if err != nil {
+		s.logger.Errorf(
+			"trigger service: watcher component: cannot watch %s for testtrigger %s/%s: %v",
+			kind, t.Namespace, t.Name, err,
+		)
+		s.unwatchResourceKind(t)
+		return
+	}
+
+	informers.customResourceMutex.Lock()
+	defer informers.customResourceMutex.Unlock()
+
+	key := newStatusKey(t.Namespace, t.Name)
+	s.releaseResourceKinds(informers, key, mapping.Resource)
+	if watched, ok := informers.customResourceInformers[mapping.Resource]; ok {
+		watched.triggers[key] = struct{}{}
+		return
+	}
+
+	namespaces := s.watcherNamespaces
+	if len(namespaces) == 0 || mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+
+	s.logger.Debugf("trigger service: starting custom resource informers for %s", kind)
+	watched := &customResourceInformer{triggers: map[statusKey]struct{}{key: {}}, done: make(chan struct{})}
+	informers.customResourceInformers[mapping.Resource] = watched
+
+	stop := make(chan struct{})
+	go func() {
+		defer close(stop)
+		select {
+		case <-informers.stop:
+		case <-watched.done:
+		}
+	}()
+
+	resource := testtrigger.ResourceType(kind.String())
+	for _, namespace := range namespaces {
+		informer := dynamicinformer.NewFilteredDynamicInformer(
+			s.dynamicClient, mapping.Resource, namespace, 0, cache.Indexers{}, nil,
+		).Informer()
+		informer.AddEventHandler(s.customResourceEventHandler(ctx, mapping.Resource, resource))
+		watched.informers = append(watched.informers, informer)
+		go informer.Run(stop)
+	}
+}
+
+// unwatchResourceKind releases custom resource watched by test trigger
+func (s *Service) unwatchResourceKind(t *testtriggersv1.TestTrigger) {
+	informers := s.informers
+	if informers == nil {
+		return
+	}
+
+	informers.customResourceMutex.Lock()
+	defer informers.customResourceMutex.Unlock()
+
+	s.releaseResourceKinds(informers, newStatusKey(t.Namespace, t.Name), schema.GroupVersionResource{})
+}
+
+// releaseResourceKinds releases custom resources watched by test trigger except the kept one and stops their
+// dynamic informers when no other test trigger watches them, custom resource mutex has to be held by the caller
+func (s *Service) releaseResourceKinds(informers *k8sInformers, key statusKey, keep schema.GroupVersionResource) {
+	for gvr, watched := range informers.customResourceInformers {
+		if _, ok := watched.triggers[key]; !ok || gvr == keep {
+			continue
+		}
+
+		delete(watched.triggers, key)
+		if len(watched.triggers) == 0 {
+			s.logger.Debugf("trigger service: stopping custom resource informers for %s", gvr)
+			close(watched.done)
+			delete(informers.customResourceInformers, gvr)
+		}
+	}
+}
+
+func (s *Service) customResourceEventHandler(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	resource testtrigger.ResourceType,
+) cache.ResourceEventHandlerFuncs {
+	getConditions := func(object metav1.Object) func() ([]testtriggersv1.TestTriggerCondition, error) {
+		return func() ([]testtriggersv1.TestTriggerCondition, error) {
+			return getCustomResourceConditions(ctx, s.dynamicClient, gvr, object)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			object, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				s.logger.Errorf("failed to process create %s event due to it being an unexpected type, received type %+v", resource, obj)
+				return
+			}
+			if inPast(object.GetCreationTimestamp().Time, s.watchFromDate) {
+				s.logger.Debugf(
+					"trigger service: watcher component: no-op create trigger: %s %s/%s was created in the past",
+					resource, object.GetNamespace(), object.GetName(),
+				)
+				return
+			}
+			s.logger.Debugf("trigger service: watcher component: emiting event: %s %s/%s created", resource, object.GetNamespace(), object.GetName())
+			event := newWatcherEvent(testtrigger.EventCreated, object, resource, withConditionsGetter(getConditions(object)))
+			if err := s.match(ctx, event); err != nil {
+				s.logger.Errorf("event matcher returned an error while matching create %s event: %v", resource, err)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldObject, ok := oldObj.(*unstructured.Unstructured)
+			if !ok {
+				s.logger.Errorf(
+					"failed to process update %s event for old object due to it being an unexpected type, received type %+v",
+					resource, oldObj,
+				)
+				return
+			}
+			newObject, ok := newObj.(*unstructured.Unstructured)
+			if !ok {
+				s.logger.Errorf(
+					"failed to process update %s event for new object due to it being an unexpected type, received type %+v",
+					resource, newObj,
+				)
+				return
+			}
+			if cmp.Equal(oldObject.Object["spec"], newObject.Object["spec"]) &&
+				cmp.Equal(oldObject.Object["status"], newObject.Object["status"]) {
+				s.logger.Debugf("trigger service: watcher component: no-op update trigger: %s specs and statuses are equal", resource)
+				return
+			}
+			s.logger.Debugf(
+				"trigger service: watcher component: emiting event: %s %s/%s updated",
+				resource, newObject.GetNamespace(), newObject.GetName(),
+			)
+			event := newWatcherEvent(testtrigger.EventModified, newObject, resource, withOldObject(oldObject), withConditionsGetter(getConditions(newObject)))
+			if err := s.match(ctx, event); err != nil {
+				s.logger.Errorf("event matcher returned an error while matching update %s event: %v", resource, err)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			object, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				s.logger.Errorf("failed to process delete %s event due to it being an unexpected type, received type %+v", resource, obj)
+				return
+			}
+			s.logger.Debugf("trigger service: watcher component: emiting event: %s %s/%s deleted", resource, object.GetNamespace(), object.GetName())
+			event := newWatcherEvent(testtrigger.EventDeleted, object, resource, withConditionsGetter(getConditions(object)))
+			if err := s.match(ctx, event); err != nil {
+				s.logger.Errorf("event matcher returned an error while matching delete %s event: %v", resource, err)
+			}
+		},
+	}
+}
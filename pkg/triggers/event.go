@@ -7,10 +7,13 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
 	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
+	"github.com/kubeshop/testkube/pkg/mapper/customresources"
 	"github.com/kubeshop/testkube/pkg/mapper/daemonsets"
 	"github.com/kubeshop/testkube/pkg/mapper/deployments"
 	"github.com/kubeshop/testkube/pkg/mapper/pods"
@@ -28,6 +31,7 @@ type watcherEvent struct {
 	namespace        string
 	labels           map[string]string
 	object           metav1.Object
+	oldObject        metav1.Object
 	eventType        testtrigger.EventType
 	causes           []testtrigger.Cause
 	conditionsGetter conditionsGetterFn
@@ -42,6 +46,12 @@ func withCauses(causes []testtrigger.Cause) watcherOpts {
 	}
 }
 
+func withOldObject(oldObject metav1.Object) watcherOpts {
+	return func(w *watcherEvent) {
+		w.oldObject = oldObject
+	}
+}
+
 func withConditionsGetter(conditionsGetter conditionsGetterFn) watcherOpts {
 	return func(w *watcherEvent) {
 		w.conditionsGetter = conditionsGetter
@@ -162,6 +172,20 @@ func getServiceConditions(
 	return services.MapCRDConditionsToAPI(service.Status.Conditions, time.Now()), nil
 }
 
+func getCustomResourceConditions(
+	ctx context.Context,
+	dynamicClient dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	object metav1.Object,
+) ([]testtriggersv1.TestTriggerCondition, error) {
+	customResource, err := dynamicClient.Resource(gvr).Namespace(object.GetNamespace()).Get(ctx, object.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return customresources.MapCRDConditionsToAPI(customResource, time.Now()), nil
+}
+
 func getServiceAdress(ctx context.Context, clientset kubernetes.Interface, object metav1.Object) (string, error) {
 	return fmt.Sprintf("%s.%s.svc.cluster.local", object.GetName(), object.GetNamespace()), nil
 }
@@ -12,6 +12,7 @@ import (
 	testsuitesv3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
 	"github.com/kubeshop/testkube/pkg/scheduler"
 	"github.com/kubeshop/testkube/pkg/workerpool"
 )
@@ -23,9 +24,18 @@ const (
 	ExecutionTestSuite = "testsuite"
 )
 
-type ExecutorF func(context.Context, *testtriggersv1.TestTrigger) error
+type ExecutorF func(context.Context, *watcherEvent, *testtriggersv1.TestTrigger) error
+
+func (s *Service) execute(ctx context.Context, e *watcherEvent, t *testtriggersv1.TestTrigger) error {
+	switch testtriggersmapper.GetAction(t) {
+	case testkube.ABORT_TestTriggerActions:
+		return s.abort(ctx, t)
+	case testkube.NOTIFY_TestTriggerActions:
+		return s.notify(e, t)
+	case testkube.WEBHOOK_TestTriggerActions:
+		return s.callWebhook(ctx, e, t)
+	}
 
-func (s *Service) execute(ctx context.Context, t *testtriggersv1.TestTrigger) error {
 	status := s.getStatusForTrigger(t)
 
 	concurrencyLevel := scheduler.DefaultConcurrencyLevel
@@ -38,6 +48,7 @@ func (s *Service) execute(ctx context.Context, t *testtriggersv1.TestTrigger) er
 		}
 
 		request := testkube.ExecutionRequest{
+			Variables: getTriggerVariables(t, e),
 			RunningContext: &testkube.RunningContext{
 				Type_:   string(testkube.RunningContextTypeTestTrigger),
 				Context: t.Name,
@@ -72,6 +83,7 @@ func (s *Service) execute(ctx context.Context, t *testtriggersv1.TestTrigger) er
 		}
 
 		request := testkube.TestSuiteExecutionRequest{
+			Variables: getTriggerVariables(t, e),
 			RunningContext: &testkube.RunningContext{
 				Type_:   string(testkube.RunningContextTypeTestTrigger),
 				Context: t.Name,
@@ -6,6 +6,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/kubeshop/testkube-operator/api/executor/v1"
@@ -16,6 +18,7 @@ import (
 	testsourcesv1 "github.com/kubeshop/testkube-operator/pkg/client/testsources/v1"
 	testsuiteexecutionsv1 "github.com/kubeshop/testkube-operator/pkg/client/testsuiteexecutions/v1"
 	testsuitesv3 "github.com/kubeshop/testkube-operator/pkg/client/testsuites/v3"
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
 	"github.com/kubeshop/testkube/internal/app/api/metrics"
 	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
 	"github.com/kubeshop/testkube/pkg/configmap"
@@ -98,8 +101,14 @@ func TestExecute(t *testing.T) {
 	mockExecutorsClient.EXPECT().GetByType(mockExecutorTypes).Return(&mockExecutorV1, nil).AnyTimes()
 	mockResultRepository.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
 	mockResultRepository.EXPECT().StartExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
-	mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(&mockExecutionResult, nil)
+	mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, execution *testkube.Execution, options client.ExecuteOptions) (*testkube.ExecutionResult, error) {
+			assert.Equal(t, "test-deployment", execution.Variables[VariableTriggerResourceName].Value)
+			assert.Equal(t, "1.2.3", execution.Variables[VariableTriggerImageTag].Value)
+			return &mockExecutionResult, nil
+		})
 	mockResultRepository.EXPECT().UpdateResult(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	configMapConfig.EXPECT().Get(gomock.Any()).Return(testkube.Config{}, nil).AnyTimes()
 
 	sched := scheduler.NewScheduler(
 		metricsHandle,
@@ -163,6 +172,14 @@ func TestExecute(t *testing.T) {
 	key := newStatusKey(testTrigger.Namespace, testTrigger.Name)
 	assert.Contains(t, s.triggerStatus, key)
 
-	err := s.execute(ctx, &testTrigger)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "test-deployment"},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "registry.example.com/app:1.2.3"}},
+		}}},
+	}
+	e := newWatcherEvent(testtrigger.EventCreated, deployment, testtrigger.ResourceDeployment)
+
+	err := s.execute(ctx, e, &testTrigger)
 	assert.NoError(t, err)
 }
@@ -0,0 +1,55 @@
+package triggers
+
+import (
+	"time"
+)
+
+// maxDedupKeys is a number of the latest dedup keys remembered for each test trigger
+const maxDedupKeys = 100
+
+// Firing keeps the last firing of the test trigger and dedup keys of the latest firings
+type Firing struct {
+	FiredAt   time.Time `bson:"fired_at" json:"firedAt"`
+	DedupKeys []string  `bson:"dedup_keys" json:"dedupKeys"`
+}
+
+// fire records firing at the given time, unless it is within cooldown of the last firing or dedup key already fired
+func (f *Firing) fire(dedupKey string, cooldown time.Duration, now time.Time) bool {
+	if cooldown > 0 && !f.FiredAt.IsZero() && now.Sub(f.FiredAt) < cooldown {
+		return false
+	}
+
+	if dedupKey != "" {
+		for _, key := range f.DedupKeys {
+			if key == dedupKey {
+				return false
+			}
+		}
+
+		f.DedupKeys = append(f.DedupKeys, dedupKey)
+		if len(f.DedupKeys) > maxDedupKeys {
+			f.DedupKeys = f.DedupKeys[len(f.DedupKeys)-maxDedupKeys:]
+		}
+	}
+
+	f.FiredAt = now
+	return true
+}
+
+// release forgets the dedup key and resets cooldown of the firing, it returns false when there's nothing to forget
+func (f *Firing) release(dedupKey string) bool {
+	released := !f.FiredAt.IsZero()
+	f.FiredAt = time.Time{}
+	if dedupKey == "" {
+		return released
+	}
+
+	for i, key := range f.DedupKeys {
+		if key == dedupKey {
+			f.DedupKeys = append(f.DedupKeys[:i:i], f.DedupKeys[i+1:]...)
+			return true
+		}
+	}
+
+	return released
+}
@@ -0,0 +1,61 @@
+package triggers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFiring_fire(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		var firing Firing
+		assert.True(t, firing.fire("", time.Minute, now))
+		assert.False(t, firing.fire("", time.Minute, now.Add(30*time.Second)))
+		assert.True(t, firing.fire("", time.Minute, now.Add(2*time.Minute)))
+		assert.Equal(t, now.Add(2*time.Minute), firing.FiredAt)
+	})
+
+	t.Run("dedup key", func(t *testing.T) {
+		t.Parallel()
+
+		var firing Firing
+		assert.True(t, firing.fire("TRIGGER_IMAGE=nginx:1", 0, now))
+		assert.False(t, firing.fire("TRIGGER_IMAGE=nginx:1", 0, now.Add(time.Hour)))
+		assert.True(t, firing.fire("TRIGGER_IMAGE=nginx:2", 0, now.Add(time.Hour)))
+		assert.Equal(t, []string{"TRIGGER_IMAGE=nginx:1", "TRIGGER_IMAGE=nginx:2"}, firing.DedupKeys)
+	})
+
+	t.Run("dedup keys are limited", func(t *testing.T) {
+		t.Parallel()
+
+		var firing Firing
+		for i := 0; i <= maxDedupKeys; i++ {
+			assert.True(t, firing.fire(time.Duration(i).String(), 0, now))
+		}
+
+		assert.Len(t, firing.DedupKeys, maxDedupKeys)
+		assert.True(t, firing.fire(time.Duration(0).String(), 0, now))
+	})
+}
+
+func TestFiring_release(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	var firing Firing
+	assert.False(t, firing.release("TRIGGER_IMAGE=nginx:1"))
+
+	assert.True(t, firing.fire("TRIGGER_IMAGE=nginx:1", time.Hour, now))
+	assert.True(t, firing.fire("TRIGGER_IMAGE=nginx:2", 0, now))
+	assert.True(t, firing.release("TRIGGER_IMAGE=nginx:1"))
+	assert.Equal(t, []string{"TRIGGER_IMAGE=nginx:2"}, firing.DedupKeys)
+	assert.True(t, firing.fire("TRIGGER_IMAGE=nginx:1", time.Hour, now))
+}
@@ -2,18 +2,26 @@ package triggers
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/kubeshop/testkube/pkg/repository/common"
 )
 
 const (
 	mongoCollectionTriggersLease = "triggers"
+	sqlTableTriggersLease        = "triggers"
+	sqlTableTriggerFirings       = "trigger_firings"
 	documentType                 = "lease"
+	firingDocumentType           = "firing"
 )
 
 // LeaseBackend does a check and set operation on the Lease object in the defined data source
@@ -22,18 +30,55 @@ const (
 type LeaseBackend interface {
 	// TryAcquire tries to acquire lease from underlying datastore
 	TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error)
+	// TryFire records firing of the test trigger in underlying datastore, so it's shared by all replicas,
+	// firing is rejected within cooldown of the last firing or when dedup key already fired
+	TryFire(ctx context.Context, clusterID, trigger, dedupKey string, cooldown time.Duration) (fired bool, err error)
+	// ReleaseFire forgets firing of the test trigger which action failed, so the next matching event fires again
+	ReleaseFire(ctx context.Context, clusterID, trigger, dedupKey string) error
 }
 
-type AcquireAlwaysLeaseBackend struct{}
+type AcquireAlwaysLeaseBackend struct {
+	mutex   sync.Mutex
+	firings map[string]*Firing
+}
 
 func NewAcquireAlwaysLeaseBackend() *AcquireAlwaysLeaseBackend {
-	return &AcquireAlwaysLeaseBackend{}
+	return &AcquireAlwaysLeaseBackend{firings: make(map[string]*Firing)}
 }
 
 func (b *AcquireAlwaysLeaseBackend) TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error) {
 	return true, nil
 }
 
+func (b *AcquireAlwaysLeaseBackend) TryFire(ctx context.Context, clusterID, trigger, dedupKey string, cooldown time.Duration) (fired bool, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.firings == nil {
+		b.firings = make(map[string]*Firing)
+	}
+
+	firingID := newFiringID(clusterID, trigger)
+	firing, ok := b.firings[firingID]
+	if !ok {
+		firing = &Firing{}
+		b.firings[firingID] = firing
+	}
+
+	return firing.fire(dedupKey, cooldown, time.Now()), nil
+}
+
+func (b *AcquireAlwaysLeaseBackend) ReleaseFire(ctx context.Context, clusterID, trigger, dedupKey string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if firing, ok := b.firings[newFiringID(clusterID, trigger)]; ok {
+		firing.release(dedupKey)
+	}
+
+	return nil
+}
+
 type MongoLeaseBackend struct {
 	coll *mongo.Collection
 }
@@ -132,6 +177,208 @@ func (b *MongoLeaseBackend) tryUpdateLease(ctx context.Context, leaseMongoID, id
 	return &updatedLease.Lease, nil
 }
 
+func (b *MongoLeaseBackend) TryFire(ctx context.Context, clusterID, trigger, dedupKey string, cooldown time.Duration) (fired bool, err error) {
+	return b.updateFiring(ctx, newFiringID(clusterID, trigger), func(firing *Firing) bool {
+		return firing.fire(dedupKey, cooldown, time.Now())
+	})
+}
+
+func (b *MongoLeaseBackend) ReleaseFire(ctx context.Context, clusterID, trigger, dedupKey string) error {
+	_, err := b.updateFiring(ctx, newFiringID(clusterID, trigger), func(firing *Firing) bool {
+		return firing.release(dedupKey)
+	})
+	return err
+}
+
+// updateFiring changes firing with compare and set on the previous firing, so concurrent firings
+// of replicas don't overwrite each other, lost updates are retried with the newer firing
+func (b *MongoLeaseBackend) updateFiring(ctx context.Context, firingID string, change func(firing *Firing) bool) (updated bool, err error) {
+	for attempt := 0; attempt < common.MaxUpdateAttempts; attempt++ {
+		var currentFiring MongoFiring
+		res := b.coll.FindOne(ctx, bson.M{"_id": firingID})
+		found := true
+		switch {
+		case res.Err() == mongo.ErrNoDocuments:
+			found = false
+		case res.Err() != nil:
+			return false, errors.Wrap(res.Err(), "error finding firing document in mongo")
+		default:
+			if err = res.Decode(&currentFiring); err != nil {
+				return false, errors.Wrap(err, "error decoding firing mongo document")
+			}
+		}
+
+		previous := currentFiring.Firing
+		previous.DedupKeys = append([]string(nil), previous.DedupKeys...)
+		if !change(&currentFiring.Firing) {
+			return false, nil
+		}
+
+		if !found {
+			_, err = b.coll.InsertOne(ctx, bson.M{"_id": firingID, "firing": currentFiring.Firing})
+			if mongo.IsDuplicateKeyError(err) {
+				continue
+			}
+			if err != nil {
+				return false, errors.Wrap(err, "error inserting firing document into mongo")
+			}
+
+			return true, nil
+		}
+
+		result, err := b.coll.UpdateOne(
+			ctx,
+			bson.M{"_id": firingID, "firing.fired_at": previous.FiredAt, "firing.dedup_keys": previous.DedupKeys},
+			bson.M{"$set": bson.M{"firing": currentFiring.Firing}},
+		)
+		if err != nil {
+			return false, errors.Wrap(err, "error updating firing document in mongo")
+		}
+
+		if result.MatchedCount != 0 {
+			return true, nil
+		}
+	}
+
+	return false, common.ErrConcurrentUpdate
+}
+
+type SQLLeaseBackend struct {
+	db *sql.DB
+}
+
+func NewSQLLeaseBackend(db *sql.DB) *SQLLeaseBackend {
+	return &SQLLeaseBackend{db: db}
+}
+
+func (b *SQLLeaseBackend) TryAcquire(ctx context.Context, id, clusterID string) (leased bool, err error) {
+	leaseID := newLeaseMongoID(clusterID)
+	lease := NewLease(id, clusterID)
+	_, err = b.db.ExecContext(ctx, "INSERT INTO "+sqlTableTriggersLease+" (id, identifier, cluster_id, acquired_at, renewed_at) "+
+		"VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO NOTHING",
+		leaseID, lease.Identifier, lease.ClusterID, common.ToUnixMilli(lease.AcquiredAt), common.ToUnixMilli(lease.RenewedAt))
+	if err != nil {
+		return false, errors.Wrap(err, "error inserting lease record")
+	}
+
+	currentLease, renewedAt, err := b.findLease(ctx, leaseID)
+	if err != nil {
+		return false, err
+	}
+
+	acquired, renewable := leaseStatus(currentLease, id, clusterID)
+	switch {
+	case acquired:
+		return true, nil
+	case !renewable:
+		return false, nil
+	}
+
+	acquiredAt := currentLease.AcquiredAt
+	if currentLease.Identifier != id {
+		acquiredAt = time.Now()
+	}
+	// optimistic update, only one of the competing replicas renews the lease
+	_, err = b.db.ExecContext(ctx, "UPDATE "+sqlTableTriggersLease+" SET identifier = $1, cluster_id = $2, acquired_at = $3, renewed_at = $4 "+
+		"WHERE id = $5 AND renewed_at = $6",
+		id, clusterID, common.ToUnixMilli(acquiredAt), common.ToUnixMilli(time.Now()), leaseID, renewedAt)
+	if err != nil {
+		return false, errors.Wrap(err, "error updating lease record")
+	}
+
+	newLease, _, err := b.findLease(ctx, leaseID)
+	if err != nil {
+		return false, err
+	}
+	acquired, _ = leaseStatus(newLease, id, clusterID)
+
+	return acquired, nil
+}
+
+func (b *SQLLeaseBackend) TryFire(ctx context.Context, clusterID, trigger, dedupKey string, cooldown time.Duration) (fired bool, err error) {
+	return b.updateFiring(ctx, newFiringID(clusterID, trigger), func(firing *Firing) bool {
+		return firing.fire(dedupKey, cooldown, time.Now())
+	})
+}
+
+func (b *SQLLeaseBackend) ReleaseFire(ctx context.Context, clusterID, trigger, dedupKey string) error {
+	_, err := b.updateFiring(ctx, newFiringID(clusterID, trigger), func(firing *Firing) bool {
+		return firing.release(dedupKey)
+	})
+	return err
+}
+
+// updateFiring changes firing with compare and set on the previous firing, so concurrent firings
+// of replicas don't overwrite each other, lost updates are retried with the newer firing
+func (b *SQLLeaseBackend) updateFiring(ctx context.Context, firingID string, change func(firing *Firing) bool) (updated bool, err error) {
+	for attempt := 0; attempt < common.MaxUpdateAttempts; attempt++ {
+		var firing Firing
+		var firedAt int64
+		var dedupKeys string
+		found := true
+		err = b.db.QueryRowContext(ctx, "SELECT fired_at, dedup_keys FROM "+sqlTableTriggerFirings+" WHERE id = $1", firingID).
+			Scan(&firedAt, &dedupKeys)
+		switch {
+		case err == sql.ErrNoRows:
+			found = false
+		case err != nil:
+			return false, errors.Wrap(err, "error finding firing record")
+		default:
+			firing.FiredAt = common.FromUnixMilli(firedAt)
+			if err = json.Unmarshal([]byte(dedupKeys), &firing.DedupKeys); err != nil {
+				return false, errors.Wrap(err, "error decoding firing dedup keys")
+			}
+		}
+
+		if !change(&firing) {
+			return false, nil
+		}
+
+		data, err := json.Marshal(firing.DedupKeys)
+		if err != nil {
+			return false, errors.Wrap(err, "error encoding firing dedup keys")
+		}
+
+		var result sql.Result
+		if found {
+			result, err = b.db.ExecContext(ctx, "UPDATE "+sqlTableTriggerFirings+" SET fired_at = $2, dedup_keys = $3 "+
+				"WHERE id = $1 AND fired_at = $4 AND dedup_keys = $5",
+				firingID, common.ToUnixMilli(firing.FiredAt), string(data), firedAt, dedupKeys)
+		} else {
+			result, err = b.db.ExecContext(ctx, "INSERT INTO "+sqlTableTriggerFirings+" (id, fired_at, dedup_keys) VALUES ($1, $2, $3) "+
+				"ON CONFLICT (id) DO NOTHING", firingID, common.ToUnixMilli(firing.FiredAt), string(data))
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "error storing firing record")
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, errors.Wrap(err, "error checking stored firing record")
+		}
+
+		if rows != 0 {
+			return true, nil
+		}
+	}
+
+	return false, common.ErrConcurrentUpdate
+}
+
+func (b *SQLLeaseBackend) findLease(ctx context.Context, leaseID string) (*Lease, int64, error) {
+	var lease Lease
+	var acquiredAt, renewedAt int64
+	err := b.db.QueryRowContext(ctx, "SELECT identifier, cluster_id, acquired_at, renewed_at FROM "+sqlTableTriggersLease+" WHERE id = $1", leaseID).
+		Scan(&lease.Identifier, &lease.ClusterID, &acquiredAt, &renewedAt)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "error finding lease record")
+	}
+
+	lease.AcquiredAt = common.FromUnixMilli(acquiredAt)
+	lease.RenewedAt = common.FromUnixMilli(renewedAt)
+	return &lease, renewedAt, nil
+}
+
 func leaseStatus(lease *Lease, id, clusterID string) (acquired bool, renewable bool) {
 	if lease == nil {
 		return false, false
@@ -157,6 +404,14 @@ func newLeaseMongoID(clusterID string) string {
 	return fmt.Sprintf("%s-%s", documentType, clusterID)
 }
 
+func newFiringID(clusterID, trigger string) string {
+	return fmt.Sprintf("%s-%s-%s", firingDocumentType, clusterID, trigger)
+}
+
+type MongoFiring struct {
+	Firing Firing `bson:"firing"`
+}
+
 type MongoLease struct {
 	_id string `bson:"_id"`
 	Lease
@@ -2,12 +2,18 @@ package triggers
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"github.com/kubeshop/testkube/pkg/repository/common"
+	"github.com/kubeshop/testkube/pkg/repository/storage"
 )
 
 func TestMongoLeaseBackend_TryAcquire(t *testing.T) {
@@ -192,6 +198,54 @@ func TestMongoLeaseBackend_TryAcquire(t *testing.T) {
 	})
 }
 
+func TestMongoLeaseBackend_TryFire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	testClusterID := "testkube_api"
+	newFiringResponse := func(firedAt time.Time) bson.D {
+		return mtest.CreateCursorResponse(0, "testkube.triggers", mtest.FirstBatch, cycleBSON(bson.M{
+			"_id":    newFiringID(testClusterID, "testkube/test-trigger"),
+			"firing": Firing{FiredAt: firedAt},
+		}))
+	}
+
+	mt.Run("fire first time", func(mt *mtest.T) {
+		leaseBackend := NewMongoLeaseBackend(mt.DB)
+		mt.AddMockResponses(mtest.CreateCursorResponse(0, "testkube.triggers", mtest.FirstBatch))
+		mt.AddMockResponses(mtest.CreateSuccessResponse())
+
+		fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "", time.Hour)
+		assert.NoError(mt, err)
+		assert.True(mt, fired)
+	})
+
+	mt.Run("fire after cooldown", func(mt *mtest.T) {
+		leaseBackend := NewMongoLeaseBackend(mt.DB)
+		mt.AddMockResponses(newFiringResponse(time.Now().Add(-2 * time.Hour)))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}, bson.E{Key: "nModified", Value: 1}))
+
+		fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "", time.Hour)
+		assert.NoError(mt, err)
+		assert.True(mt, fired)
+	})
+
+	mt.Run("reject firing lost to other replica", func(mt *mtest.T) {
+		leaseBackend := NewMongoLeaseBackend(mt.DB)
+		mt.AddMockResponses(newFiringResponse(time.Now().Add(-2 * time.Hour)))
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 0}, bson.E{Key: "nModified", Value: 0}))
+		mt.AddMockResponses(newFiringResponse(time.Now()))
+
+		fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "", time.Hour)
+		assert.NoError(mt, err)
+		assert.False(mt, fired)
+	})
+}
+
 func cycleBSON(data any) bson.D {
 	bsonData, _ := bson.Marshal(data)
 	var bsonD bson.D
@@ -199,3 +253,112 @@ func cycleBSON(data any) bson.D {
 
 	return bsonD
 }
+
+func TestSQLLeaseBackend_TryAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	leaseBackend := NewSQLLeaseBackend(db)
+	testClusterID := "testkube_api"
+
+	leased, err := leaseBackend.TryAcquire(ctx, "test-host-1", testClusterID)
+	assert.NoError(t, err)
+	assert.True(t, leased)
+
+	leased, err = leaseBackend.TryAcquire(ctx, "test-host-2", testClusterID)
+	assert.NoError(t, err)
+	assert.False(t, leased)
+
+	// expire the lease of the first host
+	expired := common.ToUnixMilli(time.Now().Add(-2 * defaultMaxLeaseDuration))
+	_, err = db.Exec("UPDATE "+sqlTableTriggersLease+" SET renewed_at = $1", expired)
+	assert.NoError(t, err)
+
+	leased, err = leaseBackend.TryAcquire(ctx, "test-host-2", testClusterID)
+	assert.NoError(t, err)
+	assert.True(t, leased)
+}
+
+func TestSQLLeaseBackend_TryFire(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	leaseBackend := NewSQLLeaseBackend(db)
+	testClusterID := "testkube_api"
+
+	fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:1", time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, fired)
+
+	fired, err = leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:2", time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, fired)
+
+	fired, err = leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:1", 0)
+	assert.NoError(t, err)
+	assert.False(t, fired)
+
+	fired, err = leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:2", 0)
+	assert.NoError(t, err)
+	assert.True(t, fired)
+
+	fired, err = leaseBackend.TryFire(ctx, testClusterID, "testkube/other-trigger", "TRIGGER_IMAGE=nginx:1", time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, fired)
+
+	assert.NoError(t, leaseBackend.ReleaseFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:2"))
+	fired, err = leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", "TRIGGER_IMAGE=nginx:2", time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, fired)
+}
+
+func TestSQLLeaseBackend_TryFire_concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, err := storage.GetSQLiteDatabase(filepath.Join(t.TempDir(), "testkube.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	leaseBackend := NewSQLLeaseBackend(db)
+	testClusterID := "testkube_api"
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firings int
+	var errs []error
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", fmt.Sprintf("TRIGGER_IMAGE=nginx:%d", i), 0)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if fired {
+				firings++
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Empty(t, errs)
+	assert.Equal(t, 10, firings)
+
+	// every dedup key was recorded, none was overwritten by concurrent firing
+	for i := 0; i < 10; i++ {
+		fired, err := leaseBackend.TryFire(ctx, testClusterID, "testkube/test-trigger", fmt.Sprintf("TRIGGER_IMAGE=nginx:%d", i), 0)
+		assert.NoError(t, err)
+		assert.False(t, fired)
+	}
+}
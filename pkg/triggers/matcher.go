@@ -16,6 +16,8 @@ import (
 
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
 	thttp "github.com/kubeshop/testkube/pkg/http"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+	"github.com/kubeshop/testkube/pkg/triggerexpression"
 )
 
 const (
@@ -29,9 +31,9 @@ var (
 )
 
 func (s *Service) match(ctx context.Context, e *watcherEvent) error {
-	for _, status := range s.triggerStatus {
+	for _, status := range s.getTriggerStatuses() {
 		t := status.testTrigger
-		if t.Spec.Resource != testtriggersv1.TestTriggerResource(e.resource) {
+		if resource, err := getTriggerResource(t); err != nil || resource != e.resource {
 			continue
 		}
 		if !matchEventOrCause(string(t.Spec.Event), e) {
@@ -40,6 +42,9 @@ func (s *Service) match(ctx context.Context, e *watcherEvent) error {
 		if !matchSelector(&t.Spec.ResourceSelector, t.Namespace, e, s.logger) {
 			continue
 		}
+		if !matchExpression(t, e, s.logger) {
+			continue
+		}
 		hasConditions := t.Spec.ConditionSpec != nil && len(t.Spec.ConditionSpec.Conditions) != 0
 		if hasConditions && e.conditionsGetter != nil {
 			matched, err := s.matchConditions(ctx, e, t, s.logger)
@@ -64,36 +69,46 @@ func (s *Service) match(ctx context.Context, e *watcherEvent) error {
 			}
 		}
 
-		status := s.getStatusForTrigger(t)
-		if t.Spec.ConcurrencyPolicy == testtriggersv1.TestTriggerConcurrencyPolicyForbid {
-			if status.hasActiveTests() {
-				s.logger.Infof(
-					"trigger service: matcher component: skipping trigger execution for trigger %s/%s by event %s on resource %s because it is currently running tests",
-					t.Namespace, t.Name, e.eventType, e.resource,
-				)
-				return nil
-			}
-		}
-
-		if t.Spec.ConcurrencyPolicy == testtriggersv1.TestTriggerConcurrencyPolicyReplace {
-			if status.hasActiveTests() {
-				s.logger.Infof(
-					"trigger service: matcher component: aborting trigger execution for trigger %s/%s by event %s on resource %s because it is currently running tests",
-					t.Namespace, t.Name, e.eventType, e.resource,
-				)
-				s.abortExecutions(ctx, t.Name, status)
-			}
+		if debounce := testtriggersmapper.GetThrottling(t).GetDebounce(); debounce > 0 {
+			s.debounce(ctx, e, status, debounce)
+			continue
 		}
 
-		s.logger.Infof("trigger service: matcher component: event %s matches trigger %s/%s for resource %s", e.eventType, t.Namespace, t.Name, e.resource)
-		s.logger.Infof("trigger service: matcher component: triggering %s action for %s execution", t.Spec.Action, t.Spec.Execution)
-		if err := s.triggerExecutor(ctx, t); err != nil {
+		if err := s.fire(ctx, e, status); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+func matchExpression(t *testtriggersv1.TestTrigger, e *watcherEvent, logger *zap.SugaredLogger) bool {
+	expression := testtriggersmapper.GetExpression(t)
+	if expression == "" {
+		return true
+	}
+
+	causes := make([]string, len(e.causes))
+	for i := range e.causes {
+		causes[i] = string(e.causes[i])
+	}
+
+	input := triggerexpression.Input{
+		Resource:  string(e.resource),
+		Event:     string(e.eventType),
+		Labels:    e.labels,
+		Causes:    causes,
+		Object:    e.object,
+		OldObject: e.oldObject,
+	}
+	matched, err := triggerexpression.Evaluate(expression, input)
+	if err != nil {
+		logger.Errorf("trigger service: matcher component: error evaluating expression for testtrigger %s/%s: %v", t.Namespace, t.Name, err)
+		return false
+	}
+
+	return matched
+}
+
 func matchEventOrCause(targetEvent string, event *watcherEvent) bool {
 	if targetEvent == string(event.eventType) {
 		return true
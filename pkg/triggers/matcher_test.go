@@ -2,16 +2,20 @@ package triggers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
 	"github.com/kubeshop/testkube/pkg/log"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
 )
 
 func TestService_matchConditionsRetry(t *testing.T) {
@@ -82,7 +86,7 @@ func TestService_matchConditionsRetry(t *testing.T) {
 	s := &Service{
 		defaultConditionsCheckBackoff: defaultConditionsCheckBackoff,
 		defaultConditionsCheckTimeout: defaultConditionsCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -158,7 +162,7 @@ func TestService_matchConditionsTimeout(t *testing.T) {
 	s := &Service{
 		defaultConditionsCheckBackoff: defaultConditionsCheckBackoff,
 		defaultConditionsCheckTimeout: defaultConditionsCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -230,7 +234,7 @@ func TestService_matchProbesMultiple(t *testing.T) {
 	s := &Service{
 		defaultProbesCheckBackoff: defaultProbesCheckBackoff,
 		defaultProbesCheckTimeout: defaultProbesCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -296,7 +300,7 @@ func TestService_matchProbesTimeout(t *testing.T) {
 	s := &Service{
 		defaultProbesCheckBackoff: defaultProbesCheckBackoff,
 		defaultProbesCheckTimeout: defaultProbesCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -401,7 +405,7 @@ func TestService_match(t *testing.T) {
 		defaultConditionsCheckTimeout: defaultConditionsCheckTimeout,
 		defaultProbesCheckBackoff:     defaultProbesCheckBackoff,
 		defaultProbesCheckTimeout:     defaultProbesCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -451,7 +455,7 @@ func TestService_matchRegex(t *testing.T) {
 		defaultConditionsCheckTimeout: defaultConditionsCheckTimeout,
 		defaultProbesCheckBackoff:     defaultProbesCheckBackoff,
 		defaultProbesCheckTimeout:     defaultProbesCheckTimeout,
-		triggerExecutor: func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, "testkube", trigger.Namespace)
 			assert.Equal(t, "test-trigger-1", trigger.Name)
 			return nil
@@ -492,7 +496,7 @@ func TestService_noMatch(t *testing.T) {
 	}
 	statusKey1 := newStatusKey(testTrigger1.Namespace, testTrigger1.Name)
 	triggerStatus1 := &triggerStatus{testTrigger: testTrigger1}
-	testExecutorF := func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+	testExecutorF := func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 		assert.Fail(t, "should not match event")
 		return nil
 	}
@@ -505,3 +509,206 @@ func TestService_noMatch(t *testing.T) {
 	err := s.match(context.Background(), e)
 	assert.NoError(t, err)
 }
+
+func TestService_matchExpression(t *testing.T) {
+	t.Parallel()
+
+	configMap := func(flags string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "features", Labels: map[string]string{"team": "payments"}},
+			Data:       map[string]string{"feature.flags": flags},
+		}
+	}
+
+	testTrigger1 := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "testkube",
+			Name:      "test-trigger-1",
+			Annotations: map[string]string{
+				testtriggersmapper.ExpressionAnnotation: `labels["team"] == "payments" && object.data["feature.flags"] != oldObject.data["feature.flags"]`,
+			},
+		},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "configmap",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "features"},
+			Event:             "modified",
+			Action:            "run",
+			Execution:         "test",
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test"},
+		},
+	}
+	statusKey1 := newStatusKey(testTrigger1.Namespace, testTrigger1.Name)
+
+	matched := 0
+	s := &Service{
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
+			assert.Equal(t, "test-trigger-1", trigger.Name)
+			matched++
+			return nil
+		},
+		triggerStatus: map[statusKey]*triggerStatus{statusKey1: {testTrigger: testTrigger1}},
+		logger:        log.DefaultLogger,
+	}
+
+	unchanged := newWatcherEvent("modified", configMap("a=true"), "configmap", withOldObject(configMap("a=true")))
+	assert.NoError(t, s.match(context.Background(), unchanged))
+	assert.Equal(t, 0, matched)
+
+	changed := newWatcherEvent("modified", configMap("b=true"), "configmap", withOldObject(configMap("a=true")))
+	assert.NoError(t, s.match(context.Background(), changed))
+	assert.Equal(t, 1, matched)
+}
+
+func TestService_matchThrottling(t *testing.T) {
+	t.Parallel()
+
+	configMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: name}}
+	}
+
+	testTrigger1 := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "testkube",
+			Name:      "test-trigger-1",
+			Annotations: map[string]string{
+				testtriggersmapper.DedupKeyAnnotation: VariableTriggerResourceName,
+			},
+		},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "configmap",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{NameRegex: "features-.*"},
+			Event:             "modified",
+			Action:            "run",
+			Execution:         "test",
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test"},
+		},
+	}
+	statusKey1 := newStatusKey(testTrigger1.Namespace, testTrigger1.Name)
+
+	matched := 0
+	s := &Service{
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
+			matched++
+			return nil
+		},
+		triggerStatus: map[statusKey]*triggerStatus{statusKey1: {testTrigger: testTrigger1}},
+		leaseBackend:  NewAcquireAlwaysLeaseBackend(),
+		clusterID:     "testkube-api",
+		logger:        log.DefaultLogger,
+	}
+
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap("features-1"), "configmap")))
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap("features-1"), "configmap")))
+	assert.Equal(t, 1, matched)
+
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap("features-2"), "configmap")))
+	assert.Equal(t, 2, matched)
+
+	testTrigger1.Annotations[testtriggersmapper.CooldownAnnotation] = "1h"
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap("features-3"), "configmap")))
+	assert.Equal(t, 2, matched)
+}
+
+func TestService_matchThrottlingFailedAction(t *testing.T) {
+	t.Parallel()
+
+	testTrigger1 := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "testkube",
+			Name:      "test-trigger-1",
+			Annotations: map[string]string{
+				testtriggersmapper.CooldownAnnotation: "1h",
+				testtriggersmapper.DedupKeyAnnotation: VariableTriggerResourceName,
+			},
+		},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "configmap",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "features"},
+			Event:             "modified",
+			Action:            "run",
+			Execution:         "test",
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test"},
+		},
+	}
+	statusKey1 := newStatusKey(testTrigger1.Namespace, testTrigger1.Name)
+
+	attempts := 0
+	s := &Service{
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
+			attempts++
+			if attempts == 1 {
+				return errors.New("test not found")
+			}
+			return nil
+		},
+		triggerStatus: map[statusKey]*triggerStatus{statusKey1: {testTrigger: testTrigger1}},
+		leaseBackend:  NewAcquireAlwaysLeaseBackend(),
+		clusterID:     "testkube-api",
+		logger:        log.DefaultLogger,
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "features"}}
+	assert.Error(t, s.match(context.Background(), newWatcherEvent("modified", configMap, "configmap")))
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap, "configmap")))
+	assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap, "configmap")))
+	assert.Equal(t, 2, attempts)
+}
+
+func TestService_matchDebounce(t *testing.T) {
+	t.Parallel()
+
+	configMap := func(version string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "testkube", Name: "features", ResourceVersion: version}}
+	}
+
+	testTrigger1 := &testtriggersv1.TestTrigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "testkube",
+			Name:      "test-trigger-1",
+			Annotations: map[string]string{
+				testtriggersmapper.DebounceAnnotation: "100ms",
+			},
+		},
+		Spec: testtriggersv1.TestTriggerSpec{
+			Resource:          "configmap",
+			ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "features"},
+			Event:             "modified",
+			Action:            "run",
+			Execution:         "test",
+			ConcurrencyPolicy: "allow",
+			TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test"},
+		},
+	}
+	statusKey1 := newStatusKey(testTrigger1.Namespace, testTrigger1.Name)
+
+	fired := make(chan *watcherEvent, 10)
+	s := &Service{
+		triggerExecutor: func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
+			fired <- e
+			return nil
+		},
+		triggerStatus: map[statusKey]*triggerStatus{statusKey1: {testTrigger: testTrigger1}},
+		leaseBackend:  NewAcquireAlwaysLeaseBackend(),
+		logger:        log.DefaultLogger,
+	}
+
+	for _, version := range []string{"1", "2", "3"} {
+		assert.NoError(t, s.match(context.Background(), newWatcherEvent("modified", configMap(version), "configmap")))
+	}
+
+	select {
+	case e := <-fired:
+		assert.Equal(t, "3", e.object.GetResourceVersion())
+	case <-time.After(5 * time.Second):
+		t.Fatal("debounced trigger was not fired")
+	}
+
+	select {
+	case <-fired:
+		t.Fatal("debounced trigger was fired more than once")
+	case <-time.After(300 * time.Millisecond):
+	}
+}
@@ -7,6 +7,7 @@ package triggers
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,6 +35,20 @@ func (m *MockLeaseBackend) EXPECT() *MockLeaseBackendMockRecorder {
 	return m.recorder
 }
 
+// ReleaseFire mocks base method.
+func (m *MockLeaseBackend) ReleaseFire(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseFire", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseFire indicates an expected call of ReleaseFire.
+func (mr *MockLeaseBackendMockRecorder) ReleaseFire(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseFire", reflect.TypeOf((*MockLeaseBackend)(nil).ReleaseFire), arg0, arg1, arg2, arg3)
+}
+
 // TryAcquire mocks base method.
 func (m *MockLeaseBackend) TryAcquire(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -48,3 +63,18 @@ func (mr *MockLeaseBackendMockRecorder) TryAcquire(arg0, arg1, arg2 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryAcquire", reflect.TypeOf((*MockLeaseBackend)(nil).TryAcquire), arg0, arg1, arg2)
 }
+
+// TryFire mocks base method.
+func (m *MockLeaseBackend) TryFire(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryFire", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryFire indicates an expected call of TryFire.
+func (mr *MockLeaseBackendMockRecorder) TryFire(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryFire", reflect.TypeOf((*MockLeaseBackend)(nil).TryFire), arg0, arg1, arg2, arg3, arg4)
+}
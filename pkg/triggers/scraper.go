@@ -21,7 +21,7 @@ func (s *Service) runExecutionScraper(ctx context.Context) {
 			return
 		case <-ticker.C:
 			s.logger.Debugf("trigger service: execution scraper component: starting new ticker iteration")
-			for triggerName, status := range s.triggerStatus {
+			for triggerName, status := range s.getTriggerStatuses() {
 				if status.hasActiveTests() {
 					s.checkForRunningTestExecutions(ctx, status)
 					s.checkForRunningTestSuiteExecutions(ctx, status)
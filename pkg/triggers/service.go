@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
 
 	testsv3 "github.com/kubeshop/testkube-operator/api/tests/v3"
 	testsuitev3 "github.com/kubeshop/testkube-operator/api/testsuite/v3"
@@ -39,6 +44,7 @@ const (
 	defaultConditionsCheckTimeout = 60 * time.Second
 	defaultProbesCheckBackoff     = 1 * time.Second
 	defaultProbesCheckTimeout     = 60 * time.Second
+	defaultWebhookTimeout         = 10 * time.Second
 	defaultClusterID              = "testkube-api"
 	defaultIdentifierFormat       = "testkube-api-%s"
 )
@@ -58,8 +64,11 @@ type Service struct {
 	defaultProbesCheckBackoff     time.Duration
 	watchFromDate                 time.Time
 	triggerStatus                 map[statusKey]*triggerStatus
+	triggerStatusMutex            sync.RWMutex
 	scheduler                     *scheduler.Scheduler
 	clientset                     kubernetes.Interface
+	dynamicClient                 dynamic.Interface
+	restMapper                    meta.RESTMapper
 	testKubeClientset             testkubeclientsetv1.Interface
 	testSuitesClient              testsuitesclientv3.Interface
 	testsClient                   testsclientv3.Interface
@@ -199,6 +208,14 @@ func WithWatcherNamespaces(namespaces string) Option {
 	}
 }
 
+// WithDynamicClient enables test triggers on custom resources, watched by dynamic informers started on demand
+func WithDynamicClient(dynamicClient dynamic.Interface) Option {
+	return func(s *Service) {
+		s.dynamicClient = dynamicClient
+		s.restMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(s.clientset.Discovery()))
+	}
+}
+
 func (s *Service) Run(ctx context.Context) {
 	leaseChan := make(chan bool)
 
@@ -210,11 +227,17 @@ func (s *Service) Run(ctx context.Context) {
 }
 
 func (s *Service) addTrigger(t *testtriggersv1.TestTrigger) {
+	s.triggerStatusMutex.Lock()
+	defer s.triggerStatusMutex.Unlock()
+
 	key := newStatusKey(t.Namespace, t.Name)
 	s.triggerStatus[key] = newTriggerStatus(t)
 }
 
 func (s *Service) updateTrigger(target *testtriggersv1.TestTrigger) {
+	s.triggerStatusMutex.Lock()
+	defer s.triggerStatusMutex.Unlock()
+
 	key := newStatusKey(target.Namespace, target.Name)
 	if s.triggerStatus[key] != nil {
 		s.triggerStatus[key].testTrigger = target
@@ -224,10 +247,23 @@ func (s *Service) updateTrigger(target *testtriggersv1.TestTrigger) {
 }
 
 func (s *Service) removeTrigger(target *testtriggersv1.TestTrigger) {
+	s.triggerStatusMutex.Lock()
+	defer s.triggerStatusMutex.Unlock()
+
 	key := newStatusKey(target.Namespace, target.Name)
+	if status, ok := s.triggerStatus[key]; ok {
+		status.stopDebounce()
+	}
 	delete(s.triggerStatus, key)
 }
 
+// stopDebounces drops debounced events of all test triggers, they are fired only by the lease holder
+func (s *Service) stopDebounces() {
+	for _, status := range s.getTriggerStatuses() {
+		status.stopDebounce()
+	}
+}
+
 func (s *Service) addTest(test *testsv3.Test) {
 	ctx := context.Background()
 	telemetryEnabled, err := s.configMap.GetTelemetryEnabled(ctx)
@@ -106,6 +106,7 @@ func TestService_Run(t *testing.T) {
 	mockResultRepository.EXPECT().StartExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Return(&mockExecutionResult, nil)
 	mockResultRepository.EXPECT().UpdateResult(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	configMapConfig.EXPECT().Get(gomock.Any()).Return(testkube.Config{}, nil).AnyTimes()
 
 	mockTestExecution := testkube.Execution{
 		Id:              "test-suite-execution-1",
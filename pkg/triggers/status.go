@@ -20,6 +20,8 @@ type triggerStatus struct {
 	lastExecutionFinished *time.Time
 	testExecutionIDs      []string
 	testSuiteExecutionIDs []string
+	debounceTimer         *time.Timer
+	debouncedEvent        *watcherEvent
 	sync.RWMutex
 }
 
@@ -107,7 +109,36 @@ func (s *triggerStatus) done() {
 	s.lastExecutionFinished = &now
 }
 
+// stopDebounce drops the debounced event of the test trigger
+func (s *triggerStatus) stopDebounce() {
+	defer s.Unlock()
+
+	s.Lock()
+	if s.debounceTimer != nil {
+		s.debounceTimer.Stop()
+	}
+	s.debounceTimer = nil
+	s.debouncedEvent = nil
+}
+
 func (s *Service) getStatusForTrigger(t *testtriggersv1.TestTrigger) *triggerStatus {
+	s.triggerStatusMutex.RLock()
+	defer s.triggerStatusMutex.RUnlock()
+
 	key := newStatusKey(t.Namespace, t.Name)
 	return s.triggerStatus[key]
 }
+
+// getTriggerStatuses returns copy of the test trigger statuses, so they can be iterated
+// while informers add and remove test triggers
+func (s *Service) getTriggerStatuses() map[statusKey]*triggerStatus {
+	s.triggerStatusMutex.RLock()
+	defer s.triggerStatusMutex.RUnlock()
+
+	statuses := make(map[statusKey]*triggerStatus, len(s.triggerStatus))
+	for key, status := range s.triggerStatus {
+		statuses[key] = status
+	}
+
+	return statuses
+}
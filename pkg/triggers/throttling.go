@@ -0,0 +1,142 @@
+package triggers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
+)
+
+// debounce postpones firing of the test trigger until there are no matching events for the debounce duration,
+// the trigger fires with the latest event
+func (s *Service) debounce(ctx context.Context, e *watcherEvent, status *triggerStatus, debounce time.Duration) {
+	t := status.testTrigger
+	status.Lock()
+	defer status.Unlock()
+
+	status.debouncedEvent = e
+	if status.debounceTimer != nil {
+		s.logger.Debugf("trigger service: matcher component: debouncing trigger %s/%s for %s", t.Namespace, t.Name, debounce)
+		status.debounceTimer.Reset(debounce)
+		return
+	}
+
+	status.debounceTimer = time.AfterFunc(debounce, func() {
+		status.Lock()
+		event := status.debouncedEvent
+		status.debouncedEvent = nil
+		status.debounceTimer = nil
+		status.Unlock()
+
+		// event is cleared when the test trigger was removed
+		if event == nil || ctx.Err() != nil {
+			return
+		}
+
+		if err := s.fire(ctx, event, status); err != nil {
+			s.logger.Errorf("trigger service: matcher component: error firing debounced trigger %s/%s: %v", t.Namespace, t.Name, err)
+		}
+	})
+}
+
+// fire runs the test trigger action, unless it's skipped by concurrency policy, cooldown or dedup key
+func (s *Service) fire(ctx context.Context, e *watcherEvent, status *triggerStatus) error {
+	t := status.testTrigger
+	if t.Spec.ConcurrencyPolicy == testtriggersv1.TestTriggerConcurrencyPolicyForbid {
+		if status.hasActiveTests() {
+			s.logger.Infof(
+				"trigger service: matcher component: skipping trigger execution for trigger %s/%s by event %s on resource %s because it is currently running tests",
+				t.Namespace, t.Name, e.eventType, e.resource,
+			)
+			return nil
+		}
+	}
+
+	throttled, err := s.isThrottled(ctx, e, t)
+	if err != nil {
+		return err
+	}
+
+	if throttled {
+		s.logger.Infof(
+			"trigger service: matcher component: skipping trigger execution for trigger %s/%s by event %s on resource %s because of cooldown or dedup key",
+			t.Namespace, t.Name, e.eventType, e.resource,
+		)
+		return nil
+	}
+
+	if t.Spec.ConcurrencyPolicy == testtriggersv1.TestTriggerConcurrencyPolicyReplace {
+		if status.hasActiveTests() {
+			s.logger.Infof(
+				"trigger service: matcher component: aborting trigger execution for trigger %s/%s by event %s on resource %s because it is currently running tests",
+				t.Namespace, t.Name, e.eventType, e.resource,
+			)
+			s.abortExecutions(ctx, t.Name, status)
+		}
+	}
+
+	s.logger.Infof("trigger service: matcher component: event %s matches trigger %s/%s for resource %s", e.eventType, t.Namespace, t.Name, e.resource)
+	s.logger.Infof("trigger service: matcher component: triggering %s action for %s execution", testtriggersmapper.GetAction(t), t.Spec.Execution)
+	if err = s.triggerExecutor(ctx, e, t); err != nil {
+		s.releaseFire(ctx, e, t)
+		return err
+	}
+
+	return nil
+}
+
+// isThrottled records firing of the test trigger with cooldown or dedup key in the lease backend,
+// so all replicas share it, and checks if the firing was rejected
+func (s *Service) isThrottled(ctx context.Context, e *watcherEvent, t *testtriggersv1.TestTrigger) (bool, error) {
+	cooldown, dedupKey := getThrottlingKeys(t, e)
+	if cooldown == 0 && dedupKey == "" {
+		return false, nil
+	}
+
+	fired, err := s.leaseBackend.TryFire(ctx, s.clusterID, string(newStatusKey(t.Namespace, t.Name)), dedupKey, cooldown)
+	if err != nil {
+		return false, err
+	}
+
+	return !fired, nil
+}
+
+// releaseFire forgets firing of the test trigger which action failed, so its cooldown
+// and dedup key don't skip the next matching event
+func (s *Service) releaseFire(ctx context.Context, e *watcherEvent, t *testtriggersv1.TestTrigger) {
+	cooldown, dedupKey := getThrottlingKeys(t, e)
+	if cooldown == 0 && dedupKey == "" {
+		return
+	}
+
+	if err := s.leaseBackend.ReleaseFire(ctx, s.clusterID, string(newStatusKey(t.Namespace, t.Name)), dedupKey); err != nil {
+		s.logger.Errorf("trigger service: matcher component: error releasing firing of trigger %s/%s: %v", t.Namespace, t.Name, err)
+	}
+}
+
+// getThrottlingKeys returns cooldown and dedup key of the test trigger for the event
+func getThrottlingKeys(t *testtriggersv1.TestTrigger, e *watcherEvent) (time.Duration, string) {
+	throttling := testtriggersmapper.GetThrottling(t)
+	if throttling == nil {
+		return 0, ""
+	}
+
+	return throttling.GetCooldown(), getDedupKey(t, e, throttling.DedupKey)
+}
+
+// getDedupKey returns values of the dedup key trigger variables for the event
+func getDedupKey(t *testtriggersv1.TestTrigger, e *watcherEvent, variables []string) string {
+	if len(variables) == 0 {
+		return ""
+	}
+
+	eventVariables := getTriggerVariableValues(t, e)
+	values := make([]string, len(variables))
+	for i, name := range variables {
+		values[i] = name + "=" + eventVariables[name]
+	}
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,160 @@
+package triggers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube/pkg/api/v1/testkube"
+)
+
+// Well-known variables passed to executions started by test triggers
+const (
+	// VariableTriggerName is a name of the test trigger
+	VariableTriggerName = "TRIGGER_NAME"
+	// VariableTriggerEvent is an event which fired the test trigger
+	VariableTriggerEvent = "TRIGGER_EVENT"
+	// VariableTriggerCauses are comma separated causes of the event
+	VariableTriggerCauses = "TRIGGER_CAUSES"
+	// VariableTriggerResourceKind is a kind of the matched resource, or group/version/kind for custom resources
+	VariableTriggerResourceKind = "TRIGGER_RESOURCE_KIND"
+	// VariableTriggerResourceName is a name of the matched resource
+	VariableTriggerResourceName = "TRIGGER_RESOURCE_NAME"
+	// VariableTriggerResourceNamespace is a namespace of the matched resource
+	VariableTriggerResourceNamespace = "TRIGGER_RESOURCE_NAMESPACE"
+	// VariableTriggerResourceLabels are comma separated key=value labels of the matched resource
+	VariableTriggerResourceLabels = "TRIGGER_RESOURCE_LABELS"
+	// VariableTriggerImage is a new container image of the matched deployment
+	VariableTriggerImage = "TRIGGER_IMAGE"
+	// VariableTriggerImageTag is a tag of the new container image of the matched deployment
+	VariableTriggerImageTag = "TRIGGER_IMAGE_TAG"
+)
+
+// triggerVariables are all well-known variables passed to executions started by test triggers
+var triggerVariables = []string{
+	VariableTriggerName,
+	VariableTriggerEvent,
+	VariableTriggerCauses,
+	VariableTriggerResourceKind,
+	VariableTriggerResourceName,
+	VariableTriggerResourceNamespace,
+	VariableTriggerResourceLabels,
+	VariableTriggerImage,
+	VariableTriggerImageTag,
+}
+
+// ValidateDedupKey checks that dedup key consists of well-known trigger variables
+func ValidateDedupKey(variables []string) error {
+	for _, name := range variables {
+		known := false
+		for _, variable := range triggerVariables {
+			if name == variable {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			return fmt.Errorf("unknown dedup key variable %s, use one of %v", name, triggerVariables)
+		}
+	}
+
+	return nil
+}
+
+// getTriggerVariables returns variables describing the event which fired the test trigger
+func getTriggerVariables(t *testtriggersv1.TestTrigger, e *watcherEvent) map[string]testkube.Variable {
+	values := getTriggerVariableValues(t, e)
+	if values == nil {
+		return nil
+	}
+
+	variables := make(map[string]testkube.Variable, len(values))
+	for name, value := range values {
+		variables[name] = testkube.NewBasicVariable(name, value)
+	}
+
+	return variables
+}
+
+// getTriggerVariableValues returns values of variables describing the event which fired the test trigger
+func getTriggerVariableValues(t *testtriggersv1.TestTrigger, e *watcherEvent) map[string]string {
+	if e == nil {
+		return nil
+	}
+
+	causes := make([]string, len(e.causes))
+	for i := range e.causes {
+		causes[i] = string(e.causes[i])
+	}
+
+	labels := make([]string, 0, len(e.labels))
+	for key, value := range e.labels {
+		labels = append(labels, key+"="+value)
+	}
+	sort.Strings(labels)
+
+	values := map[string]string{
+		VariableTriggerName:              t.Name,
+		VariableTriggerEvent:             string(e.eventType),
+		VariableTriggerCauses:            strings.Join(causes, ","),
+		VariableTriggerResourceKind:      string(e.resource),
+		VariableTriggerResourceName:      e.name,
+		VariableTriggerResourceNamespace: e.namespace,
+		VariableTriggerResourceLabels:    strings.Join(labels, ","),
+	}
+
+	if deployment, ok := e.object.(*appsv1.Deployment); ok {
+		var oldDeployment *appsv1.Deployment
+		if e.oldObject != nil {
+			oldDeployment, _ = e.oldObject.(*appsv1.Deployment)
+		}
+
+		if image := getDeploymentImage(oldDeployment, deployment); image != "" {
+			values[VariableTriggerImage] = image
+			values[VariableTriggerImageTag] = getImageTag(image)
+		}
+	}
+
+	return values
+}
+
+// getDeploymentImage returns image of the first container which image changed, or of the first container
+// when no image changed, like for created deployments
+func getDeploymentImage(oldDeployment, newDeployment *appsv1.Deployment) string {
+	containers := newDeployment.Spec.Template.Spec.Containers
+	if len(containers) == 0 {
+		return ""
+	}
+
+	if oldDeployment != nil {
+		oldImages := make(map[string]string, len(oldDeployment.Spec.Template.Spec.Containers))
+		for _, container := range oldDeployment.Spec.Template.Spec.Containers {
+			oldImages[container.Name] = container.Image
+		}
+
+		for _, container := range containers {
+			if oldImage, ok := oldImages[container.Name]; ok && oldImage != container.Image {
+				return container.Image
+			}
+		}
+	}
+
+	return containers[0].Image
+}
+
+// getImageTag returns tag or digest of the image, latest when image has no tag
+func getImageTag(image string) string {
+	if i := strings.LastIndex(image, "@"); i != -1 {
+		return image[i+1:]
+	}
+
+	if i := strings.LastIndex(image, ":"); i != -1 && !strings.Contains(image[i+1:], "/") {
+		return image[i+1:]
+	}
+
+	return "latest"
+}
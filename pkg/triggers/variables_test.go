@@ -0,0 +1,63 @@
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
+	"github.com/kubeshop/testkube-operator/pkg/validation/tests/v1/testtrigger"
+)
+
+func TestGetTriggerVariables(t *testing.T) {
+	t.Parallel()
+
+	deployment := func(images ...string) *appsv1.Deployment {
+		var containers []corev1.Container
+		for i, image := range images {
+			containers = append(containers, corev1.Container{Name: string(rune('a' + i)), Image: image})
+		}
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "payments",
+				Name:      "api",
+				Labels:    map[string]string{"team": "payments", "app": "api"},
+			},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: containers}}},
+		}
+	}
+	trigger := &testtriggersv1.TestTrigger{ObjectMeta: metav1.ObjectMeta{Name: "post-deploy"}}
+
+	e := newWatcherEvent(testtrigger.EventModified, deployment("proxy:1", "api:2.0.1"), testtrigger.ResourceDeployment,
+		withOldObject(deployment("proxy:1", "api:2.0.0")),
+		withCauses([]testtrigger.Cause{testtrigger.CauseDeploymentImageUpdate}))
+	variables := getTriggerVariables(trigger, e)
+
+	expected := map[string]string{
+		VariableTriggerName:              "post-deploy",
+		VariableTriggerEvent:             "modified",
+		VariableTriggerCauses:            "deployment-image-update",
+		VariableTriggerResourceKind:      "deployment",
+		VariableTriggerResourceName:      "api",
+		VariableTriggerResourceNamespace: "payments",
+		VariableTriggerResourceLabels:    "app=api,team=payments",
+		VariableTriggerImage:             "api:2.0.1",
+		VariableTriggerImageTag:          "2.0.1",
+	}
+	assert.Len(t, variables, len(expected))
+	for name, value := range expected {
+		assert.Equal(t, value, variables[name].Value, name)
+		assert.Equal(t, name, variables[name].Name)
+	}
+}
+
+func TestGetImageTag(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "1.2.3", getImageTag("registry.example.com:5000/app:1.2.3"))
+	assert.Equal(t, "latest", getImageTag("registry.example.com:5000/app"))
+	assert.Equal(t, "sha256:abc", getImageTag("app@sha256:abc"))
+}
@@ -2,6 +2,7 @@ package triggers
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,6 +11,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
 	appsinformerv1 "k8s.io/client-go/informers/apps/v1"
 	coreinformerv1 "k8s.io/client-go/informers/core/v1"
@@ -41,6 +43,19 @@ type k8sInformers struct {
 	testTriggerInformer testkubeinformerv1.TestTriggerInformer
 	testSuiteInformer   testkubeinformerv3.TestSuiteInformer
 	testInformer        testkubeinformerv3.TestInformer
+
+	// custom resource informers are started on demand by test triggers and stopped when no test trigger
+	// watches the custom resource anymore or together with other informers
+	customResourceMutex     sync.Mutex
+	customResourceInformers map[schema.GroupVersionResource]*customResourceInformer
+	stop                    <-chan struct{}
+}
+
+// customResourceInformer keeps dynamic informers of a custom resource and test triggers watching it
+type customResourceInformer struct {
+	informers []cache.SharedIndexInformer
+	triggers  map[statusKey]struct{}
+	done      chan struct{}
 }
 
 func newK8sInformers(clientset kubernetes.Interface, testKubeClientset versioned.Interface,
@@ -67,6 +82,7 @@ func newK8sInformers(clientset kubernetes.Interface, testKubeClientset versioned
 	k8sInformers.testTriggerInformer = testkubeInformerFactory.Tests().V1().TestTriggers()
 	k8sInformers.testSuiteInformer = testkubeInformerFactory.Tests().V3().TestSuites()
 	k8sInformers.testInformer = testkubeInformerFactory.Tests().V3().Tests()
+	k8sInformers.customResourceInformers = make(map[schema.GroupVersionResource]*customResourceInformer)
 
 	return &k8sInformers
 }
@@ -88,6 +104,7 @@ func (s *Service) runWatcher(ctx context.Context, leaseChan chan bool) {
 				if running {
 					s.logger.Infof("trigger service: instance %s in cluster %s lost lease", s.identifier, s.clusterID)
 					close(stopChan)
+					s.stopDebounces()
 					s.informers = nil
 					running = false
 				}
@@ -110,6 +127,7 @@ func (s *Service) runInformers(ctx context.Context, stop <-chan struct{}) {
 		return
 	}
 
+	s.informers.stop = stop
 	for i := range s.informers.podInformers {
 		s.informers.podInformers[i].Informer().AddEventHandler(s.podEventHandler(ctx))
 	}
@@ -142,7 +160,7 @@ func (s *Service) runInformers(ctx context.Context, stop <-chan struct{}) {
 		s.informers.configMapInformers[i].Informer().AddEventHandler(s.configMapEventHandler(ctx))
 	}
 
-	s.informers.testTriggerInformer.Informer().AddEventHandler(s.testTriggerEventHandler())
+	s.informers.testTriggerInformer.Informer().AddEventHandler(s.testTriggerEventHandler(ctx))
 	s.informers.testSuiteInformer.Informer().AddEventHandler(s.testSuiteEventHandler())
 	s.informers.testInformer.Informer().AddEventHandler(s.testEventHandler())
 
@@ -365,7 +383,7 @@ func (s *Service) deploymentEventHandler(ctx context.Context) cache.ResourceEven
 				newDeployment.Namespace, newDeployment.Name,
 			)
 			causes := diffDeployments(oldDeployment, newDeployment)
-			event := newWatcherEvent(testtrigger.EventModified, newDeployment, testtrigger.ResourceDeployment, withCauses(causes), withConditionsGetter(getConditions(newDeployment)))
+			event := newWatcherEvent(testtrigger.EventModified, newDeployment, testtrigger.ResourceDeployment, withCauses(causes), withOldObject(oldDeployment), withConditionsGetter(getConditions(newDeployment)))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update deployment event: %v", err)
 			}
@@ -436,7 +454,7 @@ func (s *Service) statefulSetEventHandler(ctx context.Context) cache.ResourceEve
 				"trigger service: watcher component: emiting event: statefulset %s/%s updated",
 				newStatefulSet.Namespace, newStatefulSet.Name,
 			)
-			event := newWatcherEvent(testtrigger.EventModified, newStatefulSet, testtrigger.ResourceStatefulSet, withConditionsGetter(getConditions(newStatefulSet)))
+			event := newWatcherEvent(testtrigger.EventModified, newStatefulSet, testtrigger.ResourceStatefulSet, withOldObject(oldStatefulSet), withConditionsGetter(getConditions(newStatefulSet)))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update statefulset event: %v", err)
 			}
@@ -507,7 +525,7 @@ func (s *Service) daemonSetEventHandler(ctx context.Context) cache.ResourceEvent
 				"trigger service: watcher component: emiting event: daemonset %s/%s updated",
 				newDaemonSet.Namespace, newDaemonSet.Name,
 			)
-			event := newWatcherEvent(testtrigger.EventModified, newDaemonSet, testtrigger.ResourceDaemonSet, withConditionsGetter(getConditions(newDaemonSet)))
+			event := newWatcherEvent(testtrigger.EventModified, newDaemonSet, testtrigger.ResourceDaemonSet, withOldObject(oldDaemonSet), withConditionsGetter(getConditions(newDaemonSet)))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update daemonset event: %v", err)
 			}
@@ -584,7 +602,7 @@ func (s *Service) serviceEventHandler(ctx context.Context) cache.ResourceEventHa
 				"trigger service: watcher component: emiting event: service %s/%s updated",
 				newService.Namespace, newService.Name,
 			)
-			event := newWatcherEvent(testtrigger.EventModified, newService, testtrigger.ResourceService,
+			event := newWatcherEvent(testtrigger.EventModified, newService, testtrigger.ResourceService, withOldObject(oldService),
 				withConditionsGetter(getConditions(newService)), withAddressGetter(getAddrress(newService)))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update service event: %v", err)
@@ -652,7 +670,7 @@ func (s *Service) ingressEventHandler(ctx context.Context) cache.ResourceEventHa
 				"trigger service: watcher component: emiting event: ingress %s/%s updated",
 				oldIngress.Namespace, newIngress.Name,
 			)
-			event := newWatcherEvent(testtrigger.EventModified, newIngress, testtrigger.ResourceIngress)
+			event := newWatcherEvent(testtrigger.EventModified, newIngress, testtrigger.ResourceIngress, withOldObject(oldIngress))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update ingress event: %v", err)
 			}
@@ -696,7 +714,7 @@ func (s *Service) clusterEventEventHandler(ctx context.Context) cache.ResourceEv
 	}
 }
 
-func (s *Service) testTriggerEventHandler() cache.ResourceEventHandlerFuncs {
+func (s *Service) testTriggerEventHandler(ctx context.Context) cache.ResourceEventHandlerFuncs {
 	return cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			t, ok := obj.(*testtriggersv1.TestTrigger)
@@ -704,11 +722,17 @@ func (s *Service) testTriggerEventHandler() cache.ResourceEventHandlerFuncs {
 				s.logger.Errorf("failed to process create testtrigger event due to it being an unexpected type, received type %+v", obj)
 				return
 			}
+			resource, err := getTriggerResource(t)
+			if err != nil {
+				s.logger.Errorf("trigger service: watcher component: skipping testtrigger %s/%s: %v", t.Namespace, t.Name, err)
+				return
+			}
 			s.logger.Debugf(
 				"trigger service: watcher component: adding testtrigger %s/%s for resource %s on event %s",
-				t.Namespace, t.Name, t.Spec.Resource, t.Spec.Event,
+				t.Namespace, t.Name, resource, t.Spec.Event,
 			)
 			s.addTrigger(t)
+			s.watchResourceKind(ctx, t)
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
 			t, ok := newObj.(*testtriggersv1.TestTrigger)
@@ -719,11 +743,19 @@ func (s *Service) testTriggerEventHandler() cache.ResourceEventHandlerFuncs {
 				)
 				return
 			}
+			resource, err := getTriggerResource(t)
+			if err != nil {
+				s.logger.Errorf("trigger service: watcher component: skipping testtrigger %s/%s: %v", t.Namespace, t.Name, err)
+				s.removeTrigger(t)
+				s.unwatchResourceKind(t)
+				return
+			}
 			s.logger.Debugf(
 				"trigger service: watcher component: updating testtrigger %s/%s for resource %s on event %s",
-				t.Namespace, t.Name, t.Spec.Resource, t.Spec.Event,
+				t.Namespace, t.Name, resource, t.Spec.Event,
 			)
 			s.updateTrigger(t)
+			s.watchResourceKind(ctx, t)
 		},
 		DeleteFunc: func(obj interface{}) {
 			t, ok := obj.(*testtriggersv1.TestTrigger)
@@ -732,10 +764,11 @@ func (s *Service) testTriggerEventHandler() cache.ResourceEventHandlerFuncs {
 				return
 			}
 			s.logger.Debugf(
-				"trigger service: watcher component: deleting testtrigger %s/%s for resource %s on event %s",
-				t.Namespace, t.Name, t.Spec.Resource, t.Spec.Event,
+				"trigger service: watcher component: deleting testtrigger %s/%s on event %s",
+				t.Namespace, t.Name, t.Spec.Event,
 			)
 			s.removeTrigger(t)
+			s.unwatchResourceKind(t)
 		},
 	}
 }
@@ -846,7 +879,7 @@ func (s *Service) configMapEventHandler(ctx context.Context) cache.ResourceEvent
 				"trigger service: watcher component: emiting event: config map %s/%s updated",
 				oldConfigMap.Namespace, newConfigMap.Name,
 			)
-			event := newWatcherEvent(testtrigger.EventModified, newConfigMap, testtrigger.ResourceConfigMap)
+			event := newWatcherEvent(testtrigger.EventModified, newConfigMap, testtrigger.ResourceConfigMap, withOldObject(oldConfigMap))
 			if err := s.match(ctx, event); err != nil {
 				s.logger.Errorf("event matcher returned an error while matching update config map event: %v", err)
 			}
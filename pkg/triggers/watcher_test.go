@@ -6,13 +6,20 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
 	testtriggersv1 "github.com/kubeshop/testkube-operator/api/testtriggers/v1"
 	faketestkube "github.com/kubeshop/testkube-operator/pkg/clientset/versioned/fake"
 	"github.com/kubeshop/testkube/pkg/log"
+	testtriggersmapper "github.com/kubeshop/testkube/pkg/mapper/testtriggers"
 )
 
 func TestService_runWatcher_lease(t *testing.T) {
@@ -76,7 +83,7 @@ func TestService_runWatcher_lease(t *testing.T) {
 		testNamespace := "testkube"
 
 		match := false
-		testExecutorF := func(ctx context.Context, trigger *testtriggersv1.TestTrigger) error {
+		testExecutorF := func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
 			assert.Equal(t, testNamespace, trigger.Namespace)
 			assert.Equal(t, "test-trigger-2", trigger.Name)
 			match = true
@@ -132,6 +139,167 @@ func TestService_runWatcher_lease(t *testing.T) {
 	})
 }
 
+func TestService_runWatcher_customResource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create a test trigger for custom resource modified and match event on custom resource update", func(t *testing.T) {
+		t.Parallel()
+
+		gvk := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+		gvr := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gvk.GroupVersion()})
+		restMapper.Add(gvk, meta.RESTScopeNamespace)
+
+		clientset := fake.NewSimpleClientset()
+		testKubeClientset := faketestkube.NewSimpleClientset()
+		dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(
+			runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "RolloutList"})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		testNamespace := "testkube"
+
+		match := false
+		testExecutorF := func(ctx context.Context, e *watcherEvent, trigger *testtriggersv1.TestTrigger) error {
+			assert.Equal(t, "test-trigger-rollout", trigger.Name)
+			match = true
+			return nil
+		}
+		s := &Service{
+			triggerExecutor:   testExecutorF,
+			identifier:        "testkube-api",
+			clusterID:         "testkube",
+			triggerStatus:     make(map[statusKey]*triggerStatus),
+			clientset:         clientset,
+			dynamicClient:     dynamicClient,
+			restMapper:        restMapper,
+			testKubeClientset: testKubeClientset,
+			logger:            log.DefaultLogger,
+			informers:         newK8sInformers(clientset, testKubeClientset, "", []string{}),
+		}
+
+		leaseChan := make(chan bool)
+		go func() { time.Sleep(50 * time.Millisecond); leaseChan <- true }()
+		go s.runWatcher(ctx, leaseChan)
+
+		time.Sleep(100 * time.Millisecond)
+
+		rollout := &unstructured.Unstructured{}
+		rollout.SetGroupVersionKind(gvk)
+		rollout.SetNamespace(testNamespace)
+		rollout.SetName("test-rollout")
+		rollout.Object["spec"] = map[string]interface{}{"replicas": int64(1)}
+		_, err := dynamicClient.Resource(gvr).Namespace(testNamespace).Create(ctx, rollout, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		testTrigger := testtriggersv1.TestTrigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   testNamespace,
+				Name:        "test-trigger-rollout",
+				Annotations: map[string]string{testtriggersmapper.ResourceKindAnnotation: "argoproj.io/v1alpha1/Rollout"},
+			},
+			Spec: testtriggersv1.TestTriggerSpec{
+				Resource:          "pod",
+				ResourceSelector:  testtriggersv1.TestTriggerSelector{Name: "test-rollout"},
+				Event:             "modified",
+				Action:            "run",
+				Execution:         "test",
+				ConcurrencyPolicy: "allow",
+				TestSelector:      testtriggersv1.TestTriggerSelector{Name: "some-test"},
+			},
+		}
+		_, err = testKubeClientset.TestsV1().TestTriggers(testNamespace).Create(ctx, &testTrigger, metav1.CreateOptions{})
+		assert.NoError(t, err)
+
+		time.Sleep(100 * time.Millisecond)
+		assert.Contains(t, s.informers.customResourceInformers, gvr)
+
+		rollout.Object["spec"] = map[string]interface{}{"replicas": int64(2)}
+		_, err = dynamicClient.Resource(gvr).Namespace(testNamespace).Update(ctx, rollout, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+
+		time.Sleep(100 * time.Millisecond)
+		assert.True(t, match, "custom resource modified event should match the test trigger")
+	})
+	t.Run("skip a test trigger with invalid resource kind", func(t *testing.T) {
+		t.Parallel()
+
+		s := &Service{triggerStatus: make(map[statusKey]*triggerStatus), logger: log.DefaultLogger}
+		testTrigger := &testtriggersv1.TestTrigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "testkube",
+				Name:        "test-trigger-invalid",
+				Annotations: map[string]string{testtriggersmapper.ResourceKindAnnotation: "Rollout"},
+			},
+			Spec: testtriggersv1.TestTriggerSpec{Resource: "pod", Event: "modified"},
+		}
+
+		handler := s.testTriggerEventHandler(context.Background())
+		handler.OnAdd(testTrigger, false)
+		assert.Empty(t, s.triggerStatus)
+
+		s.addTrigger(testTrigger)
+		handler.OnUpdate(testTrigger, testTrigger)
+		assert.Empty(t, s.triggerStatus)
+	})
+	t.Run("stop custom resource informers when no test trigger watches the custom resource", func(t *testing.T) {
+		t.Parallel()
+
+		gvk := schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+		gvr := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+		restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gvk.GroupVersion()})
+		restMapper.Add(gvk, meta.RESTScopeNamespace)
+
+		clientset := fake.NewSimpleClientset()
+		testKubeClientset := faketestkube.NewSimpleClientset()
+		dynamicClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(
+			runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "RolloutList"})
+
+		stop := make(chan struct{})
+		defer close(stop)
+		informers := newK8sInformers(clientset, testKubeClientset, "", []string{})
+		informers.stop = stop
+		s := &Service{
+			triggerStatus: make(map[statusKey]*triggerStatus),
+			dynamicClient: dynamicClient,
+			restMapper:    restMapper,
+			logger:        log.DefaultLogger,
+			informers:     informers,
+		}
+
+		newTrigger := func(name string) *testtriggersv1.TestTrigger {
+			return &testtriggersv1.TestTrigger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "testkube",
+					Name:        name,
+					Annotations: map[string]string{testtriggersmapper.ResourceKindAnnotation: "argoproj.io/v1alpha1/Rollout"},
+				},
+				Spec: testtriggersv1.TestTriggerSpec{Resource: "pod", Event: "modified"},
+			}
+		}
+		first, second := newTrigger("test-trigger-1"), newTrigger("test-trigger-2")
+
+		handler := s.testTriggerEventHandler(context.Background())
+		handler.OnAdd(first, false)
+		handler.OnAdd(second, false)
+		handler.OnUpdate(second, second)
+		require.Contains(t, informers.customResourceInformers, gvr)
+		watched := informers.customResourceInformers[gvr]
+		assert.Len(t, watched.triggers, 2)
+		assert.Len(t, watched.informers, 1)
+
+		updated := newTrigger("test-trigger-2")
+		updated.Annotations = nil
+		handler.OnUpdate(second, updated)
+		assert.Len(t, watched.triggers, 1)
+
+		handler.OnDelete(first)
+		assert.NotContains(t, informers.customResourceInformers, gvr)
+		assert.Eventually(t, watched.informers[0].IsStopped, time.Second, 10*time.Millisecond)
+	})
+}
+
 func TestService_runWatcher_noLease(t *testing.T) {
 	t.Parallel()
 